@@ -5,7 +5,10 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"strings"
 
+	"github.com/occult/pagode/ent/role"
+	"github.com/occult/pagode/pkg/authz"
 	"github.com/occult/pagode/pkg/log"
 	"github.com/occult/pagode/pkg/services"
 )
@@ -21,18 +24,38 @@ func main() {
 		}
 	}()
 
-	var email string
+	var email, pw string
 	flag.StringVar(&email, "email", "", "email address for the admin user")
+	flag.StringVar(&pw, "password", "", "password for the admin user, which is generated if omitted")
 	flag.Parse()
 
 	if len(email) == 0 {
 		invalid("email is required")
 	}
 
-	// Generate a password.
-	pw, err := c.Auth.RandomToken(10)
+	// Generate a password, if needed.
+	if len(pw) == 0 {
+		var err error
+		if pw, err = c.Auth.RandomPassword(); err != nil {
+			invalid("failed to generate a random password")
+		}
+	}
+
+	switch err := c.Auth.ValidatePassword(pw, "Admin", email).(type) {
+	case nil:
+	case services.PasswordPolicyError:
+		invalid(strings.Join(err.Reasons, " "))
+	default:
+		invalid(err.Error())
+	}
+
+	// Load the admin role, which grants all permissions.
+	adminRole, err := c.ORM.Role.
+		Query().
+		Where(role.Name(authz.AdminRole)).
+		Only(context.Background())
 	if err != nil {
-		invalid("failed to generate a random password")
+		invalid(err.Error())
 	}
 
 	// Create the admin user.
@@ -40,7 +63,7 @@ func main() {
 		Create().
 		SetEmail(email).
 		SetName("Admin").
-		SetAdmin(true).
+		AddRoles(adminRole).
 		SetVerified(true).
 		SetPassword(pw).
 		Exec(context.Background())
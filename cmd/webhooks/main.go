@@ -0,0 +1,46 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/occult/pagode/pkg/log"
+	"github.com/occult/pagode/pkg/services"
+)
+
+// main replays recorded payment webhook events which failed to process.
+func main() {
+	// Start a new container.
+	c := services.NewContainer()
+	defer func() {
+		// Gracefully shutdown all services.
+		if err := c.Shutdown(); err != nil {
+			log.Default().Error("shutdown failed", "error", err)
+		}
+	}()
+
+	var id int
+	flag.IntVar(&id, "id", 0, "ID of the event to replay; all failed events are replayed if omitted")
+	flag.Parse()
+
+	if id > 0 {
+		if err := c.Payment.ReplayWebhookEvent(context.Background(), id); err != nil {
+			invalid(err.Error())
+		}
+		fmt.Printf("Replayed event %d\n", id)
+		return
+	}
+
+	replayed, err := c.Payment.ReplayFailedWebhookEvents(context.Background())
+	fmt.Printf("Replayed %d event(s)\n", replayed)
+	if err != nil {
+		invalid(err.Error())
+	}
+}
+
+func invalid(msg string) {
+	fmt.Printf("[ERROR] %s\n", msg)
+	os.Exit(1)
+}
@@ -50,15 +50,19 @@ func SwitchEnvironment(env environment) {
 type (
 	// Config stores complete configuration.
 	Config struct {
-		HTTP     HTTPConfig
-		App      AppConfig
-		Cache    CacheConfig
-		Database DatabaseConfig
-		Files    FilesConfig
-		Tasks    TasksConfig
-		Mail     MailConfig
-		Payment  PaymentConfig
-		Chat     ChatConfig
+		HTTP      HTTPConfig
+		App       AppConfig
+		Auth      AuthConfig
+		Audit     AuditConfig
+		RateLimit RateLimitConfig
+		Cache     CacheConfig
+		Database  DatabaseConfig
+		Files     FilesConfig
+		Tasks     TasksConfig
+		Mail      MailConfig
+		Payment   PaymentConfig
+		Chat      ChatConfig
+		OAuth     OAuthConfig
 	}
 
 	// HTTPConfig stores HTTP configuration.
@@ -74,6 +78,10 @@ type (
 			Certificate string
 			Key         string
 		}
+		// TrustedProxies are the IP addresses or CIDR ranges of the reverse proxies in front of the app. The
+		// X-Forwarded-For header is only used to determine the IP address of a client if the request came from one
+		// of them; otherwise the IP address of the connection is used.
+		TrustedProxies []string
 	}
 
 	// AppConfig stores application configuration.
@@ -88,16 +96,137 @@ type (
 			Length     int
 		}
 		EmailVerificationTokenExpiration time.Duration
+		LoginTokenExpiration             time.Duration
+		TwoFactor                        struct {
+			RecoveryCodes            int
+			RememberDeviceExpiration time.Duration
+		}
+		Session struct {
+			Expiration      time.Duration
+			CleanupInterval time.Duration
+		}
+		Organization struct {
+			InvitationExpiration time.Duration
+		}
+		DataExport struct {
+			Expiration time.Duration
+		}
+		AccountDeletion struct {
+			GracePeriod time.Duration
+		}
+	}
+
+	// AuthConfig stores the authentication configuration.
+	AuthConfig struct {
+		Lockout  LockoutConfig
+		Password PasswordConfig
+		WebAuthn WebAuthnConfig
+	}
+
+	// LockoutConfig stores the limits which protect logins against brute-force attacks.
+	// Once the amount of failed attempts within the window reaches a limit, logins are blocked for the lockout
+	// duration, which doubles with each further failure up to the maximum.
+	LockoutConfig struct {
+		// MaxAttempts is the amount of failed attempts after which an account is locked.
+		MaxAttempts int
+		// MaxAttemptsPerIP is the amount of failed attempts after which an IP address is blocked.
+		MaxAttemptsPerIP int
+		// Window is how long failed attempts are counted for.
+		Window      time.Duration
+		Duration    time.Duration
+		MaxDuration time.Duration
+	}
+
+	// PasswordConfig stores the policy which passwords chosen by users must comply with.
+	PasswordConfig struct {
+		MinLength int
+		// MaxLength is the maximum length in bytes, which cannot exceed 72 since bcrypt ignores anything longer.
+		MaxLength int
+		// MinCharacterClasses is how many of lowercase letters, uppercase letters, digits and symbols are required.
+		MinCharacterClasses int
+		// BreachedList is the path to a file listing the SHA-1 hashes of breached passwords, which are rejected.
+		// Checking for breached passwords is disabled if it is empty.
+		BreachedList string
+	}
+
+	// WebAuthnConfig stores the configuration of passkeys.
+	WebAuthnConfig struct {
+		// RPID is the domain passkeys are scoped to, which defaults to the hostname of the app host.
+		RPID string
+		// Origins are the origins passkeys may be used from, which defaults to the app host.
+		Origins []string
+		// Timeout is how long users have to create or use a passkey.
+		Timeout time.Duration
+	}
+
+	// AuditConfig stores the configuration of the audit log.
+	AuditConfig struct {
+		// Entities are the types of entities, such as User, whose creation, changes and deletion are recorded.
+		Entities []string
+	}
+
+	// RateLimitConfig stores the limits of how many requests can be made to routes which are prone to abuse.
+	RateLimitConfig struct {
+		Login         RateLimitRule
+		Register      RateLimitRule
+		PasswordReset RateLimitRule
+		Contact       RateLimitRule
+		Upload        RateLimitRule
+	}
+
+	// RateLimitRule stores how many requests can be made within a period, which is not limited if the limit is zero.
+	RateLimitRule struct {
+		// Strategy is either "tokenBucket", which allows bursts up to the limit and refills evenly over the period,
+		// or "slidingWindow", which allows up to the limit within any window as long as the period.
+		Strategy string
+		// Key is what requests are counted by, either "ip", "user" or "token". Requests which are not authenticated
+		// are counted by IP address.
+		Key    string
+		Limit  int
+		Period time.Duration
 	}
 
 	// CacheConfig stores the cache configuration.
 	CacheConfig struct {
-		Capacity   int
+		// Driver is the cache store, either "memory", which is local to each instance of the app, "sqlite", which
+		// persists across restarts of a single instance, or "redis", which can be shared by several instances.
+		Driver   string
+		Capacity int
+		// Codec encodes the data of typed cache operations, either "json", "gob" or "msgpack".
+		Codec      string
+		SQLite     SQLiteCacheConfig
+		Redis      RedisConfig
 		Expiration struct {
 			StaticFile time.Duration
+			// Page is how long responses of routes using middleware.CachePage are cached for.
+			Page time.Duration
 		}
 	}
 
+	// SQLiteCacheConfig stores the configuration of the cache store which persists to an SQLite database.
+	SQLiteCacheConfig struct {
+		// Connection is the database the cache is stored in. If empty, the main database is used.
+		Connection string
+		// CleanupInterval is how often expired entries are removed from the database.
+		CleanupInterval time.Duration
+		// MemoryCapacity is the maximum amount of entries kept in memory in front of the database, or zero to
+		// read all entries from the database.
+		MemoryCapacity int
+	}
+
+	// RedisConfig stores the connection to a server which speaks the Redis protocol (RESP), such as Redis or Valkey.
+	RedisConfig struct {
+		Address  string
+		Password string
+		Database int
+		// Prefix is prepended to all keys, so several apps can share a server.
+		Prefix string
+		// Timeout is how long connecting to the server and each command may take.
+		Timeout time.Duration
+		// MaxIdleConnections is how many connections are kept open for reuse.
+		MaxIdleConnections int
+	}
+
 	// DatabaseConfig stores the database configuration.
 	DatabaseConfig struct {
 		Driver         string
@@ -130,8 +259,10 @@ type (
 
 	// PaymentConfig stores the payment configuration.
 	PaymentConfig struct {
+		// Provider is the name of the payment provider, which is either "stripe" or "fake".
 		Provider string
 		Stripe   StripeConfig
+		Fake     FakePaymentConfig
 	}
 
 	// StripeConfig stores the Stripe-specific configuration.
@@ -142,6 +273,35 @@ type (
 		Currency       string
 	}
 
+	// FakePaymentConfig stores the configuration of the fake payment provider, which keeps everything in memory.
+	FakePaymentConfig struct {
+		WebhookSecret string
+		// SettleDelay is how long payments which succeed with a delay are processing for.
+		SettleDelay time.Duration
+	}
+
+	// OAuthConfig stores the configuration of the external identity providers users can log in with.
+	OAuthConfig struct {
+		// StateExpiration is how long a user has to complete logging in with a provider.
+		StateExpiration time.Duration
+		// Providers stores the providers, keyed by the name used in their URLs.
+		Providers map[string]OAuthProviderConfig
+	}
+
+	// OAuthProviderConfig stores the configuration of an external identity provider.
+	OAuthProviderConfig struct {
+		Enabled bool
+		// Type is the type of provider, which is either "google", "github" or "oidc".
+		Type         string
+		Label        string
+		ClientID     string
+		ClientSecret string
+		// IssuerURL is the URL used to discover the OpenID Connect configuration of "oidc" providers.
+		IssuerURL string
+		// Scopes overrides the default scopes requested from the provider.
+		Scopes []string
+	}
+
 	// ChatConfig stores the chat configuration.
 	ChatConfig struct {
 		Enabled                bool
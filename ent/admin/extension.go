@@ -35,22 +35,10 @@ func (*Extension) Templates() []*gen.Template {
 	}
 }
 
-// fieldName provides a struct field name from an entity field name (ie, user_id -> UserID).
+// fieldName provides a struct field name from an entity field name (ie, user_id -> UserID, ip_hash -> IPHash).
+// This uses Ent's own naming so that it always matches the generated entity code.
 func fieldName(name string) string {
-	if len(name) == 0 {
-		return name
-	}
-
-	parts := strings.Split(name, "_")
-	for i := 0; i < len(parts); i++ {
-		if parts[i] == "id" {
-			parts[i] = "ID"
-		} else {
-			parts[i] = upperFirst(parts[i])
-		}
-	}
-
-	return strings.Join(parts, "")
+	return gen.Funcs["pascal"].(func(string) string)(name)
 }
 
 // FieldLabel provides a label for an entity field name (ie, user_id -> User ID).
@@ -11,13 +11,27 @@ import (
 	"github.com/labstack/echo/v4"
 
 	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/accesstoken"
+	"github.com/occult/pagode/ent/auditevent"
 	"github.com/occult/pagode/ent/chatban"
 	"github.com/occult/pagode/ent/chatmessage"
 	"github.com/occult/pagode/ent/chatroom"
+	"github.com/occult/pagode/ent/credential"
+	"github.com/occult/pagode/ent/identity"
+	"github.com/occult/pagode/ent/invitation"
+	"github.com/occult/pagode/ent/loginattempt"
+	"github.com/occult/pagode/ent/logintoken"
+	"github.com/occult/pagode/ent/membership"
+	"github.com/occult/pagode/ent/organization"
 	"github.com/occult/pagode/ent/passwordtoken"
 	"github.com/occult/pagode/ent/paymentcustomer"
+	"github.com/occult/pagode/ent/paymentevent"
 	"github.com/occult/pagode/ent/paymentintent"
 	"github.com/occult/pagode/ent/paymentmethod"
+	"github.com/occult/pagode/ent/permission"
+	"github.com/occult/pagode/ent/recoverycode"
+	"github.com/occult/pagode/ent/role"
+	"github.com/occult/pagode/ent/session"
 	"github.com/occult/pagode/ent/subscription"
 	"github.com/occult/pagode/ent/user"
 )
@@ -39,20 +53,48 @@ func NewHandler(client *ent.Client, cfg HandlerConfig) *Handler {
 
 func (h *Handler) Create(ctx echo.Context, entityType string) error {
 	switch entityType {
+	case "AccessToken":
+		return h.AccessTokenCreate(ctx)
+	case "AuditEvent":
+		return h.AuditEventCreate(ctx)
 	case "ChatBan":
 		return h.ChatBanCreate(ctx)
 	case "ChatMessage":
 		return h.ChatMessageCreate(ctx)
 	case "ChatRoom":
 		return h.ChatRoomCreate(ctx)
+	case "Credential":
+		return h.CredentialCreate(ctx)
+	case "Identity":
+		return h.IdentityCreate(ctx)
+	case "Invitation":
+		return h.InvitationCreate(ctx)
+	case "LoginAttempt":
+		return h.LoginAttemptCreate(ctx)
+	case "LoginToken":
+		return h.LoginTokenCreate(ctx)
+	case "Membership":
+		return h.MembershipCreate(ctx)
+	case "Organization":
+		return h.OrganizationCreate(ctx)
 	case "PasswordToken":
 		return h.PasswordTokenCreate(ctx)
 	case "PaymentCustomer":
 		return h.PaymentCustomerCreate(ctx)
+	case "PaymentEvent":
+		return h.PaymentEventCreate(ctx)
 	case "PaymentIntent":
 		return h.PaymentIntentCreate(ctx)
 	case "PaymentMethod":
 		return h.PaymentMethodCreate(ctx)
+	case "Permission":
+		return h.PermissionCreate(ctx)
+	case "RecoveryCode":
+		return h.RecoveryCodeCreate(ctx)
+	case "Role":
+		return h.RoleCreate(ctx)
+	case "Session":
+		return h.SessionCreate(ctx)
 	case "Subscription":
 		return h.SubscriptionCreate(ctx)
 	case "User":
@@ -64,20 +106,48 @@ func (h *Handler) Create(ctx echo.Context, entityType string) error {
 
 func (h *Handler) Get(ctx echo.Context, entityType string, id int) (url.Values, error) {
 	switch entityType {
+	case "AccessToken":
+		return h.AccessTokenGet(ctx, id)
+	case "AuditEvent":
+		return h.AuditEventGet(ctx, id)
 	case "ChatBan":
 		return h.ChatBanGet(ctx, id)
 	case "ChatMessage":
 		return h.ChatMessageGet(ctx, id)
 	case "ChatRoom":
 		return h.ChatRoomGet(ctx, id)
+	case "Credential":
+		return h.CredentialGet(ctx, id)
+	case "Identity":
+		return h.IdentityGet(ctx, id)
+	case "Invitation":
+		return h.InvitationGet(ctx, id)
+	case "LoginAttempt":
+		return h.LoginAttemptGet(ctx, id)
+	case "LoginToken":
+		return h.LoginTokenGet(ctx, id)
+	case "Membership":
+		return h.MembershipGet(ctx, id)
+	case "Organization":
+		return h.OrganizationGet(ctx, id)
 	case "PasswordToken":
 		return h.PasswordTokenGet(ctx, id)
 	case "PaymentCustomer":
 		return h.PaymentCustomerGet(ctx, id)
+	case "PaymentEvent":
+		return h.PaymentEventGet(ctx, id)
 	case "PaymentIntent":
 		return h.PaymentIntentGet(ctx, id)
 	case "PaymentMethod":
 		return h.PaymentMethodGet(ctx, id)
+	case "Permission":
+		return h.PermissionGet(ctx, id)
+	case "RecoveryCode":
+		return h.RecoveryCodeGet(ctx, id)
+	case "Role":
+		return h.RoleGet(ctx, id)
+	case "Session":
+		return h.SessionGet(ctx, id)
 	case "Subscription":
 		return h.SubscriptionGet(ctx, id)
 	case "User":
@@ -89,20 +159,48 @@ func (h *Handler) Get(ctx echo.Context, entityType string, id int) (url.Values,
 
 func (h *Handler) Delete(ctx echo.Context, entityType string, id int) error {
 	switch entityType {
+	case "AccessToken":
+		return h.AccessTokenDelete(ctx, id)
+	case "AuditEvent":
+		return h.AuditEventDelete(ctx, id)
 	case "ChatBan":
 		return h.ChatBanDelete(ctx, id)
 	case "ChatMessage":
 		return h.ChatMessageDelete(ctx, id)
 	case "ChatRoom":
 		return h.ChatRoomDelete(ctx, id)
+	case "Credential":
+		return h.CredentialDelete(ctx, id)
+	case "Identity":
+		return h.IdentityDelete(ctx, id)
+	case "Invitation":
+		return h.InvitationDelete(ctx, id)
+	case "LoginAttempt":
+		return h.LoginAttemptDelete(ctx, id)
+	case "LoginToken":
+		return h.LoginTokenDelete(ctx, id)
+	case "Membership":
+		return h.MembershipDelete(ctx, id)
+	case "Organization":
+		return h.OrganizationDelete(ctx, id)
 	case "PasswordToken":
 		return h.PasswordTokenDelete(ctx, id)
 	case "PaymentCustomer":
 		return h.PaymentCustomerDelete(ctx, id)
+	case "PaymentEvent":
+		return h.PaymentEventDelete(ctx, id)
 	case "PaymentIntent":
 		return h.PaymentIntentDelete(ctx, id)
 	case "PaymentMethod":
 		return h.PaymentMethodDelete(ctx, id)
+	case "Permission":
+		return h.PermissionDelete(ctx, id)
+	case "RecoveryCode":
+		return h.RecoveryCodeDelete(ctx, id)
+	case "Role":
+		return h.RoleDelete(ctx, id)
+	case "Session":
+		return h.SessionDelete(ctx, id)
 	case "Subscription":
 		return h.SubscriptionDelete(ctx, id)
 	case "User":
@@ -114,20 +212,48 @@ func (h *Handler) Delete(ctx echo.Context, entityType string, id int) error {
 
 func (h *Handler) Update(ctx echo.Context, entityType string, id int) error {
 	switch entityType {
+	case "AccessToken":
+		return h.AccessTokenUpdate(ctx, id)
+	case "AuditEvent":
+		return h.AuditEventUpdate(ctx, id)
 	case "ChatBan":
 		return h.ChatBanUpdate(ctx, id)
 	case "ChatMessage":
 		return h.ChatMessageUpdate(ctx, id)
 	case "ChatRoom":
 		return h.ChatRoomUpdate(ctx, id)
+	case "Credential":
+		return h.CredentialUpdate(ctx, id)
+	case "Identity":
+		return h.IdentityUpdate(ctx, id)
+	case "Invitation":
+		return h.InvitationUpdate(ctx, id)
+	case "LoginAttempt":
+		return h.LoginAttemptUpdate(ctx, id)
+	case "LoginToken":
+		return h.LoginTokenUpdate(ctx, id)
+	case "Membership":
+		return h.MembershipUpdate(ctx, id)
+	case "Organization":
+		return h.OrganizationUpdate(ctx, id)
 	case "PasswordToken":
 		return h.PasswordTokenUpdate(ctx, id)
 	case "PaymentCustomer":
 		return h.PaymentCustomerUpdate(ctx, id)
+	case "PaymentEvent":
+		return h.PaymentEventUpdate(ctx, id)
 	case "PaymentIntent":
 		return h.PaymentIntentUpdate(ctx, id)
 	case "PaymentMethod":
 		return h.PaymentMethodUpdate(ctx, id)
+	case "Permission":
+		return h.PermissionUpdate(ctx, id)
+	case "RecoveryCode":
+		return h.RecoveryCodeUpdate(ctx, id)
+	case "Role":
+		return h.RoleUpdate(ctx, id)
+	case "Session":
+		return h.SessionUpdate(ctx, id)
 	case "Subscription":
 		return h.SubscriptionUpdate(ctx, id)
 	case "User":
@@ -139,20 +265,48 @@ func (h *Handler) Update(ctx echo.Context, entityType string, id int) error {
 
 func (h *Handler) List(ctx echo.Context, entityType string) (*EntityList, error) {
 	switch entityType {
+	case "AccessToken":
+		return h.AccessTokenList(ctx)
+	case "AuditEvent":
+		return h.AuditEventList(ctx)
 	case "ChatBan":
 		return h.ChatBanList(ctx)
 	case "ChatMessage":
 		return h.ChatMessageList(ctx)
 	case "ChatRoom":
 		return h.ChatRoomList(ctx)
+	case "Credential":
+		return h.CredentialList(ctx)
+	case "Identity":
+		return h.IdentityList(ctx)
+	case "Invitation":
+		return h.InvitationList(ctx)
+	case "LoginAttempt":
+		return h.LoginAttemptList(ctx)
+	case "LoginToken":
+		return h.LoginTokenList(ctx)
+	case "Membership":
+		return h.MembershipList(ctx)
+	case "Organization":
+		return h.OrganizationList(ctx)
 	case "PasswordToken":
 		return h.PasswordTokenList(ctx)
 	case "PaymentCustomer":
 		return h.PaymentCustomerList(ctx)
+	case "PaymentEvent":
+		return h.PaymentEventList(ctx)
 	case "PaymentIntent":
 		return h.PaymentIntentList(ctx)
 	case "PaymentMethod":
 		return h.PaymentMethodList(ctx)
+	case "Permission":
+		return h.PermissionList(ctx)
+	case "RecoveryCode":
+		return h.RecoveryCodeList(ctx)
+	case "Role":
+		return h.RoleList(ctx)
+	case "Session":
+		return h.SessionList(ctx)
 	case "Subscription":
 		return h.SubscriptionList(ctx)
 	case "User":
@@ -162,6 +316,266 @@ func (h *Handler) List(ctx echo.Context, entityType string) (*EntityList, error)
 	}
 }
 
+func (h *Handler) AccessTokenCreate(ctx echo.Context) error {
+	var payload AccessToken
+	if err := h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := h.client.AccessToken.Create()
+	op.SetName(payload.Name)
+	if payload.Token != nil {
+		op.SetToken(*payload.Token)
+	}
+	op.SetScopes(payload.Scopes)
+	op.SetUserID(payload.UserID)
+	if payload.CreatedAt != nil {
+		op.SetCreatedAt(*payload.CreatedAt)
+	}
+	if payload.LastUsedAt != nil {
+		op.SetLastUsedAt(*payload.LastUsedAt)
+	}
+	if payload.ExpiresAt != nil {
+		op.SetExpiresAt(*payload.ExpiresAt)
+	}
+	_, err := op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) AccessTokenUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.AccessToken.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	var payload AccessToken
+	if err = h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := entity.Update()
+	op.SetName(payload.Name)
+	op.SetScopes(payload.Scopes)
+	op.SetUserID(payload.UserID)
+	op.SetNillableLastUsedAt(payload.LastUsedAt)
+	op.SetNillableExpiresAt(payload.ExpiresAt)
+	_, err = op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) AccessTokenDelete(ctx echo.Context, id int) error {
+	return h.client.AccessToken.DeleteOneID(id).
+		Exec(ctx.Request().Context())
+}
+
+func (h *Handler) AccessTokenList(ctx echo.Context) (*EntityList, error) {
+	page, offset := h.getPageAndOffset(ctx)
+	res, err := h.client.AccessToken.
+		Query().
+		Limit(h.Config.ItemsPerPage + 1).
+		Offset(offset).
+		Order(accesstoken.ByID(sql.OrderDesc())).
+		All(ctx.Request().Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	list := &EntityList{
+		Columns: []string{
+			"Name",
+			"Scopes",
+			"User ID",
+			"Created at",
+			"Last used at",
+			"Expires at",
+		},
+		Entities:    make([]EntityValues, 0, len(res)),
+		Page:        page,
+		HasNextPage: len(res) > h.Config.ItemsPerPage,
+	}
+
+	for i := 0; i <= len(res)-1; i++ {
+		list.Entities = append(list.Entities, EntityValues{
+			ID: res[i].ID,
+			Values: []string{
+				res[i].Name,
+				fmt.Sprint(res[i].Scopes),
+				fmt.Sprint(res[i].UserID),
+				res[i].CreatedAt.Format(h.Config.TimeFormat),
+				res[i].LastUsedAt.Format(h.Config.TimeFormat),
+				res[i].ExpiresAt.Format(h.Config.TimeFormat),
+			},
+		})
+	}
+
+	return list, err
+}
+
+func (h *Handler) AccessTokenGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.AccessToken.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	v.Set("name", entity.Name)
+	v.Set("scopes", fmt.Sprint(entity.Scopes))
+	v.Set("user_id", fmt.Sprint(entity.UserID))
+	v.Set("last_used_at", entity.LastUsedAt.Format(dateTimeFormat))
+	v.Set("expires_at", entity.ExpiresAt.Format(dateTimeFormat))
+	return v, err
+}
+
+func (h *Handler) AuditEventCreate(ctx echo.Context) error {
+	var payload AuditEvent
+	if err := h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := h.client.AuditEvent.Create()
+	op.SetAction(payload.Action)
+	if payload.ActorID != nil {
+		op.SetActorID(*payload.ActorID)
+	}
+	if payload.ImpersonatorID != nil {
+		op.SetImpersonatorID(*payload.ImpersonatorID)
+	}
+	if payload.EntityType != nil {
+		op.SetEntityType(*payload.EntityType)
+	}
+	if payload.EntityID != nil {
+		op.SetEntityID(*payload.EntityID)
+	}
+	if payload.Changes != nil {
+		op.SetChanges(*payload.Changes)
+	}
+	if payload.IPAddress != nil {
+		op.SetIPAddress(*payload.IPAddress)
+	}
+	if payload.RequestID != nil {
+		op.SetRequestID(*payload.RequestID)
+	}
+	if payload.CreatedAt != nil {
+		op.SetCreatedAt(*payload.CreatedAt)
+	}
+	_, err := op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) AuditEventUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.AuditEvent.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	var payload AuditEvent
+	if err = h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := entity.Update()
+	op.SetAction(payload.Action)
+	op.SetNillableActorID(payload.ActorID)
+	op.SetNillableImpersonatorID(payload.ImpersonatorID)
+	if payload.EntityType == nil {
+		op.ClearEntityType()
+	} else {
+		op.SetEntityType(*payload.EntityType)
+	}
+	op.SetNillableEntityID(payload.EntityID)
+	if payload.Changes == nil {
+		op.ClearChanges()
+	} else {
+		op.SetChanges(*payload.Changes)
+	}
+	if payload.IPAddress == nil {
+		op.ClearIPAddress()
+	} else {
+		op.SetIPAddress(*payload.IPAddress)
+	}
+	if payload.RequestID == nil {
+		op.ClearRequestID()
+	} else {
+		op.SetRequestID(*payload.RequestID)
+	}
+	_, err = op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) AuditEventDelete(ctx echo.Context, id int) error {
+	return h.client.AuditEvent.DeleteOneID(id).
+		Exec(ctx.Request().Context())
+}
+
+func (h *Handler) AuditEventList(ctx echo.Context) (*EntityList, error) {
+	page, offset := h.getPageAndOffset(ctx)
+	res, err := h.client.AuditEvent.
+		Query().
+		Limit(h.Config.ItemsPerPage + 1).
+		Offset(offset).
+		Order(auditevent.ByID(sql.OrderDesc())).
+		All(ctx.Request().Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	list := &EntityList{
+		Columns: []string{
+			"Action",
+			"Actor ID",
+			"Impersonator ID",
+			"Entity type",
+			"Entity ID",
+			"Changes",
+			"Ip address",
+			"Request ID",
+			"Created at",
+		},
+		Entities:    make([]EntityValues, 0, len(res)),
+		Page:        page,
+		HasNextPage: len(res) > h.Config.ItemsPerPage,
+	}
+
+	for i := 0; i <= len(res)-1; i++ {
+		list.Entities = append(list.Entities, EntityValues{
+			ID: res[i].ID,
+			Values: []string{
+				res[i].Action,
+				fmt.Sprint(res[i].ActorID),
+				fmt.Sprint(res[i].ImpersonatorID),
+				res[i].EntityType,
+				fmt.Sprint(res[i].EntityID),
+				fmt.Sprint(res[i].Changes),
+				res[i].IPAddress,
+				res[i].RequestID,
+				res[i].CreatedAt.Format(h.Config.TimeFormat),
+			},
+		})
+	}
+
+	return list, err
+}
+
+func (h *Handler) AuditEventGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.AuditEvent.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	v.Set("action", entity.Action)
+	v.Set("actor_id", fmt.Sprint(entity.ActorID))
+	v.Set("impersonator_id", fmt.Sprint(entity.ImpersonatorID))
+	v.Set("entity_type", entity.EntityType)
+	v.Set("entity_id", fmt.Sprint(entity.EntityID))
+	v.Set("changes", fmt.Sprint(entity.Changes))
+	v.Set("ip_address", entity.IPAddress)
+	v.Set("request_id", entity.RequestID)
+	return v, err
+}
+
 func (h *Handler) ChatBanCreate(ctx echo.Context) error {
 	var payload ChatBan
 	if err := h.bind(ctx, &payload); err != nil {
@@ -169,8 +583,8 @@ func (h *Handler) ChatBanCreate(ctx echo.Context) error {
 	}
 
 	op := h.client.ChatBan.Create()
-	if payload.IpHash != nil {
-		op.SetIPHash(*payload.IpHash)
+	if payload.IPHash != nil {
+		op.SetIPHash(*payload.IPHash)
 	}
 	if payload.Reason != nil {
 		op.SetReason(*payload.Reason)
@@ -194,10 +608,10 @@ func (h *Handler) ChatBanUpdate(ctx echo.Context, id int) error {
 	}
 
 	op := entity.Update()
-	if payload.IpHash == nil {
+	if payload.IPHash == nil {
 		op.ClearIPHash()
 	} else {
-		op.SetIPHash(*payload.IpHash)
+		op.SetIPHash(*payload.IPHash)
 	}
 	if payload.Reason == nil {
 		op.ClearReason()
@@ -447,62 +861,80 @@ func (h *Handler) ChatRoomGet(ctx echo.Context, id int) (url.Values, error) {
 	return v, err
 }
 
-func (h *Handler) PasswordTokenCreate(ctx echo.Context) error {
-	var payload PasswordToken
+func (h *Handler) CredentialCreate(ctx echo.Context) error {
+	var payload Credential
 	if err := h.bind(ctx, &payload); err != nil {
 		return err
 	}
 
-	op := h.client.PasswordToken.Create()
-	if payload.Token != nil {
-		op.SetToken(*payload.Token)
+	op := h.client.Credential.Create()
+	op.SetName(payload.Name)
+	op.SetCredentialID(payload.CredentialID)
+	if payload.PublicKey != nil {
+		op.SetPublicKey(*payload.PublicKey)
+	}
+	if payload.SignCount != nil {
+		op.SetSignCount(*payload.SignCount)
+	}
+	if payload.Aaguid != nil {
+		op.SetAaguid(*payload.Aaguid)
+	}
+	if payload.Transports != nil {
+		op.SetTransports(*payload.Transports)
 	}
 	op.SetUserID(payload.UserID)
 	if payload.CreatedAt != nil {
 		op.SetCreatedAt(*payload.CreatedAt)
 	}
+	if payload.LastUsedAt != nil {
+		op.SetLastUsedAt(*payload.LastUsedAt)
+	}
 	_, err := op.Save(ctx.Request().Context())
 	return err
 }
 
-func (h *Handler) PasswordTokenUpdate(ctx echo.Context, id int) error {
-	entity, err := h.client.PasswordToken.Get(ctx.Request().Context(), id)
+func (h *Handler) CredentialUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.Credential.Get(ctx.Request().Context(), id)
 	if err != nil {
 		return err
 	}
 
-	var payload PasswordToken
+	var payload Credential
 	if err = h.bind(ctx, &payload); err != nil {
 		return err
 	}
 
 	op := entity.Update()
-	if payload.Token != nil {
-		op.SetToken(*payload.Token)
+	op.SetName(payload.Name)
+	if payload.SignCount == nil {
+		var empty uint32
+		op.SetSignCount(empty)
+	} else {
+		op.SetSignCount(*payload.SignCount)
 	}
-	op.SetUserID(payload.UserID)
-	if payload.CreatedAt == nil {
-		var empty time.Time
-		op.SetCreatedAt(empty)
+	if payload.Transports == nil {
+		op.ClearTransports()
 	} else {
-		op.SetCreatedAt(*payload.CreatedAt)
+		op.SetTransports(*payload.Transports)
 	}
+	op.SetUserID(payload.UserID)
+	op.SetNillableLastUsedAt(payload.LastUsedAt)
 	_, err = op.Save(ctx.Request().Context())
 	return err
 }
 
-func (h *Handler) PasswordTokenDelete(ctx echo.Context, id int) error {
-	return h.client.PasswordToken.DeleteOneID(id).
+func (h *Handler) CredentialDelete(ctx echo.Context, id int) error {
+	return h.client.Credential.DeleteOneID(id).
 		Exec(ctx.Request().Context())
 }
 
-func (h *Handler) PasswordTokenList(ctx echo.Context) (*EntityList, error) {
+func (h *Handler) CredentialList(ctx echo.Context) (*EntityList, error) {
 	page, offset := h.getPageAndOffset(ctx)
-	res, err := h.client.PasswordToken.
+	res, err := h.client.Credential.
 		Query().
 		Limit(h.Config.ItemsPerPage + 1).
 		Offset(offset).
-		Order(passwordtoken.ByID(sql.OrderDesc())).
+		Order(credential.ByID(sql.OrderDesc())).
 		All(ctx.Request().Context())
 
 	if err != nil {
@@ -511,8 +943,14 @@ func (h *Handler) PasswordTokenList(ctx echo.Context) (*EntityList, error) {
 
 	list := &EntityList{
 		Columns: []string{
+			"Name",
+			"Credential ID",
+			"Sign count",
+			"Aaguid",
+			"Transports",
 			"User ID",
 			"Created at",
+			"Last used at",
 		},
 		Entities:    make([]EntityValues, 0, len(res)),
 		Page:        page,
@@ -523,8 +961,14 @@ func (h *Handler) PasswordTokenList(ctx echo.Context) (*EntityList, error) {
 		list.Entities = append(list.Entities, EntityValues{
 			ID: res[i].ID,
 			Values: []string{
+				res[i].Name,
+				fmt.Sprint(res[i].CredentialID),
+				fmt.Sprint(res[i].SignCount),
+				fmt.Sprint(res[i].Aaguid),
+				fmt.Sprint(res[i].Transports),
 				fmt.Sprint(res[i].UserID),
 				res[i].CreatedAt.Format(h.Config.TimeFormat),
+				res[i].LastUsedAt.Format(h.Config.TimeFormat),
 			},
 		})
 	}
@@ -532,98 +976,1507 @@ func (h *Handler) PasswordTokenList(ctx echo.Context) (*EntityList, error) {
 	return list, err
 }
 
-func (h *Handler) PasswordTokenGet(ctx echo.Context, id int) (url.Values, error) {
-	entity, err := h.client.PasswordToken.Get(ctx.Request().Context(), id)
+func (h *Handler) CredentialGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.Credential.Get(ctx.Request().Context(), id)
 	if err != nil {
 		return nil, err
 	}
 
 	v := url.Values{}
+	v.Set("name", entity.Name)
+	v.Set("sign_count", fmt.Sprint(entity.SignCount))
+	v.Set("transports", fmt.Sprint(entity.Transports))
 	v.Set("user_id", fmt.Sprint(entity.UserID))
-	v.Set("created_at", entity.CreatedAt.Format(dateTimeFormat))
+	v.Set("last_used_at", entity.LastUsedAt.Format(dateTimeFormat))
 	return v, err
 }
 
-func (h *Handler) PaymentCustomerCreate(ctx echo.Context) error {
-	var payload PaymentCustomer
+func (h *Handler) IdentityCreate(ctx echo.Context) error {
+	var payload Identity
 	if err := h.bind(ctx, &payload); err != nil {
 		return err
 	}
 
-	op := h.client.PaymentCustomer.Create()
-	op.SetProviderCustomerID(payload.ProviderCustomerID)
-	if payload.Provider != nil {
-		op.SetProvider(*payload.Provider)
-	}
-	op.SetEmail(payload.Email)
-	if payload.Name != nil {
-		op.SetName(*payload.Name)
-	}
-	if payload.Metadata != nil {
-		op.SetMetadata(*payload.Metadata)
+	op := h.client.Identity.Create()
+	op.SetProvider(payload.Provider)
+	op.SetSubject(payload.Subject)
+	if payload.Email != nil {
+		op.SetEmail(*payload.Email)
 	}
+	op.SetUserID(payload.UserID)
 	if payload.CreatedAt != nil {
 		op.SetCreatedAt(*payload.CreatedAt)
 	}
-	if payload.UpdatedAt != nil {
-		op.SetUpdatedAt(*payload.UpdatedAt)
+	if payload.LastLoginAt != nil {
+		op.SetLastLoginAt(*payload.LastLoginAt)
 	}
 	_, err := op.Save(ctx.Request().Context())
 	return err
 }
 
-func (h *Handler) PaymentCustomerUpdate(ctx echo.Context, id int) error {
-	entity, err := h.client.PaymentCustomer.Get(ctx.Request().Context(), id)
+func (h *Handler) IdentityUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.Identity.Get(ctx.Request().Context(), id)
 	if err != nil {
 		return err
 	}
 
-	var payload PaymentCustomer
+	var payload Identity
 	if err = h.bind(ctx, &payload); err != nil {
 		return err
 	}
 
 	op := entity.Update()
-	op.SetProviderCustomerID(payload.ProviderCustomerID)
-	if payload.Provider == nil {
-		var empty string
-		op.SetProvider(empty)
+	if payload.Email == nil {
+		op.ClearEmail()
 	} else {
-		op.SetProvider(*payload.Provider)
+		op.SetEmail(*payload.Email)
 	}
-	op.SetEmail(payload.Email)
-	if payload.Name == nil {
-		op.ClearName()
+	op.SetUserID(payload.UserID)
+	if payload.LastLoginAt == nil {
+		var empty time.Time
+		op.SetLastLoginAt(empty)
 	} else {
-		op.SetName(*payload.Name)
+		op.SetLastLoginAt(*payload.LastLoginAt)
+	}
+	_, err = op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) IdentityDelete(ctx echo.Context, id int) error {
+	return h.client.Identity.DeleteOneID(id).
+		Exec(ctx.Request().Context())
+}
+
+func (h *Handler) IdentityList(ctx echo.Context) (*EntityList, error) {
+	page, offset := h.getPageAndOffset(ctx)
+	res, err := h.client.Identity.
+		Query().
+		Limit(h.Config.ItemsPerPage + 1).
+		Offset(offset).
+		Order(identity.ByID(sql.OrderDesc())).
+		All(ctx.Request().Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	list := &EntityList{
+		Columns: []string{
+			"Provider",
+			"Subject",
+			"Email",
+			"User ID",
+			"Created at",
+			"Last login at",
+		},
+		Entities:    make([]EntityValues, 0, len(res)),
+		Page:        page,
+		HasNextPage: len(res) > h.Config.ItemsPerPage,
+	}
+
+	for i := 0; i <= len(res)-1; i++ {
+		list.Entities = append(list.Entities, EntityValues{
+			ID: res[i].ID,
+			Values: []string{
+				res[i].Provider,
+				res[i].Subject,
+				res[i].Email,
+				fmt.Sprint(res[i].UserID),
+				res[i].CreatedAt.Format(h.Config.TimeFormat),
+				res[i].LastLoginAt.Format(h.Config.TimeFormat),
+			},
+		})
+	}
+
+	return list, err
+}
+
+func (h *Handler) IdentityGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.Identity.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	v.Set("email", entity.Email)
+	v.Set("user_id", fmt.Sprint(entity.UserID))
+	v.Set("last_login_at", entity.LastLoginAt.Format(dateTimeFormat))
+	return v, err
+}
+
+func (h *Handler) InvitationCreate(ctx echo.Context) error {
+	var payload Invitation
+	if err := h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := h.client.Invitation.Create()
+	if payload.Token != nil {
+		op.SetToken(*payload.Token)
+	}
+	op.SetEmail(payload.Email)
+	if payload.Role != nil {
+		op.SetRole(*payload.Role)
+	}
+	op.SetOrganizationID(payload.OrganizationID)
+	if payload.InvitedByID != nil {
+		op.SetInvitedByID(*payload.InvitedByID)
+	}
+	if payload.CreatedAt != nil {
+		op.SetCreatedAt(*payload.CreatedAt)
+	}
+	op.SetExpiresAt(payload.ExpiresAt)
+	_, err := op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) InvitationUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.Invitation.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	var payload Invitation
+	if err = h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := entity.Update()
+	op.SetEmail(payload.Email)
+	if payload.Role == nil {
+		var empty invitation.Role
+		op.SetRole(empty)
+	} else {
+		op.SetRole(*payload.Role)
+	}
+	op.SetOrganizationID(payload.OrganizationID)
+	op.SetNillableInvitedByID(payload.InvitedByID)
+	op.SetExpiresAt(payload.ExpiresAt)
+	_, err = op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) InvitationDelete(ctx echo.Context, id int) error {
+	return h.client.Invitation.DeleteOneID(id).
+		Exec(ctx.Request().Context())
+}
+
+func (h *Handler) InvitationList(ctx echo.Context) (*EntityList, error) {
+	page, offset := h.getPageAndOffset(ctx)
+	res, err := h.client.Invitation.
+		Query().
+		Limit(h.Config.ItemsPerPage + 1).
+		Offset(offset).
+		Order(invitation.ByID(sql.OrderDesc())).
+		All(ctx.Request().Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	list := &EntityList{
+		Columns: []string{
+			"Email",
+			"Role",
+			"Organization ID",
+			"Invited by ID",
+			"Created at",
+			"Expires at",
+		},
+		Entities:    make([]EntityValues, 0, len(res)),
+		Page:        page,
+		HasNextPage: len(res) > h.Config.ItemsPerPage,
+	}
+
+	for i := 0; i <= len(res)-1; i++ {
+		list.Entities = append(list.Entities, EntityValues{
+			ID: res[i].ID,
+			Values: []string{
+				res[i].Email,
+				fmt.Sprint(res[i].Role),
+				fmt.Sprint(res[i].OrganizationID),
+				fmt.Sprint(res[i].InvitedByID),
+				res[i].CreatedAt.Format(h.Config.TimeFormat),
+				res[i].ExpiresAt.Format(h.Config.TimeFormat),
+			},
+		})
+	}
+
+	return list, err
+}
+
+func (h *Handler) InvitationGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.Invitation.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	v.Set("email", entity.Email)
+	v.Set("role", fmt.Sprint(entity.Role))
+	v.Set("organization_id", fmt.Sprint(entity.OrganizationID))
+	v.Set("invited_by_id", fmt.Sprint(entity.InvitedByID))
+	v.Set("expires_at", entity.ExpiresAt.Format(dateTimeFormat))
+	return v, err
+}
+
+func (h *Handler) LoginAttemptCreate(ctx echo.Context) error {
+	var payload LoginAttempt
+	if err := h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := h.client.LoginAttempt.Create()
+	op.SetEmail(payload.Email)
+	op.SetIPAddress(payload.IPAddress)
+	if payload.UserID != nil {
+		op.SetUserID(*payload.UserID)
+	}
+	if payload.CreatedAt != nil {
+		op.SetCreatedAt(*payload.CreatedAt)
+	}
+	_, err := op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) LoginAttemptUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.LoginAttempt.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	var payload LoginAttempt
+	if err = h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := entity.Update()
+	op.SetEmail(payload.Email)
+	op.SetIPAddress(payload.IPAddress)
+	op.SetNillableUserID(payload.UserID)
+	_, err = op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) LoginAttemptDelete(ctx echo.Context, id int) error {
+	return h.client.LoginAttempt.DeleteOneID(id).
+		Exec(ctx.Request().Context())
+}
+
+func (h *Handler) LoginAttemptList(ctx echo.Context) (*EntityList, error) {
+	page, offset := h.getPageAndOffset(ctx)
+	res, err := h.client.LoginAttempt.
+		Query().
+		Limit(h.Config.ItemsPerPage + 1).
+		Offset(offset).
+		Order(loginattempt.ByID(sql.OrderDesc())).
+		All(ctx.Request().Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	list := &EntityList{
+		Columns: []string{
+			"Email",
+			"Ip address",
+			"User ID",
+			"Created at",
+		},
+		Entities:    make([]EntityValues, 0, len(res)),
+		Page:        page,
+		HasNextPage: len(res) > h.Config.ItemsPerPage,
+	}
+
+	for i := 0; i <= len(res)-1; i++ {
+		list.Entities = append(list.Entities, EntityValues{
+			ID: res[i].ID,
+			Values: []string{
+				res[i].Email,
+				res[i].IPAddress,
+				fmt.Sprint(res[i].UserID),
+				res[i].CreatedAt.Format(h.Config.TimeFormat),
+			},
+		})
+	}
+
+	return list, err
+}
+
+func (h *Handler) LoginAttemptGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.LoginAttempt.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	v.Set("email", entity.Email)
+	v.Set("ip_address", entity.IPAddress)
+	v.Set("user_id", fmt.Sprint(entity.UserID))
+	return v, err
+}
+
+func (h *Handler) LoginTokenCreate(ctx echo.Context) error {
+	var payload LoginToken
+	if err := h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := h.client.LoginToken.Create()
+	if payload.Token != nil {
+		op.SetToken(*payload.Token)
+	}
+	op.SetUserID(payload.UserID)
+	if payload.CreatedAt != nil {
+		op.SetCreatedAt(*payload.CreatedAt)
+	}
+	_, err := op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) LoginTokenUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.LoginToken.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	var payload LoginToken
+	if err = h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := entity.Update()
+	if payload.Token != nil {
+		op.SetToken(*payload.Token)
+	}
+	op.SetUserID(payload.UserID)
+	_, err = op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) LoginTokenDelete(ctx echo.Context, id int) error {
+	return h.client.LoginToken.DeleteOneID(id).
+		Exec(ctx.Request().Context())
+}
+
+func (h *Handler) LoginTokenList(ctx echo.Context) (*EntityList, error) {
+	page, offset := h.getPageAndOffset(ctx)
+	res, err := h.client.LoginToken.
+		Query().
+		Limit(h.Config.ItemsPerPage + 1).
+		Offset(offset).
+		Order(logintoken.ByID(sql.OrderDesc())).
+		All(ctx.Request().Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	list := &EntityList{
+		Columns: []string{
+			"User ID",
+			"Created at",
+		},
+		Entities:    make([]EntityValues, 0, len(res)),
+		Page:        page,
+		HasNextPage: len(res) > h.Config.ItemsPerPage,
+	}
+
+	for i := 0; i <= len(res)-1; i++ {
+		list.Entities = append(list.Entities, EntityValues{
+			ID: res[i].ID,
+			Values: []string{
+				fmt.Sprint(res[i].UserID),
+				res[i].CreatedAt.Format(h.Config.TimeFormat),
+			},
+		})
+	}
+
+	return list, err
+}
+
+func (h *Handler) LoginTokenGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.LoginToken.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	v.Set("user_id", fmt.Sprint(entity.UserID))
+	return v, err
+}
+
+func (h *Handler) MembershipCreate(ctx echo.Context) error {
+	var payload Membership
+	if err := h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := h.client.Membership.Create()
+	op.SetOrganizationID(payload.OrganizationID)
+	op.SetUserID(payload.UserID)
+	if payload.Role != nil {
+		op.SetRole(*payload.Role)
+	}
+	if payload.CreatedAt != nil {
+		op.SetCreatedAt(*payload.CreatedAt)
+	}
+	_, err := op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) MembershipUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.Membership.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	var payload Membership
+	if err = h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := entity.Update()
+	op.SetOrganizationID(payload.OrganizationID)
+	op.SetUserID(payload.UserID)
+	if payload.Role == nil {
+		var empty membership.Role
+		op.SetRole(empty)
+	} else {
+		op.SetRole(*payload.Role)
+	}
+	_, err = op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) MembershipDelete(ctx echo.Context, id int) error {
+	return h.client.Membership.DeleteOneID(id).
+		Exec(ctx.Request().Context())
+}
+
+func (h *Handler) MembershipList(ctx echo.Context) (*EntityList, error) {
+	page, offset := h.getPageAndOffset(ctx)
+	res, err := h.client.Membership.
+		Query().
+		Limit(h.Config.ItemsPerPage + 1).
+		Offset(offset).
+		Order(membership.ByID(sql.OrderDesc())).
+		All(ctx.Request().Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	list := &EntityList{
+		Columns: []string{
+			"Organization ID",
+			"User ID",
+			"Role",
+			"Created at",
+		},
+		Entities:    make([]EntityValues, 0, len(res)),
+		Page:        page,
+		HasNextPage: len(res) > h.Config.ItemsPerPage,
+	}
+
+	for i := 0; i <= len(res)-1; i++ {
+		list.Entities = append(list.Entities, EntityValues{
+			ID: res[i].ID,
+			Values: []string{
+				fmt.Sprint(res[i].OrganizationID),
+				fmt.Sprint(res[i].UserID),
+				fmt.Sprint(res[i].Role),
+				res[i].CreatedAt.Format(h.Config.TimeFormat),
+			},
+		})
+	}
+
+	return list, err
+}
+
+func (h *Handler) MembershipGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.Membership.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	v.Set("organization_id", fmt.Sprint(entity.OrganizationID))
+	v.Set("user_id", fmt.Sprint(entity.UserID))
+	v.Set("role", fmt.Sprint(entity.Role))
+	return v, err
+}
+
+func (h *Handler) OrganizationCreate(ctx echo.Context) error {
+	var payload Organization
+	if err := h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := h.client.Organization.Create()
+	op.SetName(payload.Name)
+	if payload.CreatedAt != nil {
+		op.SetCreatedAt(*payload.CreatedAt)
+	}
+	_, err := op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) OrganizationUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.Organization.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	var payload Organization
+	if err = h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := entity.Update()
+	op.SetName(payload.Name)
+	_, err = op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) OrganizationDelete(ctx echo.Context, id int) error {
+	return h.client.Organization.DeleteOneID(id).
+		Exec(ctx.Request().Context())
+}
+
+func (h *Handler) OrganizationList(ctx echo.Context) (*EntityList, error) {
+	page, offset := h.getPageAndOffset(ctx)
+	res, err := h.client.Organization.
+		Query().
+		Limit(h.Config.ItemsPerPage + 1).
+		Offset(offset).
+		Order(organization.ByID(sql.OrderDesc())).
+		All(ctx.Request().Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	list := &EntityList{
+		Columns: []string{
+			"Name",
+			"Created at",
+		},
+		Entities:    make([]EntityValues, 0, len(res)),
+		Page:        page,
+		HasNextPage: len(res) > h.Config.ItemsPerPage,
+	}
+
+	for i := 0; i <= len(res)-1; i++ {
+		list.Entities = append(list.Entities, EntityValues{
+			ID: res[i].ID,
+			Values: []string{
+				res[i].Name,
+				res[i].CreatedAt.Format(h.Config.TimeFormat),
+			},
+		})
+	}
+
+	return list, err
+}
+
+func (h *Handler) OrganizationGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.Organization.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	v.Set("name", entity.Name)
+	return v, err
+}
+
+func (h *Handler) PasswordTokenCreate(ctx echo.Context) error {
+	var payload PasswordToken
+	if err := h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := h.client.PasswordToken.Create()
+	if payload.Token != nil {
+		op.SetToken(*payload.Token)
+	}
+	op.SetUserID(payload.UserID)
+	if payload.CreatedAt != nil {
+		op.SetCreatedAt(*payload.CreatedAt)
+	}
+	_, err := op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) PasswordTokenUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.PasswordToken.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	var payload PasswordToken
+	if err = h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := entity.Update()
+	if payload.Token != nil {
+		op.SetToken(*payload.Token)
+	}
+	op.SetUserID(payload.UserID)
+	if payload.CreatedAt == nil {
+		var empty time.Time
+		op.SetCreatedAt(empty)
+	} else {
+		op.SetCreatedAt(*payload.CreatedAt)
+	}
+	_, err = op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) PasswordTokenDelete(ctx echo.Context, id int) error {
+	return h.client.PasswordToken.DeleteOneID(id).
+		Exec(ctx.Request().Context())
+}
+
+func (h *Handler) PasswordTokenList(ctx echo.Context) (*EntityList, error) {
+	page, offset := h.getPageAndOffset(ctx)
+	res, err := h.client.PasswordToken.
+		Query().
+		Limit(h.Config.ItemsPerPage + 1).
+		Offset(offset).
+		Order(passwordtoken.ByID(sql.OrderDesc())).
+		All(ctx.Request().Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	list := &EntityList{
+		Columns: []string{
+			"User ID",
+			"Created at",
+		},
+		Entities:    make([]EntityValues, 0, len(res)),
+		Page:        page,
+		HasNextPage: len(res) > h.Config.ItemsPerPage,
+	}
+
+	for i := 0; i <= len(res)-1; i++ {
+		list.Entities = append(list.Entities, EntityValues{
+			ID: res[i].ID,
+			Values: []string{
+				fmt.Sprint(res[i].UserID),
+				res[i].CreatedAt.Format(h.Config.TimeFormat),
+			},
+		})
+	}
+
+	return list, err
+}
+
+func (h *Handler) PasswordTokenGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.PasswordToken.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	v.Set("user_id", fmt.Sprint(entity.UserID))
+	v.Set("created_at", entity.CreatedAt.Format(dateTimeFormat))
+	return v, err
+}
+
+func (h *Handler) PaymentCustomerCreate(ctx echo.Context) error {
+	var payload PaymentCustomer
+	if err := h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := h.client.PaymentCustomer.Create()
+	op.SetProviderCustomerID(payload.ProviderCustomerID)
+	if payload.Provider != nil {
+		op.SetProvider(*payload.Provider)
+	}
+	op.SetEmail(payload.Email)
+	if payload.Name != nil {
+		op.SetName(*payload.Name)
+	}
+	if payload.Metadata != nil {
+		op.SetMetadata(*payload.Metadata)
+	}
+	if payload.CreatedAt != nil {
+		op.SetCreatedAt(*payload.CreatedAt)
+	}
+	if payload.UpdatedAt != nil {
+		op.SetUpdatedAt(*payload.UpdatedAt)
+	}
+	_, err := op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) PaymentCustomerUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.PaymentCustomer.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	var payload PaymentCustomer
+	if err = h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := entity.Update()
+	op.SetProviderCustomerID(payload.ProviderCustomerID)
+	if payload.Provider == nil {
+		var empty string
+		op.SetProvider(empty)
+	} else {
+		op.SetProvider(*payload.Provider)
+	}
+	op.SetEmail(payload.Email)
+	if payload.Name == nil {
+		op.ClearName()
+	} else {
+		op.SetName(*payload.Name)
+	}
+	if payload.Metadata == nil {
+		op.ClearMetadata()
+	} else {
+		op.SetMetadata(*payload.Metadata)
+	}
+	if payload.UpdatedAt == nil {
+		var empty time.Time
+		op.SetUpdatedAt(empty)
+	} else {
+		op.SetUpdatedAt(*payload.UpdatedAt)
+	}
+	_, err = op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) PaymentCustomerDelete(ctx echo.Context, id int) error {
+	return h.client.PaymentCustomer.DeleteOneID(id).
+		Exec(ctx.Request().Context())
+}
+
+func (h *Handler) PaymentCustomerList(ctx echo.Context) (*EntityList, error) {
+	page, offset := h.getPageAndOffset(ctx)
+	res, err := h.client.PaymentCustomer.
+		Query().
+		Limit(h.Config.ItemsPerPage + 1).
+		Offset(offset).
+		Order(paymentcustomer.ByID(sql.OrderDesc())).
+		All(ctx.Request().Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	list := &EntityList{
+		Columns: []string{
+			"Provider customer ID",
+			"Provider",
+			"Email",
+			"Name",
+			"Metadata",
+			"Created at",
+			"Updated at",
+		},
+		Entities:    make([]EntityValues, 0, len(res)),
+		Page:        page,
+		HasNextPage: len(res) > h.Config.ItemsPerPage,
+	}
+
+	for i := 0; i <= len(res)-1; i++ {
+		list.Entities = append(list.Entities, EntityValues{
+			ID: res[i].ID,
+			Values: []string{
+				res[i].ProviderCustomerID,
+				res[i].Provider,
+				res[i].Email,
+				res[i].Name,
+				fmt.Sprint(res[i].Metadata),
+				res[i].CreatedAt.Format(h.Config.TimeFormat),
+				res[i].UpdatedAt.Format(h.Config.TimeFormat),
+			},
+		})
+	}
+
+	return list, err
+}
+
+func (h *Handler) PaymentCustomerGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.PaymentCustomer.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	v.Set("provider_customer_id", entity.ProviderCustomerID)
+	v.Set("provider", entity.Provider)
+	v.Set("email", entity.Email)
+	v.Set("name", entity.Name)
+	v.Set("metadata", fmt.Sprint(entity.Metadata))
+	v.Set("updated_at", entity.UpdatedAt.Format(dateTimeFormat))
+	return v, err
+}
+
+func (h *Handler) PaymentEventCreate(ctx echo.Context) error {
+	var payload PaymentEvent
+	if err := h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := h.client.PaymentEvent.Create()
+	if payload.Provider != nil {
+		op.SetProvider(*payload.Provider)
+	}
+	op.SetEventID(payload.EventID)
+	op.SetType(payload.Type)
+	if payload.Payload != nil {
+		op.SetPayload(*payload.Payload)
+	}
+	if payload.Attempts != nil {
+		op.SetAttempts(*payload.Attempts)
+	}
+	if payload.ProcessedAt != nil {
+		op.SetProcessedAt(*payload.ProcessedAt)
+	}
+	if payload.Error != nil {
+		op.SetError(*payload.Error)
+	}
+	if payload.CreatedAt != nil {
+		op.SetCreatedAt(*payload.CreatedAt)
+	}
+	if payload.UpdatedAt != nil {
+		op.SetUpdatedAt(*payload.UpdatedAt)
+	}
+	_, err := op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) PaymentEventUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.PaymentEvent.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	var payload PaymentEvent
+	if err = h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := entity.Update()
+	if payload.Attempts == nil {
+		var empty int
+		op.SetAttempts(empty)
+	} else {
+		op.SetAttempts(*payload.Attempts)
+	}
+	if payload.ProcessedAt == nil {
+		op.ClearProcessedAt()
+	} else {
+		op.SetProcessedAt(*payload.ProcessedAt)
+	}
+	if payload.Error == nil {
+		op.ClearError()
+	} else {
+		op.SetError(*payload.Error)
+	}
+	if payload.UpdatedAt == nil {
+		var empty time.Time
+		op.SetUpdatedAt(empty)
+	} else {
+		op.SetUpdatedAt(*payload.UpdatedAt)
+	}
+	_, err = op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) PaymentEventDelete(ctx echo.Context, id int) error {
+	return h.client.PaymentEvent.DeleteOneID(id).
+		Exec(ctx.Request().Context())
+}
+
+func (h *Handler) PaymentEventList(ctx echo.Context) (*EntityList, error) {
+	page, offset := h.getPageAndOffset(ctx)
+	res, err := h.client.PaymentEvent.
+		Query().
+		Limit(h.Config.ItemsPerPage + 1).
+		Offset(offset).
+		Order(paymentevent.ByID(sql.OrderDesc())).
+		All(ctx.Request().Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	list := &EntityList{
+		Columns: []string{
+			"Provider",
+			"Event ID",
+			"Type",
+			"Attempts",
+			"Processed at",
+			"Error",
+			"Created at",
+			"Updated at",
+		},
+		Entities:    make([]EntityValues, 0, len(res)),
+		Page:        page,
+		HasNextPage: len(res) > h.Config.ItemsPerPage,
+	}
+
+	for i := 0; i <= len(res)-1; i++ {
+		list.Entities = append(list.Entities, EntityValues{
+			ID: res[i].ID,
+			Values: []string{
+				res[i].Provider,
+				res[i].EventID,
+				res[i].Type,
+				fmt.Sprint(res[i].Attempts),
+				res[i].ProcessedAt.Format(h.Config.TimeFormat),
+				res[i].Error,
+				res[i].CreatedAt.Format(h.Config.TimeFormat),
+				res[i].UpdatedAt.Format(h.Config.TimeFormat),
+			},
+		})
+	}
+
+	return list, err
+}
+
+func (h *Handler) PaymentEventGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.PaymentEvent.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	v.Set("attempts", fmt.Sprint(entity.Attempts))
+	v.Set("processed_at", entity.ProcessedAt.Format(dateTimeFormat))
+	v.Set("error", entity.Error)
+	v.Set("updated_at", entity.UpdatedAt.Format(dateTimeFormat))
+	return v, err
+}
+
+func (h *Handler) PaymentIntentCreate(ctx echo.Context) error {
+	var payload PaymentIntent
+	if err := h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := h.client.PaymentIntent.Create()
+	op.SetProviderPaymentIntentID(payload.ProviderPaymentIntentID)
+	if payload.Provider != nil {
+		op.SetProvider(*payload.Provider)
+	}
+	if payload.Status != nil {
+		op.SetStatus(*payload.Status)
+	}
+	op.SetAmount(payload.Amount)
+	if payload.Currency != nil {
+		op.SetCurrency(*payload.Currency)
+	}
+	if payload.Description != nil {
+		op.SetDescription(*payload.Description)
+	}
+	if payload.ClientSecret != nil {
+		op.SetClientSecret(*payload.ClientSecret)
+	}
+	if payload.Metadata != nil {
+		op.SetMetadata(*payload.Metadata)
+	}
+	if payload.SyncedAt != nil {
+		op.SetSyncedAt(*payload.SyncedAt)
+	}
+	if payload.CreatedAt != nil {
+		op.SetCreatedAt(*payload.CreatedAt)
+	}
+	if payload.UpdatedAt != nil {
+		op.SetUpdatedAt(*payload.UpdatedAt)
+	}
+	_, err := op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) PaymentIntentUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.PaymentIntent.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	var payload PaymentIntent
+	if err = h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := entity.Update()
+	op.SetProviderPaymentIntentID(payload.ProviderPaymentIntentID)
+	if payload.Provider == nil {
+		var empty string
+		op.SetProvider(empty)
+	} else {
+		op.SetProvider(*payload.Provider)
+	}
+	if payload.Status == nil {
+		var empty paymentintent.Status
+		op.SetStatus(empty)
+	} else {
+		op.SetStatus(*payload.Status)
+	}
+	op.SetAmount(payload.Amount)
+	if payload.Currency == nil {
+		var empty string
+		op.SetCurrency(empty)
+	} else {
+		op.SetCurrency(*payload.Currency)
+	}
+	if payload.Description == nil {
+		op.ClearDescription()
+	} else {
+		op.SetDescription(*payload.Description)
+	}
+	if payload.ClientSecret != nil {
+		op.SetClientSecret(*payload.ClientSecret)
+	}
+	if payload.Metadata == nil {
+		op.ClearMetadata()
+	} else {
+		op.SetMetadata(*payload.Metadata)
+	}
+	if payload.SyncedAt == nil {
+		op.ClearSyncedAt()
+	} else {
+		op.SetSyncedAt(*payload.SyncedAt)
+	}
+	if payload.UpdatedAt == nil {
+		var empty time.Time
+		op.SetUpdatedAt(empty)
+	} else {
+		op.SetUpdatedAt(*payload.UpdatedAt)
+	}
+	_, err = op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) PaymentIntentDelete(ctx echo.Context, id int) error {
+	return h.client.PaymentIntent.DeleteOneID(id).
+		Exec(ctx.Request().Context())
+}
+
+func (h *Handler) PaymentIntentList(ctx echo.Context) (*EntityList, error) {
+	page, offset := h.getPageAndOffset(ctx)
+	res, err := h.client.PaymentIntent.
+		Query().
+		Limit(h.Config.ItemsPerPage + 1).
+		Offset(offset).
+		Order(paymentintent.ByID(sql.OrderDesc())).
+		All(ctx.Request().Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	list := &EntityList{
+		Columns: []string{
+			"Provider payment intent ID",
+			"Provider",
+			"Status",
+			"Amount",
+			"Currency",
+			"Description",
+			"Metadata",
+			"Synced at",
+			"Created at",
+			"Updated at",
+		},
+		Entities:    make([]EntityValues, 0, len(res)),
+		Page:        page,
+		HasNextPage: len(res) > h.Config.ItemsPerPage,
+	}
+
+	for i := 0; i <= len(res)-1; i++ {
+		list.Entities = append(list.Entities, EntityValues{
+			ID: res[i].ID,
+			Values: []string{
+				res[i].ProviderPaymentIntentID,
+				res[i].Provider,
+				fmt.Sprint(res[i].Status),
+				fmt.Sprint(res[i].Amount),
+				res[i].Currency,
+				res[i].Description,
+				fmt.Sprint(res[i].Metadata),
+				res[i].SyncedAt.Format(h.Config.TimeFormat),
+				res[i].CreatedAt.Format(h.Config.TimeFormat),
+				res[i].UpdatedAt.Format(h.Config.TimeFormat),
+			},
+		})
+	}
+
+	return list, err
+}
+
+func (h *Handler) PaymentIntentGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.PaymentIntent.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	v.Set("provider_payment_intent_id", entity.ProviderPaymentIntentID)
+	v.Set("provider", entity.Provider)
+	v.Set("status", fmt.Sprint(entity.Status))
+	v.Set("amount", fmt.Sprint(entity.Amount))
+	v.Set("currency", entity.Currency)
+	v.Set("description", entity.Description)
+	v.Set("metadata", fmt.Sprint(entity.Metadata))
+	v.Set("synced_at", entity.SyncedAt.Format(dateTimeFormat))
+	v.Set("updated_at", entity.UpdatedAt.Format(dateTimeFormat))
+	return v, err
+}
+
+func (h *Handler) PaymentMethodCreate(ctx echo.Context) error {
+	var payload PaymentMethod
+	if err := h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := h.client.PaymentMethod.Create()
+	op.SetProviderPaymentMethodID(payload.ProviderPaymentMethodID)
+	if payload.Provider != nil {
+		op.SetProvider(*payload.Provider)
+	}
+	if payload.Type != nil {
+		op.SetType(*payload.Type)
+	}
+	if payload.LastFour != nil {
+		op.SetLastFour(*payload.LastFour)
+	}
+	if payload.Brand != nil {
+		op.SetBrand(*payload.Brand)
+	}
+	if payload.ExpMonth != nil {
+		op.SetExpMonth(*payload.ExpMonth)
+	}
+	if payload.ExpYear != nil {
+		op.SetExpYear(*payload.ExpYear)
+	}
+	op.SetIsDefault(payload.IsDefault)
+	if payload.Metadata != nil {
+		op.SetMetadata(*payload.Metadata)
+	}
+	if payload.SyncedAt != nil {
+		op.SetSyncedAt(*payload.SyncedAt)
+	}
+	if payload.CreatedAt != nil {
+		op.SetCreatedAt(*payload.CreatedAt)
+	}
+	if payload.UpdatedAt != nil {
+		op.SetUpdatedAt(*payload.UpdatedAt)
+	}
+	_, err := op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) PaymentMethodUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.PaymentMethod.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	var payload PaymentMethod
+	if err = h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := entity.Update()
+	op.SetProviderPaymentMethodID(payload.ProviderPaymentMethodID)
+	if payload.Provider == nil {
+		var empty string
+		op.SetProvider(empty)
+	} else {
+		op.SetProvider(*payload.Provider)
+	}
+	if payload.Type == nil {
+		var empty paymentmethod.Type
+		op.SetType(empty)
+	} else {
+		op.SetType(*payload.Type)
+	}
+	if payload.LastFour == nil {
+		op.ClearLastFour()
+	} else {
+		op.SetLastFour(*payload.LastFour)
+	}
+	if payload.Brand == nil {
+		op.ClearBrand()
+	} else {
+		op.SetBrand(*payload.Brand)
+	}
+	if payload.ExpMonth == nil {
+		op.ClearExpMonth()
+	} else {
+		op.SetExpMonth(*payload.ExpMonth)
+	}
+	if payload.ExpYear == nil {
+		op.ClearExpYear()
+	} else {
+		op.SetExpYear(*payload.ExpYear)
+	}
+	op.SetIsDefault(payload.IsDefault)
+	if payload.Metadata == nil {
+		op.ClearMetadata()
+	} else {
+		op.SetMetadata(*payload.Metadata)
+	}
+	if payload.SyncedAt == nil {
+		op.ClearSyncedAt()
+	} else {
+		op.SetSyncedAt(*payload.SyncedAt)
+	}
+	if payload.UpdatedAt == nil {
+		var empty time.Time
+		op.SetUpdatedAt(empty)
+	} else {
+		op.SetUpdatedAt(*payload.UpdatedAt)
+	}
+	_, err = op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) PaymentMethodDelete(ctx echo.Context, id int) error {
+	return h.client.PaymentMethod.DeleteOneID(id).
+		Exec(ctx.Request().Context())
+}
+
+func (h *Handler) PaymentMethodList(ctx echo.Context) (*EntityList, error) {
+	page, offset := h.getPageAndOffset(ctx)
+	res, err := h.client.PaymentMethod.
+		Query().
+		Limit(h.Config.ItemsPerPage + 1).
+		Offset(offset).
+		Order(paymentmethod.ByID(sql.OrderDesc())).
+		All(ctx.Request().Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	list := &EntityList{
+		Columns: []string{
+			"Provider payment method ID",
+			"Provider",
+			"Type",
+			"Last four",
+			"Brand",
+			"Exp month",
+			"Exp year",
+			"Is default",
+			"Metadata",
+			"Synced at",
+			"Created at",
+			"Updated at",
+		},
+		Entities:    make([]EntityValues, 0, len(res)),
+		Page:        page,
+		HasNextPage: len(res) > h.Config.ItemsPerPage,
+	}
+
+	for i := 0; i <= len(res)-1; i++ {
+		list.Entities = append(list.Entities, EntityValues{
+			ID: res[i].ID,
+			Values: []string{
+				res[i].ProviderPaymentMethodID,
+				res[i].Provider,
+				fmt.Sprint(res[i].Type),
+				res[i].LastFour,
+				res[i].Brand,
+				fmt.Sprint(res[i].ExpMonth),
+				fmt.Sprint(res[i].ExpYear),
+				fmt.Sprint(res[i].IsDefault),
+				fmt.Sprint(res[i].Metadata),
+				res[i].SyncedAt.Format(h.Config.TimeFormat),
+				res[i].CreatedAt.Format(h.Config.TimeFormat),
+				res[i].UpdatedAt.Format(h.Config.TimeFormat),
+			},
+		})
+	}
+
+	return list, err
+}
+
+func (h *Handler) PaymentMethodGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.PaymentMethod.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	v.Set("provider_payment_method_id", entity.ProviderPaymentMethodID)
+	v.Set("provider", entity.Provider)
+	v.Set("type", fmt.Sprint(entity.Type))
+	v.Set("last_four", entity.LastFour)
+	v.Set("brand", entity.Brand)
+	v.Set("exp_month", fmt.Sprint(entity.ExpMonth))
+	v.Set("exp_year", fmt.Sprint(entity.ExpYear))
+	v.Set("is_default", fmt.Sprint(entity.IsDefault))
+	v.Set("metadata", fmt.Sprint(entity.Metadata))
+	v.Set("synced_at", entity.SyncedAt.Format(dateTimeFormat))
+	v.Set("updated_at", entity.UpdatedAt.Format(dateTimeFormat))
+	return v, err
+}
+
+func (h *Handler) PermissionCreate(ctx echo.Context) error {
+	var payload Permission
+	if err := h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := h.client.Permission.Create()
+	op.SetName(payload.Name)
+	if payload.Description != nil {
+		op.SetDescription(*payload.Description)
+	}
+	_, err := op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) PermissionUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.Permission.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	var payload Permission
+	if err = h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := entity.Update()
+	op.SetName(payload.Name)
+	if payload.Description == nil {
+		op.ClearDescription()
+	} else {
+		op.SetDescription(*payload.Description)
+	}
+	_, err = op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) PermissionDelete(ctx echo.Context, id int) error {
+	return h.client.Permission.DeleteOneID(id).
+		Exec(ctx.Request().Context())
+}
+
+func (h *Handler) PermissionList(ctx echo.Context) (*EntityList, error) {
+	page, offset := h.getPageAndOffset(ctx)
+	res, err := h.client.Permission.
+		Query().
+		Limit(h.Config.ItemsPerPage + 1).
+		Offset(offset).
+		Order(permission.ByID(sql.OrderDesc())).
+		All(ctx.Request().Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	list := &EntityList{
+		Columns: []string{
+			"Name",
+			"Description",
+		},
+		Entities:    make([]EntityValues, 0, len(res)),
+		Page:        page,
+		HasNextPage: len(res) > h.Config.ItemsPerPage,
+	}
+
+	for i := 0; i <= len(res)-1; i++ {
+		list.Entities = append(list.Entities, EntityValues{
+			ID: res[i].ID,
+			Values: []string{
+				res[i].Name,
+				res[i].Description,
+			},
+		})
+	}
+
+	return list, err
+}
+
+func (h *Handler) PermissionGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.Permission.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	v.Set("name", entity.Name)
+	v.Set("description", entity.Description)
+	return v, err
+}
+
+func (h *Handler) RecoveryCodeCreate(ctx echo.Context) error {
+	var payload RecoveryCode
+	if err := h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := h.client.RecoveryCode.Create()
+	if payload.Code != nil {
+		op.SetCode(*payload.Code)
+	}
+	op.SetUserID(payload.UserID)
+	if payload.UsedAt != nil {
+		op.SetUsedAt(*payload.UsedAt)
+	}
+	if payload.CreatedAt != nil {
+		op.SetCreatedAt(*payload.CreatedAt)
+	}
+	_, err := op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) RecoveryCodeUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.RecoveryCode.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	var payload RecoveryCode
+	if err = h.bind(ctx, &payload); err != nil {
+		return err
 	}
-	if payload.Metadata == nil {
-		op.ClearMetadata()
-	} else {
-		op.SetMetadata(*payload.Metadata)
+
+	op := entity.Update()
+	if payload.Code != nil {
+		op.SetCode(*payload.Code)
 	}
-	if payload.UpdatedAt == nil {
-		var empty time.Time
-		op.SetUpdatedAt(empty)
+	op.SetUserID(payload.UserID)
+	if payload.UsedAt == nil {
+		op.ClearUsedAt()
 	} else {
-		op.SetUpdatedAt(*payload.UpdatedAt)
+		op.SetUsedAt(*payload.UsedAt)
 	}
 	_, err = op.Save(ctx.Request().Context())
 	return err
 }
 
-func (h *Handler) PaymentCustomerDelete(ctx echo.Context, id int) error {
-	return h.client.PaymentCustomer.DeleteOneID(id).
+func (h *Handler) RecoveryCodeDelete(ctx echo.Context, id int) error {
+	return h.client.RecoveryCode.DeleteOneID(id).
 		Exec(ctx.Request().Context())
 }
 
-func (h *Handler) PaymentCustomerList(ctx echo.Context) (*EntityList, error) {
+func (h *Handler) RecoveryCodeList(ctx echo.Context) (*EntityList, error) {
 	page, offset := h.getPageAndOffset(ctx)
-	res, err := h.client.PaymentCustomer.
+	res, err := h.client.RecoveryCode.
 		Query().
 		Limit(h.Config.ItemsPerPage + 1).
 		Offset(offset).
-		Order(paymentcustomer.ByID(sql.OrderDesc())).
+		Order(recoverycode.ByID(sql.OrderDesc())).
 		All(ctx.Request().Context())
 
 	if err != nil {
@@ -632,13 +2485,9 @@ func (h *Handler) PaymentCustomerList(ctx echo.Context) (*EntityList, error) {
 
 	list := &EntityList{
 		Columns: []string{
-			"Provider customer ID",
-			"Provider",
-			"Email",
-			"Name",
-			"Metadata",
+			"User ID",
+			"Used at",
 			"Created at",
-			"Updated at",
 		},
 		Entities:    make([]EntityValues, 0, len(res)),
 		Page:        page,
@@ -649,13 +2498,9 @@ func (h *Handler) PaymentCustomerList(ctx echo.Context) (*EntityList, error) {
 		list.Entities = append(list.Entities, EntityValues{
 			ID: res[i].ID,
 			Values: []string{
-				res[i].ProviderCustomerID,
-				res[i].Provider,
-				res[i].Email,
-				res[i].Name,
-				fmt.Sprint(res[i].Metadata),
+				fmt.Sprint(res[i].UserID),
+				res[i].UsedAt.Format(h.Config.TimeFormat),
 				res[i].CreatedAt.Format(h.Config.TimeFormat),
-				res[i].UpdatedAt.Format(h.Config.TimeFormat),
 			},
 		})
 	}
@@ -663,126 +2508,70 @@ func (h *Handler) PaymentCustomerList(ctx echo.Context) (*EntityList, error) {
 	return list, err
 }
 
-func (h *Handler) PaymentCustomerGet(ctx echo.Context, id int) (url.Values, error) {
-	entity, err := h.client.PaymentCustomer.Get(ctx.Request().Context(), id)
+func (h *Handler) RecoveryCodeGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.RecoveryCode.Get(ctx.Request().Context(), id)
 	if err != nil {
 		return nil, err
 	}
 
 	v := url.Values{}
-	v.Set("provider_customer_id", entity.ProviderCustomerID)
-	v.Set("provider", entity.Provider)
-	v.Set("email", entity.Email)
-	v.Set("name", entity.Name)
-	v.Set("metadata", fmt.Sprint(entity.Metadata))
-	v.Set("updated_at", entity.UpdatedAt.Format(dateTimeFormat))
+	v.Set("user_id", fmt.Sprint(entity.UserID))
+	v.Set("used_at", entity.UsedAt.Format(dateTimeFormat))
 	return v, err
 }
 
-func (h *Handler) PaymentIntentCreate(ctx echo.Context) error {
-	var payload PaymentIntent
+func (h *Handler) RoleCreate(ctx echo.Context) error {
+	var payload Role
 	if err := h.bind(ctx, &payload); err != nil {
 		return err
 	}
 
-	op := h.client.PaymentIntent.Create()
-	op.SetProviderPaymentIntentID(payload.ProviderPaymentIntentID)
-	if payload.Provider != nil {
-		op.SetProvider(*payload.Provider)
-	}
-	if payload.Status != nil {
-		op.SetStatus(*payload.Status)
-	}
-	op.SetAmount(payload.Amount)
-	if payload.Currency != nil {
-		op.SetCurrency(*payload.Currency)
-	}
+	op := h.client.Role.Create()
+	op.SetName(payload.Name)
 	if payload.Description != nil {
 		op.SetDescription(*payload.Description)
 	}
-	if payload.ClientSecret != nil {
-		op.SetClientSecret(*payload.ClientSecret)
-	}
-	if payload.Metadata != nil {
-		op.SetMetadata(*payload.Metadata)
-	}
 	if payload.CreatedAt != nil {
 		op.SetCreatedAt(*payload.CreatedAt)
 	}
-	if payload.UpdatedAt != nil {
-		op.SetUpdatedAt(*payload.UpdatedAt)
-	}
 	_, err := op.Save(ctx.Request().Context())
 	return err
 }
 
-func (h *Handler) PaymentIntentUpdate(ctx echo.Context, id int) error {
-	entity, err := h.client.PaymentIntent.Get(ctx.Request().Context(), id)
+func (h *Handler) RoleUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.Role.Get(ctx.Request().Context(), id)
 	if err != nil {
 		return err
 	}
 
-	var payload PaymentIntent
+	var payload Role
 	if err = h.bind(ctx, &payload); err != nil {
 		return err
 	}
 
 	op := entity.Update()
-	op.SetProviderPaymentIntentID(payload.ProviderPaymentIntentID)
-	if payload.Provider == nil {
-		var empty string
-		op.SetProvider(empty)
-	} else {
-		op.SetProvider(*payload.Provider)
-	}
-	if payload.Status == nil {
-		var empty paymentintent.Status
-		op.SetStatus(empty)
-	} else {
-		op.SetStatus(*payload.Status)
-	}
-	op.SetAmount(payload.Amount)
-	if payload.Currency == nil {
-		var empty string
-		op.SetCurrency(empty)
-	} else {
-		op.SetCurrency(*payload.Currency)
-	}
+	op.SetName(payload.Name)
 	if payload.Description == nil {
 		op.ClearDescription()
 	} else {
 		op.SetDescription(*payload.Description)
 	}
-	if payload.ClientSecret != nil {
-		op.SetClientSecret(*payload.ClientSecret)
-	}
-	if payload.Metadata == nil {
-		op.ClearMetadata()
-	} else {
-		op.SetMetadata(*payload.Metadata)
-	}
-	if payload.UpdatedAt == nil {
-		var empty time.Time
-		op.SetUpdatedAt(empty)
-	} else {
-		op.SetUpdatedAt(*payload.UpdatedAt)
-	}
 	_, err = op.Save(ctx.Request().Context())
 	return err
 }
 
-func (h *Handler) PaymentIntentDelete(ctx echo.Context, id int) error {
-	return h.client.PaymentIntent.DeleteOneID(id).
+func (h *Handler) RoleDelete(ctx echo.Context, id int) error {
+	return h.client.Role.DeleteOneID(id).
 		Exec(ctx.Request().Context())
 }
 
-func (h *Handler) PaymentIntentList(ctx echo.Context) (*EntityList, error) {
+func (h *Handler) RoleList(ctx echo.Context) (*EntityList, error) {
 	page, offset := h.getPageAndOffset(ctx)
-	res, err := h.client.PaymentIntent.
+	res, err := h.client.Role.
 		Query().
 		Limit(h.Config.ItemsPerPage + 1).
 		Offset(offset).
-		Order(paymentintent.ByID(sql.OrderDesc())).
+		Order(role.ByID(sql.OrderDesc())).
 		All(ctx.Request().Context())
 
 	if err != nil {
@@ -791,15 +2580,9 @@ func (h *Handler) PaymentIntentList(ctx echo.Context) (*EntityList, error) {
 
 	list := &EntityList{
 		Columns: []string{
-			"Provider payment intent ID",
-			"Provider",
-			"Status",
-			"Amount",
-			"Currency",
+			"Name",
 			"Description",
-			"Metadata",
 			"Created at",
-			"Updated at",
 		},
 		Entities:    make([]EntityValues, 0, len(res)),
 		Page:        page,
@@ -810,15 +2593,9 @@ func (h *Handler) PaymentIntentList(ctx echo.Context) (*EntityList, error) {
 		list.Entities = append(list.Entities, EntityValues{
 			ID: res[i].ID,
 			Values: []string{
-				res[i].ProviderPaymentIntentID,
-				res[i].Provider,
-				fmt.Sprint(res[i].Status),
-				fmt.Sprint(res[i].Amount),
-				res[i].Currency,
+				res[i].Name,
 				res[i].Description,
-				fmt.Sprint(res[i].Metadata),
 				res[i].CreatedAt.Format(h.Config.TimeFormat),
-				res[i].UpdatedAt.Format(h.Config.TimeFormat),
 			},
 		})
 	}
@@ -826,137 +2603,101 @@ func (h *Handler) PaymentIntentList(ctx echo.Context) (*EntityList, error) {
 	return list, err
 }
 
-func (h *Handler) PaymentIntentGet(ctx echo.Context, id int) (url.Values, error) {
-	entity, err := h.client.PaymentIntent.Get(ctx.Request().Context(), id)
+func (h *Handler) RoleGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.Role.Get(ctx.Request().Context(), id)
 	if err != nil {
 		return nil, err
 	}
 
 	v := url.Values{}
-	v.Set("provider_payment_intent_id", entity.ProviderPaymentIntentID)
-	v.Set("provider", entity.Provider)
-	v.Set("status", fmt.Sprint(entity.Status))
-	v.Set("amount", fmt.Sprint(entity.Amount))
-	v.Set("currency", entity.Currency)
+	v.Set("name", entity.Name)
 	v.Set("description", entity.Description)
-	v.Set("metadata", fmt.Sprint(entity.Metadata))
-	v.Set("updated_at", entity.UpdatedAt.Format(dateTimeFormat))
 	return v, err
 }
 
-func (h *Handler) PaymentMethodCreate(ctx echo.Context) error {
-	var payload PaymentMethod
+func (h *Handler) SessionCreate(ctx echo.Context) error {
+	var payload Session
 	if err := h.bind(ctx, &payload); err != nil {
 		return err
 	}
 
-	op := h.client.PaymentMethod.Create()
-	op.SetProviderPaymentMethodID(payload.ProviderPaymentMethodID)
-	if payload.Provider != nil {
-		op.SetProvider(*payload.Provider)
-	}
-	if payload.Type != nil {
-		op.SetType(*payload.Type)
-	}
-	if payload.LastFour != nil {
-		op.SetLastFour(*payload.LastFour)
+	op := h.client.Session.Create()
+	if payload.Token != nil {
+		op.SetToken(*payload.Token)
 	}
-	if payload.Brand != nil {
-		op.SetBrand(*payload.Brand)
+	op.SetName(payload.Name)
+	if payload.Data != nil {
+		op.SetData(*payload.Data)
 	}
-	if payload.ExpMonth != nil {
-		op.SetExpMonth(*payload.ExpMonth)
+	if payload.UserID != nil {
+		op.SetUserID(*payload.UserID)
 	}
-	if payload.ExpYear != nil {
-		op.SetExpYear(*payload.ExpYear)
+	if payload.UserAgent != nil {
+		op.SetUserAgent(*payload.UserAgent)
 	}
-	op.SetIsDefault(payload.IsDefault)
-	if payload.Metadata != nil {
-		op.SetMetadata(*payload.Metadata)
+	if payload.IPAddress != nil {
+		op.SetIPAddress(*payload.IPAddress)
 	}
 	if payload.CreatedAt != nil {
 		op.SetCreatedAt(*payload.CreatedAt)
 	}
-	if payload.UpdatedAt != nil {
-		op.SetUpdatedAt(*payload.UpdatedAt)
+	if payload.LastSeenAt != nil {
+		op.SetLastSeenAt(*payload.LastSeenAt)
 	}
+	op.SetExpiresAt(payload.ExpiresAt)
 	_, err := op.Save(ctx.Request().Context())
 	return err
 }
 
-func (h *Handler) PaymentMethodUpdate(ctx echo.Context, id int) error {
-	entity, err := h.client.PaymentMethod.Get(ctx.Request().Context(), id)
+func (h *Handler) SessionUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.Session.Get(ctx.Request().Context(), id)
 	if err != nil {
 		return err
 	}
 
-	var payload PaymentMethod
+	var payload Session
 	if err = h.bind(ctx, &payload); err != nil {
 		return err
 	}
 
 	op := entity.Update()
-	op.SetProviderPaymentMethodID(payload.ProviderPaymentMethodID)
-	if payload.Provider == nil {
-		var empty string
-		op.SetProvider(empty)
-	} else {
-		op.SetProvider(*payload.Provider)
-	}
-	if payload.Type == nil {
-		var empty paymentmethod.Type
-		op.SetType(empty)
-	} else {
-		op.SetType(*payload.Type)
+	if payload.Data != nil {
+		op.SetData(*payload.Data)
 	}
-	if payload.LastFour == nil {
-		op.ClearLastFour()
-	} else {
-		op.SetLastFour(*payload.LastFour)
-	}
-	if payload.Brand == nil {
-		op.ClearBrand()
-	} else {
-		op.SetBrand(*payload.Brand)
-	}
-	if payload.ExpMonth == nil {
-		op.ClearExpMonth()
-	} else {
-		op.SetExpMonth(*payload.ExpMonth)
-	}
-	if payload.ExpYear == nil {
-		op.ClearExpYear()
+	op.SetNillableUserID(payload.UserID)
+	if payload.UserAgent == nil {
+		op.ClearUserAgent()
 	} else {
-		op.SetExpYear(*payload.ExpYear)
+		op.SetUserAgent(*payload.UserAgent)
 	}
-	op.SetIsDefault(payload.IsDefault)
-	if payload.Metadata == nil {
-		op.ClearMetadata()
+	if payload.IPAddress == nil {
+		op.ClearIPAddress()
 	} else {
-		op.SetMetadata(*payload.Metadata)
+		op.SetIPAddress(*payload.IPAddress)
 	}
-	if payload.UpdatedAt == nil {
+	if payload.LastSeenAt == nil {
 		var empty time.Time
-		op.SetUpdatedAt(empty)
+		op.SetLastSeenAt(empty)
 	} else {
-		op.SetUpdatedAt(*payload.UpdatedAt)
+		op.SetLastSeenAt(*payload.LastSeenAt)
 	}
+	op.SetExpiresAt(payload.ExpiresAt)
 	_, err = op.Save(ctx.Request().Context())
 	return err
 }
 
-func (h *Handler) PaymentMethodDelete(ctx echo.Context, id int) error {
-	return h.client.PaymentMethod.DeleteOneID(id).
+func (h *Handler) SessionDelete(ctx echo.Context, id int) error {
+	return h.client.Session.DeleteOneID(id).
 		Exec(ctx.Request().Context())
 }
 
-func (h *Handler) PaymentMethodList(ctx echo.Context) (*EntityList, error) {
+func (h *Handler) SessionList(ctx echo.Context) (*EntityList, error) {
 	page, offset := h.getPageAndOffset(ctx)
-	res, err := h.client.PaymentMethod.
+	res, err := h.client.Session.
 		Query().
 		Limit(h.Config.ItemsPerPage + 1).
 		Offset(offset).
-		Order(paymentmethod.ByID(sql.OrderDesc())).
+		Order(session.ByID(sql.OrderDesc())).
 		All(ctx.Request().Context())
 
 	if err != nil {
@@ -965,17 +2706,13 @@ func (h *Handler) PaymentMethodList(ctx echo.Context) (*EntityList, error) {
 
 	list := &EntityList{
 		Columns: []string{
-			"Provider payment method ID",
-			"Provider",
-			"Type",
-			"Last four",
-			"Brand",
-			"Exp month",
-			"Exp year",
-			"Is default",
-			"Metadata",
+			"Name",
+			"User ID",
+			"User agent",
+			"Ip address",
 			"Created at",
-			"Updated at",
+			"Last seen at",
+			"Expires at",
 		},
 		Entities:    make([]EntityValues, 0, len(res)),
 		Page:        page,
@@ -986,17 +2723,13 @@ func (h *Handler) PaymentMethodList(ctx echo.Context) (*EntityList, error) {
 		list.Entities = append(list.Entities, EntityValues{
 			ID: res[i].ID,
 			Values: []string{
-				res[i].ProviderPaymentMethodID,
-				res[i].Provider,
-				fmt.Sprint(res[i].Type),
-				res[i].LastFour,
-				res[i].Brand,
-				fmt.Sprint(res[i].ExpMonth),
-				fmt.Sprint(res[i].ExpYear),
-				fmt.Sprint(res[i].IsDefault),
-				fmt.Sprint(res[i].Metadata),
+				res[i].Name,
+				fmt.Sprint(res[i].UserID),
+				res[i].UserAgent,
+				res[i].IPAddress,
 				res[i].CreatedAt.Format(h.Config.TimeFormat),
-				res[i].UpdatedAt.Format(h.Config.TimeFormat),
+				res[i].LastSeenAt.Format(h.Config.TimeFormat),
+				res[i].ExpiresAt.Format(h.Config.TimeFormat),
 			},
 		})
 	}
@@ -1004,23 +2737,18 @@ func (h *Handler) PaymentMethodList(ctx echo.Context) (*EntityList, error) {
 	return list, err
 }
 
-func (h *Handler) PaymentMethodGet(ctx echo.Context, id int) (url.Values, error) {
-	entity, err := h.client.PaymentMethod.Get(ctx.Request().Context(), id)
+func (h *Handler) SessionGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.Session.Get(ctx.Request().Context(), id)
 	if err != nil {
 		return nil, err
 	}
 
 	v := url.Values{}
-	v.Set("provider_payment_method_id", entity.ProviderPaymentMethodID)
-	v.Set("provider", entity.Provider)
-	v.Set("type", fmt.Sprint(entity.Type))
-	v.Set("last_four", entity.LastFour)
-	v.Set("brand", entity.Brand)
-	v.Set("exp_month", fmt.Sprint(entity.ExpMonth))
-	v.Set("exp_year", fmt.Sprint(entity.ExpYear))
-	v.Set("is_default", fmt.Sprint(entity.IsDefault))
-	v.Set("metadata", fmt.Sprint(entity.Metadata))
-	v.Set("updated_at", entity.UpdatedAt.Format(dateTimeFormat))
+	v.Set("user_id", fmt.Sprint(entity.UserID))
+	v.Set("user_agent", entity.UserAgent)
+	v.Set("ip_address", entity.IPAddress)
+	v.Set("last_seen_at", entity.LastSeenAt.Format(dateTimeFormat))
+	v.Set("expires_at", entity.ExpiresAt.Format(dateTimeFormat))
 	return v, err
 }
 
@@ -1068,6 +2796,9 @@ func (h *Handler) SubscriptionCreate(ctx echo.Context) error {
 	if payload.Metadata != nil {
 		op.SetMetadata(*payload.Metadata)
 	}
+	if payload.SyncedAt != nil {
+		op.SetSyncedAt(*payload.SyncedAt)
+	}
 	if payload.CreatedAt != nil {
 		op.SetCreatedAt(*payload.CreatedAt)
 	}
@@ -1153,6 +2884,11 @@ func (h *Handler) SubscriptionUpdate(ctx echo.Context, id int) error {
 	} else {
 		op.SetMetadata(*payload.Metadata)
 	}
+	if payload.SyncedAt == nil {
+		op.ClearSyncedAt()
+	} else {
+		op.SetSyncedAt(*payload.SyncedAt)
+	}
 	if payload.UpdatedAt == nil {
 		var empty time.Time
 		op.SetUpdatedAt(empty)
@@ -1198,6 +2934,7 @@ func (h *Handler) SubscriptionList(ctx echo.Context) (*EntityList, error) {
 			"Canceled at",
 			"Ended at",
 			"Metadata",
+			"Synced at",
 			"Created at",
 			"Updated at",
 		},
@@ -1225,6 +2962,7 @@ func (h *Handler) SubscriptionList(ctx echo.Context) (*EntityList, error) {
 				res[i].CanceledAt.Format(h.Config.TimeFormat),
 				res[i].EndedAt.Format(h.Config.TimeFormat),
 				fmt.Sprint(res[i].Metadata),
+				res[i].SyncedAt.Format(h.Config.TimeFormat),
 				res[i].CreatedAt.Format(h.Config.TimeFormat),
 				res[i].UpdatedAt.Format(h.Config.TimeFormat),
 			},
@@ -1256,6 +2994,7 @@ func (h *Handler) SubscriptionGet(ctx echo.Context, id int) (url.Values, error)
 	v.Set("canceled_at", entity.CanceledAt.Format(dateTimeFormat))
 	v.Set("ended_at", entity.EndedAt.Format(dateTimeFormat))
 	v.Set("metadata", fmt.Sprint(entity.Metadata))
+	v.Set("synced_at", entity.SyncedAt.Format(dateTimeFormat))
 	v.Set("updated_at", entity.UpdatedAt.Format(dateTimeFormat))
 	return v, err
 }
@@ -1269,11 +3008,25 @@ func (h *Handler) UserCreate(ctx echo.Context) error {
 	op := h.client.User.Create()
 	op.SetName(payload.Name)
 	op.SetEmail(payload.Email)
+	if payload.PendingEmail != nil {
+		op.SetPendingEmail(*payload.PendingEmail)
+	}
 	if payload.Password != nil {
 		op.SetPassword(*payload.Password)
 	}
 	op.SetVerified(payload.Verified)
-	op.SetAdmin(payload.Admin)
+	if payload.TotpSecret != nil {
+		op.SetTotpSecret(*payload.TotpSecret)
+	}
+	if payload.TotpLastStep != nil {
+		op.SetTotpLastStep(*payload.TotpLastStep)
+	}
+	if payload.LockedUntil != nil {
+		op.SetLockedUntil(*payload.LockedUntil)
+	}
+	if payload.DeletedAt != nil {
+		op.SetDeletedAt(*payload.DeletedAt)
+	}
 	if payload.CreatedAt != nil {
 		op.SetCreatedAt(*payload.CreatedAt)
 	}
@@ -1295,11 +3048,26 @@ func (h *Handler) UserUpdate(ctx echo.Context, id int) error {
 	op := entity.Update()
 	op.SetName(payload.Name)
 	op.SetEmail(payload.Email)
+	if payload.PendingEmail == nil {
+		op.ClearPendingEmail()
+	} else {
+		op.SetPendingEmail(*payload.PendingEmail)
+	}
 	if payload.Password != nil {
 		op.SetPassword(*payload.Password)
 	}
 	op.SetVerified(payload.Verified)
-	op.SetAdmin(payload.Admin)
+	if payload.TotpSecret != nil {
+		op.SetTotpSecret(*payload.TotpSecret)
+	}
+	if payload.TotpLastStep == nil {
+		var empty int64
+		op.SetTotpLastStep(empty)
+	} else {
+		op.SetTotpLastStep(*payload.TotpLastStep)
+	}
+	op.SetNillableLockedUntil(payload.LockedUntil)
+	op.SetNillableDeletedAt(payload.DeletedAt)
 	_, err = op.Save(ctx.Request().Context())
 	return err
 }
@@ -1326,8 +3094,11 @@ func (h *Handler) UserList(ctx echo.Context) (*EntityList, error) {
 		Columns: []string{
 			"Name",
 			"Email",
+			"Pending email",
 			"Verified",
-			"Admin",
+			"Totp last step",
+			"Locked until",
+			"Deleted at",
 			"Created at",
 		},
 		Entities:    make([]EntityValues, 0, len(res)),
@@ -1341,8 +3112,11 @@ func (h *Handler) UserList(ctx echo.Context) (*EntityList, error) {
 			Values: []string{
 				res[i].Name,
 				res[i].Email,
+				res[i].PendingEmail,
 				fmt.Sprint(res[i].Verified),
-				fmt.Sprint(res[i].Admin),
+				fmt.Sprint(res[i].TotpLastStep),
+				res[i].LockedUntil.Format(h.Config.TimeFormat),
+				res[i].DeletedAt.Format(h.Config.TimeFormat),
 				res[i].CreatedAt.Format(h.Config.TimeFormat),
 			},
 		})
@@ -1360,8 +3134,11 @@ func (h *Handler) UserGet(ctx echo.Context, id int) (url.Values, error) {
 	v := url.Values{}
 	v.Set("name", entity.Name)
 	v.Set("email", entity.Email)
+	v.Set("pending_email", entity.PendingEmail)
 	v.Set("verified", fmt.Sprint(entity.Verified))
-	v.Set("admin", fmt.Sprint(entity.Admin))
+	v.Set("totp_last_step", fmt.Sprint(entity.TotpLastStep))
+	v.Set("locked_until", entity.LockedUntil.Format(dateTimeFormat))
+	v.Set("deleted_at", entity.DeletedAt.Format(dateTimeFormat))
 	return v, err
 }
 
@@ -4,13 +4,37 @@ package admin
 import (
 	"time"
 
+	"github.com/occult/pagode/ent/invitation"
+	"github.com/occult/pagode/ent/membership"
 	"github.com/occult/pagode/ent/paymentintent"
 	"github.com/occult/pagode/ent/paymentmethod"
 	"github.com/occult/pagode/ent/subscription"
 )
 
+type AccessToken struct {
+	Name       string     `form:"name"`
+	Token      *string    `form:"token"`
+	Scopes     []string   `form:"scopes"`
+	UserID     int        `form:"user_id"`
+	CreatedAt  *time.Time `form:"created_at"`
+	LastUsedAt *time.Time `form:"last_used_at"`
+	ExpiresAt  *time.Time `form:"expires_at"`
+}
+
+type AuditEvent struct {
+	Action         string                  `form:"action"`
+	ActorID        *int                    `form:"actor_id"`
+	ImpersonatorID *int                    `form:"impersonator_id"`
+	EntityType     *string                 `form:"entity_type"`
+	EntityID       *int                    `form:"entity_id"`
+	Changes        *map[string]interface{} `form:"changes"`
+	IPAddress      *string                 `form:"ip_address"`
+	RequestID      *string                 `form:"request_id"`
+	CreatedAt      *time.Time              `form:"created_at"`
+}
+
 type ChatBan struct {
-	IpHash    *string    `form:"ip_hash"`
+	IPHash    *string    `form:"ip_hash"`
 	Reason    *string    `form:"reason"`
 	CreatedAt *time.Time `form:"created_at"`
 }
@@ -28,6 +52,62 @@ type ChatRoom struct {
 	CreatedAt    *time.Time `form:"created_at"`
 }
 
+type Credential struct {
+	Name         string     `form:"name"`
+	CredentialID []byte     `form:"credential_id"`
+	PublicKey    *[]byte    `form:"public_key"`
+	SignCount    *uint32    `form:"sign_count"`
+	Aaguid       *[]byte    `form:"aaguid"`
+	Transports   *[]string  `form:"transports"`
+	UserID       int        `form:"user_id"`
+	CreatedAt    *time.Time `form:"created_at"`
+	LastUsedAt   *time.Time `form:"last_used_at"`
+}
+
+type Identity struct {
+	Provider    string     `form:"provider"`
+	Subject     string     `form:"subject"`
+	Email       *string    `form:"email"`
+	UserID      int        `form:"user_id"`
+	CreatedAt   *time.Time `form:"created_at"`
+	LastLoginAt *time.Time `form:"last_login_at"`
+}
+
+type Invitation struct {
+	Token          *string          `form:"token"`
+	Email          string           `form:"email"`
+	Role           *invitation.Role `form:"role"`
+	OrganizationID int              `form:"organization_id"`
+	InvitedByID    *int             `form:"invited_by_id"`
+	CreatedAt      *time.Time       `form:"created_at"`
+	ExpiresAt      time.Time        `form:"expires_at"`
+}
+
+type LoginAttempt struct {
+	Email     string     `form:"email"`
+	IPAddress string     `form:"ip_address"`
+	UserID    *int       `form:"user_id"`
+	CreatedAt *time.Time `form:"created_at"`
+}
+
+type LoginToken struct {
+	Token     *string    `form:"token"`
+	UserID    int        `form:"user_id"`
+	CreatedAt *time.Time `form:"created_at"`
+}
+
+type Membership struct {
+	OrganizationID int              `form:"organization_id"`
+	UserID         int              `form:"user_id"`
+	Role           *membership.Role `form:"role"`
+	CreatedAt      *time.Time       `form:"created_at"`
+}
+
+type Organization struct {
+	Name      string     `form:"name"`
+	CreatedAt *time.Time `form:"created_at"`
+}
+
 type PasswordToken struct {
 	Token     *string    `form:"token"`
 	UserID    int        `form:"user_id"`
@@ -44,6 +124,18 @@ type PaymentCustomer struct {
 	UpdatedAt          *time.Time              `form:"updated_at"`
 }
 
+type PaymentEvent struct {
+	Provider    *string    `form:"provider"`
+	EventID     string     `form:"event_id"`
+	Type        string     `form:"type"`
+	Payload     *string    `form:"payload"`
+	Attempts    *int       `form:"attempts"`
+	ProcessedAt *time.Time `form:"processed_at"`
+	Error       *string    `form:"error"`
+	CreatedAt   *time.Time `form:"created_at"`
+	UpdatedAt   *time.Time `form:"updated_at"`
+}
+
 type PaymentIntent struct {
 	ProviderPaymentIntentID string                  `form:"provider_payment_intent_id"`
 	Provider                *string                 `form:"provider"`
@@ -53,6 +145,7 @@ type PaymentIntent struct {
 	Description             *string                 `form:"description"`
 	ClientSecret            *string                 `form:"client_secret"`
 	Metadata                *map[string]interface{} `form:"metadata"`
+	SyncedAt                *time.Time              `form:"synced_at"`
 	CreatedAt               *time.Time              `form:"created_at"`
 	UpdatedAt               *time.Time              `form:"updated_at"`
 }
@@ -67,10 +160,41 @@ type PaymentMethod struct {
 	ExpYear                 *int                    `form:"exp_year"`
 	IsDefault               bool                    `form:"is_default"`
 	Metadata                *map[string]interface{} `form:"metadata"`
+	SyncedAt                *time.Time              `form:"synced_at"`
 	CreatedAt               *time.Time              `form:"created_at"`
 	UpdatedAt               *time.Time              `form:"updated_at"`
 }
 
+type Permission struct {
+	Name        string  `form:"name"`
+	Description *string `form:"description"`
+}
+
+type RecoveryCode struct {
+	Code      *string    `form:"code"`
+	UserID    int        `form:"user_id"`
+	UsedAt    *time.Time `form:"used_at"`
+	CreatedAt *time.Time `form:"created_at"`
+}
+
+type Role struct {
+	Name        string     `form:"name"`
+	Description *string    `form:"description"`
+	CreatedAt   *time.Time `form:"created_at"`
+}
+
+type Session struct {
+	Token      *string    `form:"token"`
+	Name       string     `form:"name"`
+	Data       *[]byte    `form:"data"`
+	UserID     *int       `form:"user_id"`
+	UserAgent  *string    `form:"user_agent"`
+	IPAddress  *string    `form:"ip_address"`
+	CreatedAt  *time.Time `form:"created_at"`
+	LastSeenAt *time.Time `form:"last_seen_at"`
+	ExpiresAt  time.Time  `form:"expires_at"`
+}
+
 type Subscription struct {
 	ProviderSubscriptionID string                  `form:"provider_subscription_id"`
 	Provider               *string                 `form:"provider"`
@@ -87,17 +211,22 @@ type Subscription struct {
 	CanceledAt             *time.Time              `form:"canceled_at"`
 	EndedAt                *time.Time              `form:"ended_at"`
 	Metadata               *map[string]interface{} `form:"metadata"`
+	SyncedAt               *time.Time              `form:"synced_at"`
 	CreatedAt              *time.Time              `form:"created_at"`
 	UpdatedAt              *time.Time              `form:"updated_at"`
 }
 
 type User struct {
-	Name      string     `form:"name"`
-	Email     string     `form:"email"`
-	Password  *string    `form:"password"`
-	Verified  bool       `form:"verified"`
-	Admin     bool       `form:"admin"`
-	CreatedAt *time.Time `form:"created_at"`
+	Name         string     `form:"name"`
+	Email        string     `form:"email"`
+	PendingEmail *string    `form:"pending_email"`
+	Password     *string    `form:"password"`
+	Verified     bool       `form:"verified"`
+	TotpSecret   *string    `form:"totp_secret"`
+	TotpLastStep *int64     `form:"totp_last_step"`
+	LockedUntil  *time.Time `form:"locked_until"`
+	DeletedAt    *time.Time `form:"deleted_at"`
+	CreatedAt    *time.Time `form:"created_at"`
 }
 
 type EntityList struct {
@@ -120,13 +249,27 @@ type HandlerConfig struct {
 
 func GetEntityTypeNames() []string {
 	return []string{
+		"AccessToken",
+		"AuditEvent",
 		"ChatBan",
 		"ChatMessage",
 		"ChatRoom",
+		"Credential",
+		"Identity",
+		"Invitation",
+		"LoginAttempt",
+		"LoginToken",
+		"Membership",
+		"Organization",
 		"PasswordToken",
 		"PaymentCustomer",
+		"PaymentEvent",
 		"PaymentIntent",
 		"PaymentMethod",
+		"Permission",
+		"RecoveryCode",
+		"Role",
+		"Session",
 		"Subscription",
 		"User",
 	}
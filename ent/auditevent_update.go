@@ -0,0 +1,643 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/occult/pagode/ent/auditevent"
+	"github.com/occult/pagode/ent/predicate"
+)
+
+// AuditEventUpdate is the builder for updating AuditEvent entities.
+type AuditEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AuditEventMutation
+}
+
+// Where appends a list predicates to the AuditEventUpdate builder.
+func (_u *AuditEventUpdate) Where(ps ...predicate.AuditEvent) *AuditEventUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *AuditEventUpdate) SetAction(v string) *AuditEventUpdate {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *AuditEventUpdate) SetNillableAction(v *string) *AuditEventUpdate {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetActorID sets the "actor_id" field.
+func (_u *AuditEventUpdate) SetActorID(v int) *AuditEventUpdate {
+	_u.mutation.ResetActorID()
+	_u.mutation.SetActorID(v)
+	return _u
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (_u *AuditEventUpdate) SetNillableActorID(v *int) *AuditEventUpdate {
+	if v != nil {
+		_u.SetActorID(*v)
+	}
+	return _u
+}
+
+// AddActorID adds value to the "actor_id" field.
+func (_u *AuditEventUpdate) AddActorID(v int) *AuditEventUpdate {
+	_u.mutation.AddActorID(v)
+	return _u
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (_u *AuditEventUpdate) ClearActorID() *AuditEventUpdate {
+	_u.mutation.ClearActorID()
+	return _u
+}
+
+// SetImpersonatorID sets the "impersonator_id" field.
+func (_u *AuditEventUpdate) SetImpersonatorID(v int) *AuditEventUpdate {
+	_u.mutation.ResetImpersonatorID()
+	_u.mutation.SetImpersonatorID(v)
+	return _u
+}
+
+// SetNillableImpersonatorID sets the "impersonator_id" field if the given value is not nil.
+func (_u *AuditEventUpdate) SetNillableImpersonatorID(v *int) *AuditEventUpdate {
+	if v != nil {
+		_u.SetImpersonatorID(*v)
+	}
+	return _u
+}
+
+// AddImpersonatorID adds value to the "impersonator_id" field.
+func (_u *AuditEventUpdate) AddImpersonatorID(v int) *AuditEventUpdate {
+	_u.mutation.AddImpersonatorID(v)
+	return _u
+}
+
+// ClearImpersonatorID clears the value of the "impersonator_id" field.
+func (_u *AuditEventUpdate) ClearImpersonatorID() *AuditEventUpdate {
+	_u.mutation.ClearImpersonatorID()
+	return _u
+}
+
+// SetEntityType sets the "entity_type" field.
+func (_u *AuditEventUpdate) SetEntityType(v string) *AuditEventUpdate {
+	_u.mutation.SetEntityType(v)
+	return _u
+}
+
+// SetNillableEntityType sets the "entity_type" field if the given value is not nil.
+func (_u *AuditEventUpdate) SetNillableEntityType(v *string) *AuditEventUpdate {
+	if v != nil {
+		_u.SetEntityType(*v)
+	}
+	return _u
+}
+
+// ClearEntityType clears the value of the "entity_type" field.
+func (_u *AuditEventUpdate) ClearEntityType() *AuditEventUpdate {
+	_u.mutation.ClearEntityType()
+	return _u
+}
+
+// SetEntityID sets the "entity_id" field.
+func (_u *AuditEventUpdate) SetEntityID(v int) *AuditEventUpdate {
+	_u.mutation.ResetEntityID()
+	_u.mutation.SetEntityID(v)
+	return _u
+}
+
+// SetNillableEntityID sets the "entity_id" field if the given value is not nil.
+func (_u *AuditEventUpdate) SetNillableEntityID(v *int) *AuditEventUpdate {
+	if v != nil {
+		_u.SetEntityID(*v)
+	}
+	return _u
+}
+
+// AddEntityID adds value to the "entity_id" field.
+func (_u *AuditEventUpdate) AddEntityID(v int) *AuditEventUpdate {
+	_u.mutation.AddEntityID(v)
+	return _u
+}
+
+// ClearEntityID clears the value of the "entity_id" field.
+func (_u *AuditEventUpdate) ClearEntityID() *AuditEventUpdate {
+	_u.mutation.ClearEntityID()
+	return _u
+}
+
+// SetChanges sets the "changes" field.
+func (_u *AuditEventUpdate) SetChanges(v map[string]interface{}) *AuditEventUpdate {
+	_u.mutation.SetChanges(v)
+	return _u
+}
+
+// ClearChanges clears the value of the "changes" field.
+func (_u *AuditEventUpdate) ClearChanges() *AuditEventUpdate {
+	_u.mutation.ClearChanges()
+	return _u
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (_u *AuditEventUpdate) SetIPAddress(v string) *AuditEventUpdate {
+	_u.mutation.SetIPAddress(v)
+	return _u
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (_u *AuditEventUpdate) SetNillableIPAddress(v *string) *AuditEventUpdate {
+	if v != nil {
+		_u.SetIPAddress(*v)
+	}
+	return _u
+}
+
+// ClearIPAddress clears the value of the "ip_address" field.
+func (_u *AuditEventUpdate) ClearIPAddress() *AuditEventUpdate {
+	_u.mutation.ClearIPAddress()
+	return _u
+}
+
+// SetRequestID sets the "request_id" field.
+func (_u *AuditEventUpdate) SetRequestID(v string) *AuditEventUpdate {
+	_u.mutation.SetRequestID(v)
+	return _u
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (_u *AuditEventUpdate) SetNillableRequestID(v *string) *AuditEventUpdate {
+	if v != nil {
+		_u.SetRequestID(*v)
+	}
+	return _u
+}
+
+// ClearRequestID clears the value of the "request_id" field.
+func (_u *AuditEventUpdate) ClearRequestID() *AuditEventUpdate {
+	_u.mutation.ClearRequestID()
+	return _u
+}
+
+// Mutation returns the AuditEventMutation object of the builder.
+func (_u *AuditEventUpdate) Mutation() *AuditEventMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AuditEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AuditEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AuditEventUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AuditEventUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AuditEventUpdate) check() error {
+	if v, ok := _u.mutation.Action(); ok {
+		if err := auditevent.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "AuditEvent.action": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AuditEventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(auditevent.Table, auditevent.Columns, sqlgraph.NewFieldSpec(auditevent.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(auditevent.FieldAction, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ActorID(); ok {
+		_spec.SetField(auditevent.FieldActorID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedActorID(); ok {
+		_spec.AddField(auditevent.FieldActorID, field.TypeInt, value)
+	}
+	if _u.mutation.ActorIDCleared() {
+		_spec.ClearField(auditevent.FieldActorID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ImpersonatorID(); ok {
+		_spec.SetField(auditevent.FieldImpersonatorID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedImpersonatorID(); ok {
+		_spec.AddField(auditevent.FieldImpersonatorID, field.TypeInt, value)
+	}
+	if _u.mutation.ImpersonatorIDCleared() {
+		_spec.ClearField(auditevent.FieldImpersonatorID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.EntityType(); ok {
+		_spec.SetField(auditevent.FieldEntityType, field.TypeString, value)
+	}
+	if _u.mutation.EntityTypeCleared() {
+		_spec.ClearField(auditevent.FieldEntityType, field.TypeString)
+	}
+	if value, ok := _u.mutation.EntityID(); ok {
+		_spec.SetField(auditevent.FieldEntityID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEntityID(); ok {
+		_spec.AddField(auditevent.FieldEntityID, field.TypeInt, value)
+	}
+	if _u.mutation.EntityIDCleared() {
+		_spec.ClearField(auditevent.FieldEntityID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Changes(); ok {
+		_spec.SetField(auditevent.FieldChanges, field.TypeJSON, value)
+	}
+	if _u.mutation.ChangesCleared() {
+		_spec.ClearField(auditevent.FieldChanges, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.IPAddress(); ok {
+		_spec.SetField(auditevent.FieldIPAddress, field.TypeString, value)
+	}
+	if _u.mutation.IPAddressCleared() {
+		_spec.ClearField(auditevent.FieldIPAddress, field.TypeString)
+	}
+	if value, ok := _u.mutation.RequestID(); ok {
+		_spec.SetField(auditevent.FieldRequestID, field.TypeString, value)
+	}
+	if _u.mutation.RequestIDCleared() {
+		_spec.ClearField(auditevent.FieldRequestID, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AuditEventUpdateOne is the builder for updating a single AuditEvent entity.
+type AuditEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AuditEventMutation
+}
+
+// SetAction sets the "action" field.
+func (_u *AuditEventUpdateOne) SetAction(v string) *AuditEventUpdateOne {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *AuditEventUpdateOne) SetNillableAction(v *string) *AuditEventUpdateOne {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetActorID sets the "actor_id" field.
+func (_u *AuditEventUpdateOne) SetActorID(v int) *AuditEventUpdateOne {
+	_u.mutation.ResetActorID()
+	_u.mutation.SetActorID(v)
+	return _u
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (_u *AuditEventUpdateOne) SetNillableActorID(v *int) *AuditEventUpdateOne {
+	if v != nil {
+		_u.SetActorID(*v)
+	}
+	return _u
+}
+
+// AddActorID adds value to the "actor_id" field.
+func (_u *AuditEventUpdateOne) AddActorID(v int) *AuditEventUpdateOne {
+	_u.mutation.AddActorID(v)
+	return _u
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (_u *AuditEventUpdateOne) ClearActorID() *AuditEventUpdateOne {
+	_u.mutation.ClearActorID()
+	return _u
+}
+
+// SetImpersonatorID sets the "impersonator_id" field.
+func (_u *AuditEventUpdateOne) SetImpersonatorID(v int) *AuditEventUpdateOne {
+	_u.mutation.ResetImpersonatorID()
+	_u.mutation.SetImpersonatorID(v)
+	return _u
+}
+
+// SetNillableImpersonatorID sets the "impersonator_id" field if the given value is not nil.
+func (_u *AuditEventUpdateOne) SetNillableImpersonatorID(v *int) *AuditEventUpdateOne {
+	if v != nil {
+		_u.SetImpersonatorID(*v)
+	}
+	return _u
+}
+
+// AddImpersonatorID adds value to the "impersonator_id" field.
+func (_u *AuditEventUpdateOne) AddImpersonatorID(v int) *AuditEventUpdateOne {
+	_u.mutation.AddImpersonatorID(v)
+	return _u
+}
+
+// ClearImpersonatorID clears the value of the "impersonator_id" field.
+func (_u *AuditEventUpdateOne) ClearImpersonatorID() *AuditEventUpdateOne {
+	_u.mutation.ClearImpersonatorID()
+	return _u
+}
+
+// SetEntityType sets the "entity_type" field.
+func (_u *AuditEventUpdateOne) SetEntityType(v string) *AuditEventUpdateOne {
+	_u.mutation.SetEntityType(v)
+	return _u
+}
+
+// SetNillableEntityType sets the "entity_type" field if the given value is not nil.
+func (_u *AuditEventUpdateOne) SetNillableEntityType(v *string) *AuditEventUpdateOne {
+	if v != nil {
+		_u.SetEntityType(*v)
+	}
+	return _u
+}
+
+// ClearEntityType clears the value of the "entity_type" field.
+func (_u *AuditEventUpdateOne) ClearEntityType() *AuditEventUpdateOne {
+	_u.mutation.ClearEntityType()
+	return _u
+}
+
+// SetEntityID sets the "entity_id" field.
+func (_u *AuditEventUpdateOne) SetEntityID(v int) *AuditEventUpdateOne {
+	_u.mutation.ResetEntityID()
+	_u.mutation.SetEntityID(v)
+	return _u
+}
+
+// SetNillableEntityID sets the "entity_id" field if the given value is not nil.
+func (_u *AuditEventUpdateOne) SetNillableEntityID(v *int) *AuditEventUpdateOne {
+	if v != nil {
+		_u.SetEntityID(*v)
+	}
+	return _u
+}
+
+// AddEntityID adds value to the "entity_id" field.
+func (_u *AuditEventUpdateOne) AddEntityID(v int) *AuditEventUpdateOne {
+	_u.mutation.AddEntityID(v)
+	return _u
+}
+
+// ClearEntityID clears the value of the "entity_id" field.
+func (_u *AuditEventUpdateOne) ClearEntityID() *AuditEventUpdateOne {
+	_u.mutation.ClearEntityID()
+	return _u
+}
+
+// SetChanges sets the "changes" field.
+func (_u *AuditEventUpdateOne) SetChanges(v map[string]interface{}) *AuditEventUpdateOne {
+	_u.mutation.SetChanges(v)
+	return _u
+}
+
+// ClearChanges clears the value of the "changes" field.
+func (_u *AuditEventUpdateOne) ClearChanges() *AuditEventUpdateOne {
+	_u.mutation.ClearChanges()
+	return _u
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (_u *AuditEventUpdateOne) SetIPAddress(v string) *AuditEventUpdateOne {
+	_u.mutation.SetIPAddress(v)
+	return _u
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (_u *AuditEventUpdateOne) SetNillableIPAddress(v *string) *AuditEventUpdateOne {
+	if v != nil {
+		_u.SetIPAddress(*v)
+	}
+	return _u
+}
+
+// ClearIPAddress clears the value of the "ip_address" field.
+func (_u *AuditEventUpdateOne) ClearIPAddress() *AuditEventUpdateOne {
+	_u.mutation.ClearIPAddress()
+	return _u
+}
+
+// SetRequestID sets the "request_id" field.
+func (_u *AuditEventUpdateOne) SetRequestID(v string) *AuditEventUpdateOne {
+	_u.mutation.SetRequestID(v)
+	return _u
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (_u *AuditEventUpdateOne) SetNillableRequestID(v *string) *AuditEventUpdateOne {
+	if v != nil {
+		_u.SetRequestID(*v)
+	}
+	return _u
+}
+
+// ClearRequestID clears the value of the "request_id" field.
+func (_u *AuditEventUpdateOne) ClearRequestID() *AuditEventUpdateOne {
+	_u.mutation.ClearRequestID()
+	return _u
+}
+
+// Mutation returns the AuditEventMutation object of the builder.
+func (_u *AuditEventUpdateOne) Mutation() *AuditEventMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AuditEventUpdate builder.
+func (_u *AuditEventUpdateOne) Where(ps ...predicate.AuditEvent) *AuditEventUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AuditEventUpdateOne) Select(field string, fields ...string) *AuditEventUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AuditEvent entity.
+func (_u *AuditEventUpdateOne) Save(ctx context.Context) (*AuditEvent, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AuditEventUpdateOne) SaveX(ctx context.Context) *AuditEvent {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AuditEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AuditEventUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AuditEventUpdateOne) check() error {
+	if v, ok := _u.mutation.Action(); ok {
+		if err := auditevent.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "AuditEvent.action": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AuditEventUpdateOne) sqlSave(ctx context.Context) (_node *AuditEvent, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(auditevent.Table, auditevent.Columns, sqlgraph.NewFieldSpec(auditevent.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AuditEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, auditevent.FieldID)
+		for _, f := range fields {
+			if !auditevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != auditevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(auditevent.FieldAction, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ActorID(); ok {
+		_spec.SetField(auditevent.FieldActorID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedActorID(); ok {
+		_spec.AddField(auditevent.FieldActorID, field.TypeInt, value)
+	}
+	if _u.mutation.ActorIDCleared() {
+		_spec.ClearField(auditevent.FieldActorID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ImpersonatorID(); ok {
+		_spec.SetField(auditevent.FieldImpersonatorID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedImpersonatorID(); ok {
+		_spec.AddField(auditevent.FieldImpersonatorID, field.TypeInt, value)
+	}
+	if _u.mutation.ImpersonatorIDCleared() {
+		_spec.ClearField(auditevent.FieldImpersonatorID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.EntityType(); ok {
+		_spec.SetField(auditevent.FieldEntityType, field.TypeString, value)
+	}
+	if _u.mutation.EntityTypeCleared() {
+		_spec.ClearField(auditevent.FieldEntityType, field.TypeString)
+	}
+	if value, ok := _u.mutation.EntityID(); ok {
+		_spec.SetField(auditevent.FieldEntityID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEntityID(); ok {
+		_spec.AddField(auditevent.FieldEntityID, field.TypeInt, value)
+	}
+	if _u.mutation.EntityIDCleared() {
+		_spec.ClearField(auditevent.FieldEntityID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Changes(); ok {
+		_spec.SetField(auditevent.FieldChanges, field.TypeJSON, value)
+	}
+	if _u.mutation.ChangesCleared() {
+		_spec.ClearField(auditevent.FieldChanges, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.IPAddress(); ok {
+		_spec.SetField(auditevent.FieldIPAddress, field.TypeString, value)
+	}
+	if _u.mutation.IPAddressCleared() {
+		_spec.ClearField(auditevent.FieldIPAddress, field.TypeString)
+	}
+	if value, ok := _u.mutation.RequestID(); ok {
+		_spec.SetField(auditevent.FieldRequestID, field.TypeString, value)
+	}
+	if _u.mutation.RequestIDCleared() {
+		_spec.ClearField(auditevent.FieldRequestID, field.TypeString)
+	}
+	_node = &AuditEvent{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -12,13 +12,27 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/occult/pagode/ent/accesstoken"
+	"github.com/occult/pagode/ent/auditevent"
 	"github.com/occult/pagode/ent/chatban"
 	"github.com/occult/pagode/ent/chatmessage"
 	"github.com/occult/pagode/ent/chatroom"
+	"github.com/occult/pagode/ent/credential"
+	"github.com/occult/pagode/ent/identity"
+	"github.com/occult/pagode/ent/invitation"
+	"github.com/occult/pagode/ent/loginattempt"
+	"github.com/occult/pagode/ent/logintoken"
+	"github.com/occult/pagode/ent/membership"
+	"github.com/occult/pagode/ent/organization"
 	"github.com/occult/pagode/ent/passwordtoken"
 	"github.com/occult/pagode/ent/paymentcustomer"
+	"github.com/occult/pagode/ent/paymentevent"
 	"github.com/occult/pagode/ent/paymentintent"
 	"github.com/occult/pagode/ent/paymentmethod"
+	"github.com/occult/pagode/ent/permission"
+	"github.com/occult/pagode/ent/recoverycode"
+	"github.com/occult/pagode/ent/role"
+	"github.com/occult/pagode/ent/session"
 	"github.com/occult/pagode/ent/subscription"
 	"github.com/occult/pagode/ent/user"
 )
@@ -81,13 +95,27 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
+			accesstoken.Table:     accesstoken.ValidColumn,
+			auditevent.Table:      auditevent.ValidColumn,
 			chatban.Table:         chatban.ValidColumn,
 			chatmessage.Table:     chatmessage.ValidColumn,
 			chatroom.Table:        chatroom.ValidColumn,
+			credential.Table:      credential.ValidColumn,
+			identity.Table:        identity.ValidColumn,
+			invitation.Table:      invitation.ValidColumn,
+			loginattempt.Table:    loginattempt.ValidColumn,
+			logintoken.Table:      logintoken.ValidColumn,
+			membership.Table:      membership.ValidColumn,
+			organization.Table:    organization.ValidColumn,
 			passwordtoken.Table:   passwordtoken.ValidColumn,
 			paymentcustomer.Table: paymentcustomer.ValidColumn,
+			paymentevent.Table:    paymentevent.ValidColumn,
 			paymentintent.Table:   paymentintent.ValidColumn,
 			paymentmethod.Table:   paymentmethod.ValidColumn,
+			permission.Table:      permission.ValidColumn,
+			recoverycode.Table:    recoverycode.ValidColumn,
+			role.Table:            role.ValidColumn,
+			session.Table:         session.ValidColumn,
 			subscription.Table:    subscription.ValidColumn,
 			user.Table:            user.ValidColumn,
 		})
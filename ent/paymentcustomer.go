@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/occult/pagode/ent/organization"
 	"github.com/occult/pagode/ent/paymentcustomer"
 	"github.com/occult/pagode/ent/user"
 )
@@ -41,8 +42,10 @@ type PaymentCustomer struct {
 
 // PaymentCustomerEdges holds the relations/edges for other nodes in the graph.
 type PaymentCustomerEdges struct {
-	// User associated with this payment customer
+	// User associated with this payment customer, unless it belongs to an organization
 	User *User `json:"user,omitempty"`
+	// Organization associated with this payment customer, whose members share its subscriptions
+	Organization *Organization `json:"organization,omitempty"`
 	// Payment intents for this customer
 	PaymentIntents []*PaymentIntent `json:"payment_intents,omitempty"`
 	// Subscriptions for this customer
@@ -51,7 +54,7 @@ type PaymentCustomerEdges struct {
 	PaymentMethods []*PaymentMethod `json:"payment_methods,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [5]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -65,10 +68,21 @@ func (e PaymentCustomerEdges) UserOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "user"}
 }
 
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PaymentCustomerEdges) OrganizationOrErr() (*Organization, error) {
+	if e.Organization != nil {
+		return e.Organization, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: organization.Label}
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
 // PaymentIntentsOrErr returns the PaymentIntents value or an error if the edge
 // was not loaded in eager-loading.
 func (e PaymentCustomerEdges) PaymentIntentsOrErr() ([]*PaymentIntent, error) {
-	if e.loadedTypes[1] {
+	if e.loadedTypes[2] {
 		return e.PaymentIntents, nil
 	}
 	return nil, &NotLoadedError{edge: "payment_intents"}
@@ -77,7 +91,7 @@ func (e PaymentCustomerEdges) PaymentIntentsOrErr() ([]*PaymentIntent, error) {
 // SubscriptionsOrErr returns the Subscriptions value or an error if the edge
 // was not loaded in eager-loading.
 func (e PaymentCustomerEdges) SubscriptionsOrErr() ([]*Subscription, error) {
-	if e.loadedTypes[2] {
+	if e.loadedTypes[3] {
 		return e.Subscriptions, nil
 	}
 	return nil, &NotLoadedError{edge: "subscriptions"}
@@ -86,7 +100,7 @@ func (e PaymentCustomerEdges) SubscriptionsOrErr() ([]*Subscription, error) {
 // PaymentMethodsOrErr returns the PaymentMethods value or an error if the edge
 // was not loaded in eager-loading.
 func (e PaymentCustomerEdges) PaymentMethodsOrErr() ([]*PaymentMethod, error) {
-	if e.loadedTypes[3] {
+	if e.loadedTypes[4] {
 		return e.PaymentMethods, nil
 	}
 	return nil, &NotLoadedError{edge: "payment_methods"}
@@ -188,6 +202,11 @@ func (_m *PaymentCustomer) QueryUser() *UserQuery {
 	return NewPaymentCustomerClient(_m.config).QueryUser(_m)
 }
 
+// QueryOrganization queries the "organization" edge of the PaymentCustomer entity.
+func (_m *PaymentCustomer) QueryOrganization() *OrganizationQuery {
+	return NewPaymentCustomerClient(_m.config).QueryOrganization(_m)
+}
+
 // QueryPaymentIntents queries the "payment_intents" edge of the PaymentCustomer entity.
 func (_m *PaymentCustomer) QueryPaymentIntents() *PaymentIntentQuery {
 	return NewPaymentCustomerClient(_m.config).QueryPaymentIntents(_m)
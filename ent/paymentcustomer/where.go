@@ -468,6 +468,29 @@ func HasUserWith(preds ...predicate.User) predicate.PaymentCustomer {
 	})
 }
 
+// HasOrganization applies the HasEdge predicate on the "organization" edge.
+func HasOrganization() predicate.PaymentCustomer {
+	return predicate.PaymentCustomer(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, OrganizationTable, OrganizationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrganizationWith applies the HasEdge predicate on the "organization" edge with a given conditions (other predicates).
+func HasOrganizationWith(preds ...predicate.Organization) predicate.PaymentCustomer {
+	return predicate.PaymentCustomer(func(s *sql.Selector) {
+		step := newOrganizationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasPaymentIntents applies the HasEdge predicate on the "payment_intents" edge.
 func HasPaymentIntents() predicate.PaymentCustomer {
 	return predicate.PaymentCustomer(func(s *sql.Selector) {
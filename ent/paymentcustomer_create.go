@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/occult/pagode/ent/organization"
 	"github.com/occult/pagode/ent/paymentcustomer"
 	"github.com/occult/pagode/ent/paymentintent"
 	"github.com/occult/pagode/ent/paymentmethod"
@@ -104,11 +105,38 @@ func (_c *PaymentCustomerCreate) SetUserID(id int) *PaymentCustomerCreate {
 	return _c
 }
 
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (_c *PaymentCustomerCreate) SetNillableUserID(id *int) *PaymentCustomerCreate {
+	if id != nil {
+		_c = _c.SetUserID(*id)
+	}
+	return _c
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (_c *PaymentCustomerCreate) SetUser(v *User) *PaymentCustomerCreate {
 	return _c.SetUserID(v.ID)
 }
 
+// SetOrganizationID sets the "organization" edge to the Organization entity by ID.
+func (_c *PaymentCustomerCreate) SetOrganizationID(id int) *PaymentCustomerCreate {
+	_c.mutation.SetOrganizationID(id)
+	return _c
+}
+
+// SetNillableOrganizationID sets the "organization" edge to the Organization entity by ID if the given value is not nil.
+func (_c *PaymentCustomerCreate) SetNillableOrganizationID(id *int) *PaymentCustomerCreate {
+	if id != nil {
+		_c = _c.SetOrganizationID(*id)
+	}
+	return _c
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_c *PaymentCustomerCreate) SetOrganization(v *Organization) *PaymentCustomerCreate {
+	return _c.SetOrganizationID(v.ID)
+}
+
 // AddPaymentIntentIDs adds the "payment_intents" edge to the PaymentIntent entity by IDs.
 func (_c *PaymentCustomerCreate) AddPaymentIntentIDs(ids ...int) *PaymentCustomerCreate {
 	_c.mutation.AddPaymentIntentIDs(ids...)
@@ -235,9 +263,6 @@ func (_c *PaymentCustomerCreate) check() error {
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PaymentCustomer.updated_at"`)}
 	}
-	if len(_c.mutation.UserIDs()) == 0 {
-		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "PaymentCustomer.user"`)}
-	}
 	return nil
 }
 
@@ -308,6 +333,22 @@ func (_c *PaymentCustomerCreate) createSpec() (*PaymentCustomer, *sqlgraph.Creat
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   paymentcustomer.OrganizationTable,
+			Columns: []string{paymentcustomer.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.PaymentIntentsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/occult/pagode/ent/organization"
 	"github.com/occult/pagode/ent/paymentcustomer"
 	"github.com/occult/pagode/ent/paymentintent"
 	"github.com/occult/pagode/ent/paymentmethod"
@@ -28,6 +29,7 @@ type PaymentCustomerQuery struct {
 	inters             []Interceptor
 	predicates         []predicate.PaymentCustomer
 	withUser           *UserQuery
+	withOrganization   *OrganizationQuery
 	withPaymentIntents *PaymentIntentQuery
 	withSubscriptions  *SubscriptionQuery
 	withPaymentMethods *PaymentMethodQuery
@@ -89,6 +91,28 @@ func (_q *PaymentCustomerQuery) QueryUser() *UserQuery {
 	return query
 }
 
+// QueryOrganization chains the current query on the "organization" edge.
+func (_q *PaymentCustomerQuery) QueryOrganization() *OrganizationQuery {
+	query := (&OrganizationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentcustomer.Table, paymentcustomer.FieldID, selector),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, paymentcustomer.OrganizationTable, paymentcustomer.OrganizationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryPaymentIntents chains the current query on the "payment_intents" edge.
 func (_q *PaymentCustomerQuery) QueryPaymentIntents() *PaymentIntentQuery {
 	query := (&PaymentIntentClient{config: _q.config}).Query()
@@ -348,6 +372,7 @@ func (_q *PaymentCustomerQuery) Clone() *PaymentCustomerQuery {
 		inters:             append([]Interceptor{}, _q.inters...),
 		predicates:         append([]predicate.PaymentCustomer{}, _q.predicates...),
 		withUser:           _q.withUser.Clone(),
+		withOrganization:   _q.withOrganization.Clone(),
 		withPaymentIntents: _q.withPaymentIntents.Clone(),
 		withSubscriptions:  _q.withSubscriptions.Clone(),
 		withPaymentMethods: _q.withPaymentMethods.Clone(),
@@ -368,6 +393,17 @@ func (_q *PaymentCustomerQuery) WithUser(opts ...func(*UserQuery)) *PaymentCusto
 	return _q
 }
 
+// WithOrganization tells the query-builder to eager-load the nodes that are connected to
+// the "organization" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PaymentCustomerQuery) WithOrganization(opts ...func(*OrganizationQuery)) *PaymentCustomerQuery {
+	query := (&OrganizationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withOrganization = query
+	return _q
+}
+
 // WithPaymentIntents tells the query-builder to eager-load the nodes that are connected to
 // the "payment_intents" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *PaymentCustomerQuery) WithPaymentIntents(opts ...func(*PaymentIntentQuery)) *PaymentCustomerQuery {
@@ -479,8 +515,9 @@ func (_q *PaymentCustomerQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 	var (
 		nodes       = []*PaymentCustomer{}
 		_spec       = _q.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [5]bool{
 			_q.withUser != nil,
+			_q.withOrganization != nil,
 			_q.withPaymentIntents != nil,
 			_q.withSubscriptions != nil,
 			_q.withPaymentMethods != nil,
@@ -510,6 +547,12 @@ func (_q *PaymentCustomerQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 			return nil, err
 		}
 	}
+	if query := _q.withOrganization; query != nil {
+		if err := _q.loadOrganization(ctx, query, nodes, nil,
+			func(n *PaymentCustomer, e *Organization) { n.Edges.Organization = e }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withPaymentIntents; query != nil {
 		if err := _q.loadPaymentIntents(ctx, query, nodes,
 			func(n *PaymentCustomer) { n.Edges.PaymentIntents = []*PaymentIntent{} },
@@ -562,6 +605,34 @@ func (_q *PaymentCustomerQuery) loadUser(ctx context.Context, query *UserQuery,
 	}
 	return nil
 }
+func (_q *PaymentCustomerQuery) loadOrganization(ctx context.Context, query *OrganizationQuery, nodes []*PaymentCustomer, init func(*PaymentCustomer), assign func(*PaymentCustomer, *Organization)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*PaymentCustomer)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	query.withFKs = true
+	query.Where(predicate.Organization(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(paymentcustomer.OrganizationColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.payment_customer_organization
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "payment_customer_organization" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "payment_customer_organization" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (_q *PaymentCustomerQuery) loadPaymentIntents(ctx context.Context, query *PaymentIntentQuery, nodes []*PaymentCustomer, init func(*PaymentCustomer), assign func(*PaymentCustomer, *PaymentIntent)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*PaymentCustomer)
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/occult/pagode/ent/organization"
 	"github.com/occult/pagode/ent/paymentcustomer"
 	"github.com/occult/pagode/ent/paymentintent"
 	"github.com/occult/pagode/ent/paymentmethod"
@@ -118,11 +119,38 @@ func (_u *PaymentCustomerUpdate) SetUserID(id int) *PaymentCustomerUpdate {
 	return _u
 }
 
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (_u *PaymentCustomerUpdate) SetNillableUserID(id *int) *PaymentCustomerUpdate {
+	if id != nil {
+		_u = _u.SetUserID(*id)
+	}
+	return _u
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (_u *PaymentCustomerUpdate) SetUser(v *User) *PaymentCustomerUpdate {
 	return _u.SetUserID(v.ID)
 }
 
+// SetOrganizationID sets the "organization" edge to the Organization entity by ID.
+func (_u *PaymentCustomerUpdate) SetOrganizationID(id int) *PaymentCustomerUpdate {
+	_u.mutation.SetOrganizationID(id)
+	return _u
+}
+
+// SetNillableOrganizationID sets the "organization" edge to the Organization entity by ID if the given value is not nil.
+func (_u *PaymentCustomerUpdate) SetNillableOrganizationID(id *int) *PaymentCustomerUpdate {
+	if id != nil {
+		_u = _u.SetOrganizationID(*id)
+	}
+	return _u
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_u *PaymentCustomerUpdate) SetOrganization(v *Organization) *PaymentCustomerUpdate {
+	return _u.SetOrganizationID(v.ID)
+}
+
 // AddPaymentIntentIDs adds the "payment_intents" edge to the PaymentIntent entity by IDs.
 func (_u *PaymentCustomerUpdate) AddPaymentIntentIDs(ids ...int) *PaymentCustomerUpdate {
 	_u.mutation.AddPaymentIntentIDs(ids...)
@@ -179,6 +207,12 @@ func (_u *PaymentCustomerUpdate) ClearUser() *PaymentCustomerUpdate {
 	return _u
 }
 
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (_u *PaymentCustomerUpdate) ClearOrganization() *PaymentCustomerUpdate {
+	_u.mutation.ClearOrganization()
+	return _u
+}
+
 // ClearPaymentIntents clears all "payment_intents" edges to the PaymentIntent entity.
 func (_u *PaymentCustomerUpdate) ClearPaymentIntents() *PaymentCustomerUpdate {
 	_u.mutation.ClearPaymentIntents()
@@ -295,9 +329,6 @@ func (_u *PaymentCustomerUpdate) check() error {
 			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "PaymentCustomer.email": %w`, err)}
 		}
 	}
-	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
-		return errors.New(`ent: clearing a required unique edge "PaymentCustomer.user"`)
-	}
 	return nil
 }
 
@@ -366,6 +397,35 @@ func (_u *PaymentCustomerUpdate) sqlSave(ctx context.Context) (_node int, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   paymentcustomer.OrganizationTable,
+			Columns: []string{paymentcustomer.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   paymentcustomer.OrganizationTable,
+			Columns: []string{paymentcustomer.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.PaymentIntentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -607,11 +667,38 @@ func (_u *PaymentCustomerUpdateOne) SetUserID(id int) *PaymentCustomerUpdateOne
 	return _u
 }
 
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (_u *PaymentCustomerUpdateOne) SetNillableUserID(id *int) *PaymentCustomerUpdateOne {
+	if id != nil {
+		_u = _u.SetUserID(*id)
+	}
+	return _u
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (_u *PaymentCustomerUpdateOne) SetUser(v *User) *PaymentCustomerUpdateOne {
 	return _u.SetUserID(v.ID)
 }
 
+// SetOrganizationID sets the "organization" edge to the Organization entity by ID.
+func (_u *PaymentCustomerUpdateOne) SetOrganizationID(id int) *PaymentCustomerUpdateOne {
+	_u.mutation.SetOrganizationID(id)
+	return _u
+}
+
+// SetNillableOrganizationID sets the "organization" edge to the Organization entity by ID if the given value is not nil.
+func (_u *PaymentCustomerUpdateOne) SetNillableOrganizationID(id *int) *PaymentCustomerUpdateOne {
+	if id != nil {
+		_u = _u.SetOrganizationID(*id)
+	}
+	return _u
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_u *PaymentCustomerUpdateOne) SetOrganization(v *Organization) *PaymentCustomerUpdateOne {
+	return _u.SetOrganizationID(v.ID)
+}
+
 // AddPaymentIntentIDs adds the "payment_intents" edge to the PaymentIntent entity by IDs.
 func (_u *PaymentCustomerUpdateOne) AddPaymentIntentIDs(ids ...int) *PaymentCustomerUpdateOne {
 	_u.mutation.AddPaymentIntentIDs(ids...)
@@ -668,6 +755,12 @@ func (_u *PaymentCustomerUpdateOne) ClearUser() *PaymentCustomerUpdateOne {
 	return _u
 }
 
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (_u *PaymentCustomerUpdateOne) ClearOrganization() *PaymentCustomerUpdateOne {
+	_u.mutation.ClearOrganization()
+	return _u
+}
+
 // ClearPaymentIntents clears all "payment_intents" edges to the PaymentIntent entity.
 func (_u *PaymentCustomerUpdateOne) ClearPaymentIntents() *PaymentCustomerUpdateOne {
 	_u.mutation.ClearPaymentIntents()
@@ -797,9 +890,6 @@ func (_u *PaymentCustomerUpdateOne) check() error {
 			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "PaymentCustomer.email": %w`, err)}
 		}
 	}
-	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
-		return errors.New(`ent: clearing a required unique edge "PaymentCustomer.user"`)
-	}
 	return nil
 }
 
@@ -885,6 +975,35 @@ func (_u *PaymentCustomerUpdateOne) sqlSave(ctx context.Context) (_node *Payment
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   paymentcustomer.OrganizationTable,
+			Columns: []string{paymentcustomer.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   paymentcustomer.OrganizationTable,
+			Columns: []string{paymentcustomer.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.PaymentIntentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
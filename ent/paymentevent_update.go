@@ -0,0 +1,396 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/occult/pagode/ent/paymentevent"
+	"github.com/occult/pagode/ent/predicate"
+)
+
+// PaymentEventUpdate is the builder for updating PaymentEvent entities.
+type PaymentEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PaymentEventMutation
+}
+
+// Where appends a list predicates to the PaymentEventUpdate builder.
+func (_u *PaymentEventUpdate) Where(ps ...predicate.PaymentEvent) *PaymentEventUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *PaymentEventUpdate) SetAttempts(v int) *PaymentEventUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *PaymentEventUpdate) SetNillableAttempts(v *int) *PaymentEventUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *PaymentEventUpdate) AddAttempts(v int) *PaymentEventUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (_u *PaymentEventUpdate) SetProcessedAt(v time.Time) *PaymentEventUpdate {
+	_u.mutation.SetProcessedAt(v)
+	return _u
+}
+
+// SetNillableProcessedAt sets the "processed_at" field if the given value is not nil.
+func (_u *PaymentEventUpdate) SetNillableProcessedAt(v *time.Time) *PaymentEventUpdate {
+	if v != nil {
+		_u.SetProcessedAt(*v)
+	}
+	return _u
+}
+
+// ClearProcessedAt clears the value of the "processed_at" field.
+func (_u *PaymentEventUpdate) ClearProcessedAt() *PaymentEventUpdate {
+	_u.mutation.ClearProcessedAt()
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *PaymentEventUpdate) SetError(v string) *PaymentEventUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *PaymentEventUpdate) SetNillableError(v *string) *PaymentEventUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *PaymentEventUpdate) ClearError() *PaymentEventUpdate {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PaymentEventUpdate) SetUpdatedAt(v time.Time) *PaymentEventUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the PaymentEventMutation object of the builder.
+func (_u *PaymentEventUpdate) Mutation() *PaymentEventMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PaymentEventUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PaymentEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PaymentEventUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PaymentEventUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PaymentEventUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := paymentevent.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PaymentEventUpdate) check() error {
+	if v, ok := _u.mutation.Attempts(); ok {
+		if err := paymentevent.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`ent: validator failed for field "PaymentEvent.attempts": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PaymentEventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(paymentevent.Table, paymentevent.Columns, sqlgraph.NewFieldSpec(paymentevent.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(paymentevent.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(paymentevent.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ProcessedAt(); ok {
+		_spec.SetField(paymentevent.FieldProcessedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ProcessedAtCleared() {
+		_spec.ClearField(paymentevent.FieldProcessedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(paymentevent.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(paymentevent.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(paymentevent.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{paymentevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PaymentEventUpdateOne is the builder for updating a single PaymentEvent entity.
+type PaymentEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PaymentEventMutation
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *PaymentEventUpdateOne) SetAttempts(v int) *PaymentEventUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *PaymentEventUpdateOne) SetNillableAttempts(v *int) *PaymentEventUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *PaymentEventUpdateOne) AddAttempts(v int) *PaymentEventUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (_u *PaymentEventUpdateOne) SetProcessedAt(v time.Time) *PaymentEventUpdateOne {
+	_u.mutation.SetProcessedAt(v)
+	return _u
+}
+
+// SetNillableProcessedAt sets the "processed_at" field if the given value is not nil.
+func (_u *PaymentEventUpdateOne) SetNillableProcessedAt(v *time.Time) *PaymentEventUpdateOne {
+	if v != nil {
+		_u.SetProcessedAt(*v)
+	}
+	return _u
+}
+
+// ClearProcessedAt clears the value of the "processed_at" field.
+func (_u *PaymentEventUpdateOne) ClearProcessedAt() *PaymentEventUpdateOne {
+	_u.mutation.ClearProcessedAt()
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *PaymentEventUpdateOne) SetError(v string) *PaymentEventUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *PaymentEventUpdateOne) SetNillableError(v *string) *PaymentEventUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *PaymentEventUpdateOne) ClearError() *PaymentEventUpdateOne {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PaymentEventUpdateOne) SetUpdatedAt(v time.Time) *PaymentEventUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the PaymentEventMutation object of the builder.
+func (_u *PaymentEventUpdateOne) Mutation() *PaymentEventMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PaymentEventUpdate builder.
+func (_u *PaymentEventUpdateOne) Where(ps ...predicate.PaymentEvent) *PaymentEventUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PaymentEventUpdateOne) Select(field string, fields ...string) *PaymentEventUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PaymentEvent entity.
+func (_u *PaymentEventUpdateOne) Save(ctx context.Context) (*PaymentEvent, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PaymentEventUpdateOne) SaveX(ctx context.Context) *PaymentEvent {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PaymentEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PaymentEventUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PaymentEventUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := paymentevent.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PaymentEventUpdateOne) check() error {
+	if v, ok := _u.mutation.Attempts(); ok {
+		if err := paymentevent.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`ent: validator failed for field "PaymentEvent.attempts": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PaymentEventUpdateOne) sqlSave(ctx context.Context) (_node *PaymentEvent, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(paymentevent.Table, paymentevent.Columns, sqlgraph.NewFieldSpec(paymentevent.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PaymentEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, paymentevent.FieldID)
+		for _, f := range fields {
+			if !paymentevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != paymentevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(paymentevent.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(paymentevent.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ProcessedAt(); ok {
+		_spec.SetField(paymentevent.FieldProcessedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ProcessedAtCleared() {
+		_spec.ClearField(paymentevent.FieldProcessedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(paymentevent.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(paymentevent.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(paymentevent.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &PaymentEvent{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{paymentevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
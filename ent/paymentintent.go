@@ -35,6 +35,8 @@ type PaymentIntent struct {
 	ClientSecret string `json:"-"`
 	// Additional payment data
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// When the latest provider event applied to the payment was created
+	SyncedAt time.Time `json:"synced_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -77,7 +79,7 @@ func (*PaymentIntent) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullInt64)
 		case paymentintent.FieldProviderPaymentIntentID, paymentintent.FieldProvider, paymentintent.FieldStatus, paymentintent.FieldCurrency, paymentintent.FieldDescription, paymentintent.FieldClientSecret:
 			values[i] = new(sql.NullString)
-		case paymentintent.FieldCreatedAt, paymentintent.FieldUpdatedAt:
+		case paymentintent.FieldSyncedAt, paymentintent.FieldCreatedAt, paymentintent.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		case paymentintent.ForeignKeys[0]: // payment_customer_payment_intents
 			values[i] = new(sql.NullInt64)
@@ -152,6 +154,12 @@ func (_m *PaymentIntent) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field metadata: %w", err)
 				}
 			}
+		case paymentintent.FieldSyncedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field synced_at", values[i])
+			} else if value.Valid {
+				_m.SyncedAt = value.Time
+			}
 		case paymentintent.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -235,6 +243,9 @@ func (_m *PaymentIntent) String() string {
 	builder.WriteString("metadata=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Metadata))
 	builder.WriteString(", ")
+	builder.WriteString("synced_at=")
+	builder.WriteString(_m.SyncedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -31,6 +31,8 @@ const (
 	FieldClientSecret = "client_secret"
 	// FieldMetadata holds the string denoting the metadata field in the database.
 	FieldMetadata = "metadata"
+	// FieldSyncedAt holds the string denoting the synced_at field in the database.
+	FieldSyncedAt = "synced_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -59,6 +61,7 @@ var Columns = []string{
 	FieldDescription,
 	FieldClientSecret,
 	FieldMetadata,
+	FieldSyncedAt,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -179,6 +182,11 @@ func ByClientSecret(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldClientSecret, opts...).ToFunc()
 }
 
+// BySyncedAt orders the results by the synced_at field.
+func BySyncedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSyncedAt, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
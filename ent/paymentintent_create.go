@@ -109,6 +109,20 @@ func (_c *PaymentIntentCreate) SetMetadata(v map[string]interface{}) *PaymentInt
 	return _c
 }
 
+// SetSyncedAt sets the "synced_at" field.
+func (_c *PaymentIntentCreate) SetSyncedAt(v time.Time) *PaymentIntentCreate {
+	_c.mutation.SetSyncedAt(v)
+	return _c
+}
+
+// SetNillableSyncedAt sets the "synced_at" field if the given value is not nil.
+func (_c *PaymentIntentCreate) SetNillableSyncedAt(v *time.Time) *PaymentIntentCreate {
+	if v != nil {
+		_c.SetSyncedAt(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *PaymentIntentCreate) SetCreatedAt(v time.Time) *PaymentIntentCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -314,6 +328,10 @@ func (_c *PaymentIntentCreate) createSpec() (*PaymentIntent, *sqlgraph.CreateSpe
 		_spec.SetField(paymentintent.FieldMetadata, field.TypeJSON, value)
 		_node.Metadata = value
 	}
+	if value, ok := _c.mutation.SyncedAt(); ok {
+		_spec.SetField(paymentintent.FieldSyncedAt, field.TypeTime, value)
+		_node.SyncedAt = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(paymentintent.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -158,6 +158,26 @@ func (_u *PaymentIntentUpdate) ClearMetadata() *PaymentIntentUpdate {
 	return _u
 }
 
+// SetSyncedAt sets the "synced_at" field.
+func (_u *PaymentIntentUpdate) SetSyncedAt(v time.Time) *PaymentIntentUpdate {
+	_u.mutation.SetSyncedAt(v)
+	return _u
+}
+
+// SetNillableSyncedAt sets the "synced_at" field if the given value is not nil.
+func (_u *PaymentIntentUpdate) SetNillableSyncedAt(v *time.Time) *PaymentIntentUpdate {
+	if v != nil {
+		_u.SetSyncedAt(*v)
+	}
+	return _u
+}
+
+// ClearSyncedAt clears the value of the "synced_at" field.
+func (_u *PaymentIntentUpdate) ClearSyncedAt() *PaymentIntentUpdate {
+	_u.mutation.ClearSyncedAt()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *PaymentIntentUpdate) SetUpdatedAt(v time.Time) *PaymentIntentUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -303,6 +323,12 @@ func (_u *PaymentIntentUpdate) sqlSave(ctx context.Context) (_node int, err erro
 	if _u.mutation.MetadataCleared() {
 		_spec.ClearField(paymentintent.FieldMetadata, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.SyncedAt(); ok {
+		_spec.SetField(paymentintent.FieldSyncedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SyncedAtCleared() {
+		_spec.ClearField(paymentintent.FieldSyncedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(paymentintent.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -484,6 +510,26 @@ func (_u *PaymentIntentUpdateOne) ClearMetadata() *PaymentIntentUpdateOne {
 	return _u
 }
 
+// SetSyncedAt sets the "synced_at" field.
+func (_u *PaymentIntentUpdateOne) SetSyncedAt(v time.Time) *PaymentIntentUpdateOne {
+	_u.mutation.SetSyncedAt(v)
+	return _u
+}
+
+// SetNillableSyncedAt sets the "synced_at" field if the given value is not nil.
+func (_u *PaymentIntentUpdateOne) SetNillableSyncedAt(v *time.Time) *PaymentIntentUpdateOne {
+	if v != nil {
+		_u.SetSyncedAt(*v)
+	}
+	return _u
+}
+
+// ClearSyncedAt clears the value of the "synced_at" field.
+func (_u *PaymentIntentUpdateOne) ClearSyncedAt() *PaymentIntentUpdateOne {
+	_u.mutation.ClearSyncedAt()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *PaymentIntentUpdateOne) SetUpdatedAt(v time.Time) *PaymentIntentUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -659,6 +705,12 @@ func (_u *PaymentIntentUpdateOne) sqlSave(ctx context.Context) (_node *PaymentIn
 	if _u.mutation.MetadataCleared() {
 		_spec.ClearField(paymentintent.FieldMetadata, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.SyncedAt(); ok {
+		_spec.SetField(paymentintent.FieldSyncedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SyncedAtCleared() {
+		_spec.ClearField(paymentintent.FieldSyncedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(paymentintent.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -37,6 +37,8 @@ type PaymentMethod struct {
 	IsDefault bool `json:"is_default,omitempty"`
 	// Additional payment method data
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// When the latest provider event applied to the payment method was created
+	SyncedAt time.Time `json:"synced_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -81,7 +83,7 @@ func (*PaymentMethod) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullInt64)
 		case paymentmethod.FieldProviderPaymentMethodID, paymentmethod.FieldProvider, paymentmethod.FieldType, paymentmethod.FieldLastFour, paymentmethod.FieldBrand:
 			values[i] = new(sql.NullString)
-		case paymentmethod.FieldCreatedAt, paymentmethod.FieldUpdatedAt:
+		case paymentmethod.FieldSyncedAt, paymentmethod.FieldCreatedAt, paymentmethod.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		case paymentmethod.ForeignKeys[0]: // payment_customer_payment_methods
 			values[i] = new(sql.NullInt64)
@@ -162,6 +164,12 @@ func (_m *PaymentMethod) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field metadata: %w", err)
 				}
 			}
+		case paymentmethod.FieldSyncedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field synced_at", values[i])
+			} else if value.Valid {
+				_m.SyncedAt = value.Time
+			}
 		case paymentmethod.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -249,6 +257,9 @@ func (_m *PaymentMethod) String() string {
 	builder.WriteString("metadata=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Metadata))
 	builder.WriteString(", ")
+	builder.WriteString("synced_at=")
+	builder.WriteString(_m.SyncedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -131,6 +131,20 @@ func (_c *PaymentMethodCreate) SetMetadata(v map[string]interface{}) *PaymentMet
 	return _c
 }
 
+// SetSyncedAt sets the "synced_at" field.
+func (_c *PaymentMethodCreate) SetSyncedAt(v time.Time) *PaymentMethodCreate {
+	_c.mutation.SetSyncedAt(v)
+	return _c
+}
+
+// SetNillableSyncedAt sets the "synced_at" field if the given value is not nil.
+func (_c *PaymentMethodCreate) SetNillableSyncedAt(v *time.Time) *PaymentMethodCreate {
+	if v != nil {
+		_c.SetSyncedAt(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *PaymentMethodCreate) SetCreatedAt(v time.Time) *PaymentMethodCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -332,6 +346,10 @@ func (_c *PaymentMethodCreate) createSpec() (*PaymentMethod, *sqlgraph.CreateSpe
 		_spec.SetField(paymentmethod.FieldMetadata, field.TypeJSON, value)
 		_node.Metadata = value
 	}
+	if value, ok := _c.mutation.SyncedAt(); ok {
+		_spec.SetField(paymentmethod.FieldSyncedAt, field.TypeTime, value)
+		_node.SyncedAt = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(paymentmethod.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -191,6 +191,26 @@ func (_u *PaymentMethodUpdate) ClearMetadata() *PaymentMethodUpdate {
 	return _u
 }
 
+// SetSyncedAt sets the "synced_at" field.
+func (_u *PaymentMethodUpdate) SetSyncedAt(v time.Time) *PaymentMethodUpdate {
+	_u.mutation.SetSyncedAt(v)
+	return _u
+}
+
+// SetNillableSyncedAt sets the "synced_at" field if the given value is not nil.
+func (_u *PaymentMethodUpdate) SetNillableSyncedAt(v *time.Time) *PaymentMethodUpdate {
+	if v != nil {
+		_u.SetSyncedAt(*v)
+	}
+	return _u
+}
+
+// ClearSyncedAt clears the value of the "synced_at" field.
+func (_u *PaymentMethodUpdate) ClearSyncedAt() *PaymentMethodUpdate {
+	_u.mutation.ClearSyncedAt()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *PaymentMethodUpdate) SetUpdatedAt(v time.Time) *PaymentMethodUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -343,6 +363,12 @@ func (_u *PaymentMethodUpdate) sqlSave(ctx context.Context) (_node int, err erro
 	if _u.mutation.MetadataCleared() {
 		_spec.ClearField(paymentmethod.FieldMetadata, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.SyncedAt(); ok {
+		_spec.SetField(paymentmethod.FieldSyncedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SyncedAtCleared() {
+		_spec.ClearField(paymentmethod.FieldSyncedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(paymentmethod.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -557,6 +583,26 @@ func (_u *PaymentMethodUpdateOne) ClearMetadata() *PaymentMethodUpdateOne {
 	return _u
 }
 
+// SetSyncedAt sets the "synced_at" field.
+func (_u *PaymentMethodUpdateOne) SetSyncedAt(v time.Time) *PaymentMethodUpdateOne {
+	_u.mutation.SetSyncedAt(v)
+	return _u
+}
+
+// SetNillableSyncedAt sets the "synced_at" field if the given value is not nil.
+func (_u *PaymentMethodUpdateOne) SetNillableSyncedAt(v *time.Time) *PaymentMethodUpdateOne {
+	if v != nil {
+		_u.SetSyncedAt(*v)
+	}
+	return _u
+}
+
+// ClearSyncedAt clears the value of the "synced_at" field.
+func (_u *PaymentMethodUpdateOne) ClearSyncedAt() *PaymentMethodUpdateOne {
+	_u.mutation.ClearSyncedAt()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *PaymentMethodUpdateOne) SetUpdatedAt(v time.Time) *PaymentMethodUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -739,6 +785,12 @@ func (_u *PaymentMethodUpdateOne) sqlSave(ctx context.Context) (_node *PaymentMe
 	if _u.mutation.MetadataCleared() {
 		_spec.ClearField(paymentmethod.FieldMetadata, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.SyncedAt(); ok {
+		_spec.SetField(paymentmethod.FieldSyncedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SyncedAtCleared() {
+		_spec.ClearField(paymentmethod.FieldSyncedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(paymentmethod.FieldUpdatedAt, field.TypeTime, value)
 	}
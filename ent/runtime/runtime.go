@@ -5,14 +5,28 @@ package runtime
 import (
 	"time"
 
+	"github.com/occult/pagode/ent/accesstoken"
+	"github.com/occult/pagode/ent/auditevent"
 	"github.com/occult/pagode/ent/chatban"
 	"github.com/occult/pagode/ent/chatmessage"
 	"github.com/occult/pagode/ent/chatroom"
+	"github.com/occult/pagode/ent/credential"
+	"github.com/occult/pagode/ent/identity"
+	"github.com/occult/pagode/ent/invitation"
+	"github.com/occult/pagode/ent/loginattempt"
+	"github.com/occult/pagode/ent/logintoken"
+	"github.com/occult/pagode/ent/membership"
+	"github.com/occult/pagode/ent/organization"
 	"github.com/occult/pagode/ent/passwordtoken"
 	"github.com/occult/pagode/ent/paymentcustomer"
+	"github.com/occult/pagode/ent/paymentevent"
 	"github.com/occult/pagode/ent/paymentintent"
 	"github.com/occult/pagode/ent/paymentmethod"
+	"github.com/occult/pagode/ent/permission"
+	"github.com/occult/pagode/ent/recoverycode"
+	"github.com/occult/pagode/ent/role"
 	"github.com/occult/pagode/ent/schema"
+	"github.com/occult/pagode/ent/session"
 	"github.com/occult/pagode/ent/subscription"
 	"github.com/occult/pagode/ent/user"
 )
@@ -21,6 +35,32 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	accesstokenFields := schema.AccessToken{}.Fields()
+	_ = accesstokenFields
+	// accesstokenDescName is the schema descriptor for name field.
+	accesstokenDescName := accesstokenFields[0].Descriptor()
+	// accesstoken.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	accesstoken.NameValidator = accesstokenDescName.Validators[0].(func(string) error)
+	// accesstokenDescToken is the schema descriptor for token field.
+	accesstokenDescToken := accesstokenFields[1].Descriptor()
+	// accesstoken.TokenValidator is a validator for the "token" field. It is called by the builders before save.
+	accesstoken.TokenValidator = accesstokenDescToken.Validators[0].(func(string) error)
+	// accesstokenDescCreatedAt is the schema descriptor for created_at field.
+	accesstokenDescCreatedAt := accesstokenFields[4].Descriptor()
+	// accesstoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	accesstoken.DefaultCreatedAt = accesstokenDescCreatedAt.Default.(func() time.Time)
+	auditeventHooks := schema.AuditEvent{}.Hooks()
+	auditevent.Hooks[0] = auditeventHooks[0]
+	auditeventFields := schema.AuditEvent{}.Fields()
+	_ = auditeventFields
+	// auditeventDescAction is the schema descriptor for action field.
+	auditeventDescAction := auditeventFields[0].Descriptor()
+	// auditevent.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	auditevent.ActionValidator = auditeventDescAction.Validators[0].(func(string) error)
+	// auditeventDescCreatedAt is the schema descriptor for created_at field.
+	auditeventDescCreatedAt := auditeventFields[8].Descriptor()
+	// auditevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditevent.DefaultCreatedAt = auditeventDescCreatedAt.Default.(func() time.Time)
 	chatbanFields := schema.ChatBan{}.Fields()
 	_ = chatbanFields
 	// chatbanDescIPHash is the schema descriptor for ip_hash field.
@@ -105,6 +145,94 @@ func init() {
 	chatroomDescCreatedAt := chatroomFields[3].Descriptor()
 	// chatroom.DefaultCreatedAt holds the default value on creation for the created_at field.
 	chatroom.DefaultCreatedAt = chatroomDescCreatedAt.Default.(func() time.Time)
+	credentialFields := schema.Credential{}.Fields()
+	_ = credentialFields
+	// credentialDescName is the schema descriptor for name field.
+	credentialDescName := credentialFields[0].Descriptor()
+	// credential.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	credential.NameValidator = credentialDescName.Validators[0].(func(string) error)
+	// credentialDescCredentialID is the schema descriptor for credential_id field.
+	credentialDescCredentialID := credentialFields[1].Descriptor()
+	// credential.CredentialIDValidator is a validator for the "credential_id" field. It is called by the builders before save.
+	credential.CredentialIDValidator = credentialDescCredentialID.Validators[0].(func([]byte) error)
+	// credentialDescPublicKey is the schema descriptor for public_key field.
+	credentialDescPublicKey := credentialFields[2].Descriptor()
+	// credential.PublicKeyValidator is a validator for the "public_key" field. It is called by the builders before save.
+	credential.PublicKeyValidator = credentialDescPublicKey.Validators[0].(func([]byte) error)
+	// credentialDescSignCount is the schema descriptor for sign_count field.
+	credentialDescSignCount := credentialFields[3].Descriptor()
+	// credential.DefaultSignCount holds the default value on creation for the sign_count field.
+	credential.DefaultSignCount = credentialDescSignCount.Default.(uint32)
+	// credentialDescCreatedAt is the schema descriptor for created_at field.
+	credentialDescCreatedAt := credentialFields[7].Descriptor()
+	// credential.DefaultCreatedAt holds the default value on creation for the created_at field.
+	credential.DefaultCreatedAt = credentialDescCreatedAt.Default.(func() time.Time)
+	identityFields := schema.Identity{}.Fields()
+	_ = identityFields
+	// identityDescProvider is the schema descriptor for provider field.
+	identityDescProvider := identityFields[0].Descriptor()
+	// identity.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	identity.ProviderValidator = identityDescProvider.Validators[0].(func(string) error)
+	// identityDescSubject is the schema descriptor for subject field.
+	identityDescSubject := identityFields[1].Descriptor()
+	// identity.SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
+	identity.SubjectValidator = identityDescSubject.Validators[0].(func(string) error)
+	// identityDescCreatedAt is the schema descriptor for created_at field.
+	identityDescCreatedAt := identityFields[4].Descriptor()
+	// identity.DefaultCreatedAt holds the default value on creation for the created_at field.
+	identity.DefaultCreatedAt = identityDescCreatedAt.Default.(func() time.Time)
+	// identityDescLastLoginAt is the schema descriptor for last_login_at field.
+	identityDescLastLoginAt := identityFields[5].Descriptor()
+	// identity.DefaultLastLoginAt holds the default value on creation for the last_login_at field.
+	identity.DefaultLastLoginAt = identityDescLastLoginAt.Default.(func() time.Time)
+	invitationFields := schema.Invitation{}.Fields()
+	_ = invitationFields
+	// invitationDescToken is the schema descriptor for token field.
+	invitationDescToken := invitationFields[0].Descriptor()
+	// invitation.TokenValidator is a validator for the "token" field. It is called by the builders before save.
+	invitation.TokenValidator = invitationDescToken.Validators[0].(func(string) error)
+	// invitationDescEmail is the schema descriptor for email field.
+	invitationDescEmail := invitationFields[1].Descriptor()
+	// invitation.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	invitation.EmailValidator = invitationDescEmail.Validators[0].(func(string) error)
+	// invitationDescCreatedAt is the schema descriptor for created_at field.
+	invitationDescCreatedAt := invitationFields[5].Descriptor()
+	// invitation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	invitation.DefaultCreatedAt = invitationDescCreatedAt.Default.(func() time.Time)
+	loginattemptFields := schema.LoginAttempt{}.Fields()
+	_ = loginattemptFields
+	// loginattemptDescCreatedAt is the schema descriptor for created_at field.
+	loginattemptDescCreatedAt := loginattemptFields[3].Descriptor()
+	// loginattempt.DefaultCreatedAt holds the default value on creation for the created_at field.
+	loginattempt.DefaultCreatedAt = loginattemptDescCreatedAt.Default.(func() time.Time)
+	logintokenHooks := schema.LoginToken{}.Hooks()
+	logintoken.Hooks[0] = logintokenHooks[0]
+	logintokenFields := schema.LoginToken{}.Fields()
+	_ = logintokenFields
+	// logintokenDescToken is the schema descriptor for token field.
+	logintokenDescToken := logintokenFields[0].Descriptor()
+	// logintoken.TokenValidator is a validator for the "token" field. It is called by the builders before save.
+	logintoken.TokenValidator = logintokenDescToken.Validators[0].(func(string) error)
+	// logintokenDescCreatedAt is the schema descriptor for created_at field.
+	logintokenDescCreatedAt := logintokenFields[2].Descriptor()
+	// logintoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	logintoken.DefaultCreatedAt = logintokenDescCreatedAt.Default.(func() time.Time)
+	membershipFields := schema.Membership{}.Fields()
+	_ = membershipFields
+	// membershipDescCreatedAt is the schema descriptor for created_at field.
+	membershipDescCreatedAt := membershipFields[3].Descriptor()
+	// membership.DefaultCreatedAt holds the default value on creation for the created_at field.
+	membership.DefaultCreatedAt = membershipDescCreatedAt.Default.(func() time.Time)
+	organizationFields := schema.Organization{}.Fields()
+	_ = organizationFields
+	// organizationDescName is the schema descriptor for name field.
+	organizationDescName := organizationFields[0].Descriptor()
+	// organization.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	organization.NameValidator = organizationDescName.Validators[0].(func(string) error)
+	// organizationDescCreatedAt is the schema descriptor for created_at field.
+	organizationDescCreatedAt := organizationFields[1].Descriptor()
+	// organization.DefaultCreatedAt holds the default value on creation for the created_at field.
+	organization.DefaultCreatedAt = organizationDescCreatedAt.Default.(func() time.Time)
 	passwordtokenHooks := schema.PasswordToken{}.Hooks()
 	passwordtoken.Hooks[0] = passwordtokenHooks[0]
 	passwordtokenFields := schema.PasswordToken{}.Fields()
@@ -143,6 +271,38 @@ func init() {
 	paymentcustomer.DefaultUpdatedAt = paymentcustomerDescUpdatedAt.Default.(func() time.Time)
 	// paymentcustomer.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	paymentcustomer.UpdateDefaultUpdatedAt = paymentcustomerDescUpdatedAt.UpdateDefault.(func() time.Time)
+	paymenteventFields := schema.PaymentEvent{}.Fields()
+	_ = paymenteventFields
+	// paymenteventDescProvider is the schema descriptor for provider field.
+	paymenteventDescProvider := paymenteventFields[0].Descriptor()
+	// paymentevent.DefaultProvider holds the default value on creation for the provider field.
+	paymentevent.DefaultProvider = paymenteventDescProvider.Default.(string)
+	// paymentevent.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	paymentevent.ProviderValidator = paymenteventDescProvider.Validators[0].(func(string) error)
+	// paymenteventDescEventID is the schema descriptor for event_id field.
+	paymenteventDescEventID := paymenteventFields[1].Descriptor()
+	// paymentevent.EventIDValidator is a validator for the "event_id" field. It is called by the builders before save.
+	paymentevent.EventIDValidator = paymenteventDescEventID.Validators[0].(func(string) error)
+	// paymenteventDescType is the schema descriptor for type field.
+	paymenteventDescType := paymenteventFields[2].Descriptor()
+	// paymentevent.TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	paymentevent.TypeValidator = paymenteventDescType.Validators[0].(func(string) error)
+	// paymenteventDescAttempts is the schema descriptor for attempts field.
+	paymenteventDescAttempts := paymenteventFields[4].Descriptor()
+	// paymentevent.DefaultAttempts holds the default value on creation for the attempts field.
+	paymentevent.DefaultAttempts = paymenteventDescAttempts.Default.(int)
+	// paymentevent.AttemptsValidator is a validator for the "attempts" field. It is called by the builders before save.
+	paymentevent.AttemptsValidator = paymenteventDescAttempts.Validators[0].(func(int) error)
+	// paymenteventDescCreatedAt is the schema descriptor for created_at field.
+	paymenteventDescCreatedAt := paymenteventFields[7].Descriptor()
+	// paymentevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	paymentevent.DefaultCreatedAt = paymenteventDescCreatedAt.Default.(func() time.Time)
+	// paymenteventDescUpdatedAt is the schema descriptor for updated_at field.
+	paymenteventDescUpdatedAt := paymenteventFields[8].Descriptor()
+	// paymentevent.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	paymentevent.DefaultUpdatedAt = paymenteventDescUpdatedAt.Default.(func() time.Time)
+	// paymentevent.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	paymentevent.UpdateDefaultUpdatedAt = paymenteventDescUpdatedAt.UpdateDefault.(func() time.Time)
 	paymentintentFields := schema.PaymentIntent{}.Fields()
 	_ = paymentintentFields
 	// paymentintentDescProviderPaymentIntentID is the schema descriptor for provider_payment_intent_id field.
@@ -166,11 +326,11 @@ func init() {
 	// paymentintent.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
 	paymentintent.CurrencyValidator = paymentintentDescCurrency.Validators[0].(func(string) error)
 	// paymentintentDescCreatedAt is the schema descriptor for created_at field.
-	paymentintentDescCreatedAt := paymentintentFields[8].Descriptor()
+	paymentintentDescCreatedAt := paymentintentFields[9].Descriptor()
 	// paymentintent.DefaultCreatedAt holds the default value on creation for the created_at field.
 	paymentintent.DefaultCreatedAt = paymentintentDescCreatedAt.Default.(func() time.Time)
 	// paymentintentDescUpdatedAt is the schema descriptor for updated_at field.
-	paymentintentDescUpdatedAt := paymentintentFields[9].Descriptor()
+	paymentintentDescUpdatedAt := paymentintentFields[10].Descriptor()
 	// paymentintent.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	paymentintent.DefaultUpdatedAt = paymentintentDescUpdatedAt.Default.(func() time.Time)
 	// paymentintent.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -210,15 +370,61 @@ func init() {
 	// paymentmethod.DefaultIsDefault holds the default value on creation for the is_default field.
 	paymentmethod.DefaultIsDefault = paymentmethodDescIsDefault.Default.(bool)
 	// paymentmethodDescCreatedAt is the schema descriptor for created_at field.
-	paymentmethodDescCreatedAt := paymentmethodFields[9].Descriptor()
+	paymentmethodDescCreatedAt := paymentmethodFields[10].Descriptor()
 	// paymentmethod.DefaultCreatedAt holds the default value on creation for the created_at field.
 	paymentmethod.DefaultCreatedAt = paymentmethodDescCreatedAt.Default.(func() time.Time)
 	// paymentmethodDescUpdatedAt is the schema descriptor for updated_at field.
-	paymentmethodDescUpdatedAt := paymentmethodFields[10].Descriptor()
+	paymentmethodDescUpdatedAt := paymentmethodFields[11].Descriptor()
 	// paymentmethod.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	paymentmethod.DefaultUpdatedAt = paymentmethodDescUpdatedAt.Default.(func() time.Time)
 	// paymentmethod.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	paymentmethod.UpdateDefaultUpdatedAt = paymentmethodDescUpdatedAt.UpdateDefault.(func() time.Time)
+	permissionFields := schema.Permission{}.Fields()
+	_ = permissionFields
+	// permissionDescName is the schema descriptor for name field.
+	permissionDescName := permissionFields[0].Descriptor()
+	// permission.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	permission.NameValidator = permissionDescName.Validators[0].(func(string) error)
+	recoverycodeHooks := schema.RecoveryCode{}.Hooks()
+	recoverycode.Hooks[0] = recoverycodeHooks[0]
+	recoverycodeFields := schema.RecoveryCode{}.Fields()
+	_ = recoverycodeFields
+	// recoverycodeDescCode is the schema descriptor for code field.
+	recoverycodeDescCode := recoverycodeFields[0].Descriptor()
+	// recoverycode.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	recoverycode.CodeValidator = recoverycodeDescCode.Validators[0].(func(string) error)
+	// recoverycodeDescCreatedAt is the schema descriptor for created_at field.
+	recoverycodeDescCreatedAt := recoverycodeFields[3].Descriptor()
+	// recoverycode.DefaultCreatedAt holds the default value on creation for the created_at field.
+	recoverycode.DefaultCreatedAt = recoverycodeDescCreatedAt.Default.(func() time.Time)
+	roleFields := schema.Role{}.Fields()
+	_ = roleFields
+	// roleDescName is the schema descriptor for name field.
+	roleDescName := roleFields[0].Descriptor()
+	// role.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	role.NameValidator = roleDescName.Validators[0].(func(string) error)
+	// roleDescCreatedAt is the schema descriptor for created_at field.
+	roleDescCreatedAt := roleFields[2].Descriptor()
+	// role.DefaultCreatedAt holds the default value on creation for the created_at field.
+	role.DefaultCreatedAt = roleDescCreatedAt.Default.(func() time.Time)
+	sessionFields := schema.Session{}.Fields()
+	_ = sessionFields
+	// sessionDescToken is the schema descriptor for token field.
+	sessionDescToken := sessionFields[0].Descriptor()
+	// session.TokenValidator is a validator for the "token" field. It is called by the builders before save.
+	session.TokenValidator = sessionDescToken.Validators[0].(func(string) error)
+	// sessionDescName is the schema descriptor for name field.
+	sessionDescName := sessionFields[1].Descriptor()
+	// session.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	session.NameValidator = sessionDescName.Validators[0].(func(string) error)
+	// sessionDescCreatedAt is the schema descriptor for created_at field.
+	sessionDescCreatedAt := sessionFields[6].Descriptor()
+	// session.DefaultCreatedAt holds the default value on creation for the created_at field.
+	session.DefaultCreatedAt = sessionDescCreatedAt.Default.(func() time.Time)
+	// sessionDescLastSeenAt is the schema descriptor for last_seen_at field.
+	sessionDescLastSeenAt := sessionFields[7].Descriptor()
+	// session.DefaultLastSeenAt holds the default value on creation for the last_seen_at field.
+	session.DefaultLastSeenAt = sessionDescLastSeenAt.Default.(func() time.Time)
 	subscriptionFields := schema.Subscription{}.Fields()
 	_ = subscriptionFields
 	// subscriptionDescProviderSubscriptionID is the schema descriptor for provider_subscription_id field.
@@ -252,11 +458,11 @@ func init() {
 	// subscription.IntervalCountValidator is a validator for the "interval_count" field. It is called by the builders before save.
 	subscription.IntervalCountValidator = subscriptionDescIntervalCount.Validators[0].(func(int) error)
 	// subscriptionDescCreatedAt is the schema descriptor for created_at field.
-	subscriptionDescCreatedAt := subscriptionFields[15].Descriptor()
+	subscriptionDescCreatedAt := subscriptionFields[16].Descriptor()
 	// subscription.DefaultCreatedAt holds the default value on creation for the created_at field.
 	subscription.DefaultCreatedAt = subscriptionDescCreatedAt.Default.(func() time.Time)
 	// subscriptionDescUpdatedAt is the schema descriptor for updated_at field.
-	subscriptionDescUpdatedAt := subscriptionFields[16].Descriptor()
+	subscriptionDescUpdatedAt := subscriptionFields[17].Descriptor()
 	// subscription.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	subscription.DefaultUpdatedAt = subscriptionDescUpdatedAt.Default.(func() time.Time)
 	// subscription.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -287,20 +493,24 @@ func init() {
 			return nil
 		}
 	}()
+	// userDescPendingEmail is the schema descriptor for pending_email field.
+	userDescPendingEmail := userFields[2].Descriptor()
+	// user.PendingEmailValidator is a validator for the "pending_email" field. It is called by the builders before save.
+	user.PendingEmailValidator = userDescPendingEmail.Validators[0].(func(string) error)
 	// userDescPassword is the schema descriptor for password field.
-	userDescPassword := userFields[2].Descriptor()
+	userDescPassword := userFields[3].Descriptor()
 	// user.PasswordValidator is a validator for the "password" field. It is called by the builders before save.
 	user.PasswordValidator = userDescPassword.Validators[0].(func(string) error)
 	// userDescVerified is the schema descriptor for verified field.
-	userDescVerified := userFields[3].Descriptor()
+	userDescVerified := userFields[4].Descriptor()
 	// user.DefaultVerified holds the default value on creation for the verified field.
 	user.DefaultVerified = userDescVerified.Default.(bool)
-	// userDescAdmin is the schema descriptor for admin field.
-	userDescAdmin := userFields[4].Descriptor()
-	// user.DefaultAdmin holds the default value on creation for the admin field.
-	user.DefaultAdmin = userDescAdmin.Default.(bool)
+	// userDescTotpLastStep is the schema descriptor for totp_last_step field.
+	userDescTotpLastStep := userFields[6].Descriptor()
+	// user.DefaultTotpLastStep holds the default value on creation for the totp_last_step field.
+	user.DefaultTotpLastStep = userDescTotpLastStep.Default.(int64)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[5].Descriptor()
+	userDescCreatedAt := userFields[9].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 }
@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/occult/pagode/ent/hook"
+)
+
+// AuditEvent holds the schema definition for the AuditEvent entity.
+// Audit events record sensitive actions and changes to entities, along with who performed them. They are not
+// linked to users through edges so that they are kept when the users they refer to are deleted.
+type AuditEvent struct {
+	ent.Schema
+}
+
+// Fields of the AuditEvent.
+func (AuditEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("action").
+			NotEmpty().
+			Comment("What happened, such as create, update, delete or impersonation.start"),
+		field.Int("actor_id").
+			Optional().
+			Nillable().
+			Comment("The ID of the user who performed the action, if any"),
+		field.Int("impersonator_id").
+			Optional().
+			Nillable().
+			Comment("The ID of the admin who performed the action while impersonating the actor, if any"),
+		field.String("entity_type").
+			Optional().
+			Comment("The type of the entity the action was performed on, if any"),
+		field.Int("entity_id").
+			Optional().
+			Nillable(),
+		field.JSON("changes", map[string]any{}).
+			Optional().
+			Comment("The values of the fields which changed, keyed by field name, with sensitive values redacted"),
+		field.String("ip_address").
+			Optional(),
+		field.String("request_id").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the AuditEvent.
+func (AuditEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+		index.Fields("actor_id", "created_at"),
+		index.Fields("entity_type", "entity_id"),
+	}
+}
+
+// Hooks of the AuditEvent.
+func (AuditEvent) Hooks() []ent.Hook {
+	return []ent.Hook{
+		// The audit log is append-only, although old events can be deleted.
+		hook.Reject(ent.OpUpdate | ent.OpUpdateOne),
+	}
+}
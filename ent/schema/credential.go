@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Credential holds the schema definition for the Credential entity.
+// Credentials are WebAuthn passkeys which users can log in with instead of a password.
+type Credential struct {
+	ent.Schema
+}
+
+// Fields of the Credential.
+func (Credential) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty(),
+		field.Bytes("credential_id").
+			NotEmpty().
+			Unique().
+			Immutable().
+			Comment("The ID of the credential, as provided by the authenticator"),
+		field.Bytes("public_key").
+			Sensitive().
+			NotEmpty().
+			Immutable().
+			Comment("The COSE-encoded public key of the credential"),
+		field.Uint32("sign_count").
+			Default(0),
+		field.Bytes("aaguid").
+			Optional().
+			Immutable().
+			Comment("Identifies the model of the authenticator, if it discloses it"),
+		field.Strings("transports").
+			Optional(),
+		field.Int("user_id"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("last_used_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the Credential.
+func (Credential) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).
+			Field("user_id").
+			Required().
+			Unique().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the Credential.
+func (Credential) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
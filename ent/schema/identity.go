@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Identity holds the schema definition for the Identity entity.
+// Identities link a user to their account with an external OAuth2 or OpenID Connect provider.
+type Identity struct {
+	ent.Schema
+}
+
+// Fields of the Identity.
+func (Identity) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("provider").
+			NotEmpty().
+			Immutable(),
+		field.String("subject").
+			NotEmpty().
+			Immutable().
+			Comment("The ID of the user at the provider"),
+		field.String("email").
+			Optional(),
+		field.Int("user_id"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("last_login_at").
+			Default(time.Now),
+	}
+}
+
+// Edges of the Identity.
+func (Identity) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).
+			Field("user_id").
+			Required().
+			Unique().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the Identity.
+func (Identity) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("provider", "subject").
+			Unique(),
+	}
+}
@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Invitation holds the schema definition for the Invitation entity.
+// Invitations are emailed in order to add users to an organization.
+type Invitation struct {
+	ent.Schema
+}
+
+// Fields of the Invitation.
+func (Invitation) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("token").
+			Sensitive().
+			NotEmpty().
+			Unique().
+			Immutable().
+			Comment("SHA-256 hash of the token sent in the invitation link"),
+		field.String("email").
+			NotEmpty(),
+		field.Enum("role").
+			Values("admin", "member").
+			Default("member"),
+		field.Int("organization_id"),
+		field.Int("invited_by_id").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("expires_at"),
+	}
+}
+
+// Edges of the Invitation.
+func (Invitation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("organization", Organization.Type).
+			Field("organization_id").
+			Required().
+			Unique().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("invited_by", User.Type).
+			Field("invited_by_id").
+			Unique().
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+	}
+}
@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// LoginAttempt holds the schema definition for the LoginAttempt entity.
+// Failed login attempts are recorded in order to throttle and lock out brute-force attacks.
+type LoginAttempt struct {
+	ent.Schema
+}
+
+// Fields of the LoginAttempt.
+func (LoginAttempt) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("email").
+			Comment("The email address that was attempted, which may not belong to any user"),
+		field.String("ip_address"),
+		field.Int("user_id").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the LoginAttempt.
+func (LoginAttempt) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).
+			Field("user_id").
+			Unique().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the LoginAttempt.
+func (LoginAttempt) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "created_at"),
+		index.Fields("ip_address", "created_at"),
+	}
+}
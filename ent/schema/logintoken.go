@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	ge "github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/hook"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// LoginToken holds the schema definition for the LoginToken entity.
+// Login tokens allow a user to sign in once using a link sent to their email address.
+type LoginToken struct {
+	ent.Schema
+}
+
+// Fields of the LoginToken.
+func (LoginToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("token").
+			Sensitive().
+			NotEmpty(),
+		field.Int("user_id"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the LoginToken.
+func (LoginToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).
+			Field("user_id").
+			Required().
+			Unique().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Hooks of the LoginToken.
+func (LoginToken) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(
+			func(next ent.Mutator) ent.Mutator {
+				return hook.LoginTokenFunc(func(ctx context.Context, m *ge.LoginTokenMutation) (ent.Value, error) {
+					if v, exists := m.Token(); exists {
+						hash, err := bcrypt.GenerateFromPassword([]byte(v), bcrypt.DefaultCost)
+						if err != nil {
+							return "", err
+						}
+						m.SetToken(string(hash))
+					}
+					return next.Mutate(ctx, m)
+				})
+			},
+			// Limit the hook only for these operations.
+			ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne,
+		),
+	}
+}
@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Organization holds the schema definition for the Organization entity.
+// Organizations allow teams of users to share resources, such as a subscription.
+type Organization struct {
+	ent.Schema
+}
+
+// Fields of the Organization.
+func (Organization) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Organization.
+func (Organization) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("memberships", Membership.Type).
+			Ref("organization"),
+		edge.From("invitations", Invitation.Type).
+			Ref("organization"),
+		edge.From("payment_customer", PaymentCustomer.Type).
+			Ref("organization").
+			Unique(),
+	}
+}
@@ -46,8 +46,10 @@ func (PaymentCustomer) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("user", User.Type).
 			Unique().
-			Required().
-			Comment("User associated with this payment customer"),
+			Comment("User associated with this payment customer, unless it belongs to an organization"),
+		edge.To("organization", Organization.Type).
+			Unique().
+			Comment("Organization associated with this payment customer, whose members share its subscriptions"),
 		edge.To("payment_intents", PaymentIntent.Type).
 			Comment("Payment intents for this customer"),
 		edge.To("subscriptions", Subscription.Type).
@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PaymentEvent holds the schema definition for the PaymentEvent entity.
+// Every webhook event received from a payment provider is recorded so duplicate deliveries can be
+// ignored and failed events can be replayed.
+type PaymentEvent struct {
+	ent.Schema
+}
+
+// Fields of the PaymentEvent.
+func (PaymentEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("provider").
+			NotEmpty().
+			Default("stripe").
+			Immutable().
+			Comment("Payment provider name"),
+		field.String("event_id").
+			NotEmpty().
+			Immutable().
+			Comment("External payment provider event ID"),
+		field.String("type").
+			NotEmpty().
+			Immutable().
+			Comment("Event type from provider"),
+		field.Text("payload").
+			Sensitive().
+			Immutable().
+			Comment("Raw event payload as received from the provider"),
+		field.Int("attempts").
+			Default(0).
+			Min(0).
+			Comment("Number of times processing the event has been attempted"),
+		field.Time("processed_at").
+			Optional().
+			Comment("When the event was successfully processed"),
+		field.Text("error").
+			Optional().
+			Comment("Error from the last failed processing attempt"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the PaymentEvent.
+func (PaymentEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("provider", "event_id").
+			Unique(),
+	}
+}
@@ -46,6 +46,9 @@ func (PaymentIntent) Fields() []ent.Field {
 		field.JSON("metadata", map[string]interface{}{}).
 			Optional().
 			Comment("Additional payment data"),
+		field.Time("synced_at").
+			Optional().
+			Comment("When the latest provider event applied to the payment was created"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -48,6 +48,9 @@ func (PaymentMethod) Fields() []ent.Field {
 		field.JSON("metadata", map[string]interface{}{}).
 			Optional().
 			Comment("Additional payment method data"),
+		field.Time("synced_at").
+			Optional().
+			Comment("When the latest provider event applied to the payment method was created"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
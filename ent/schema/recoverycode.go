@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	ge "github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/hook"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// RecoveryCode holds the schema definition for the RecoveryCode entity.
+// Recovery codes allow a user with two-factor authentication enabled to log in without their authenticator.
+type RecoveryCode struct {
+	ent.Schema
+}
+
+// Fields of the RecoveryCode.
+func (RecoveryCode) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("code").
+			Sensitive().
+			NotEmpty(),
+		field.Int("user_id"),
+		field.Time("used_at").
+			Optional().
+			Comment("When the code was used, after which it is no longer valid"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the RecoveryCode.
+func (RecoveryCode) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).
+			Field("user_id").
+			Required().
+			Unique().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Hooks of the RecoveryCode.
+func (RecoveryCode) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(
+			func(next ent.Mutator) ent.Mutator {
+				return hook.RecoveryCodeFunc(func(ctx context.Context, m *ge.RecoveryCodeMutation) (ent.Value, error) {
+					if v, exists := m.Code(); exists {
+						hash, err := bcrypt.GenerateFromPassword([]byte(v), bcrypt.DefaultCost)
+						if err != nil {
+							return "", err
+						}
+						m.SetCode(string(hash))
+					}
+					return next.Mutate(ctx, m)
+				})
+			},
+			// Limit the hook only for these operations.
+			ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne,
+		),
+	}
+}
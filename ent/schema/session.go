@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Session holds the schema definition for the Session entity.
+// Sessions store server-side session data so that they can be listed and revoked.
+type Session struct {
+	ent.Schema
+}
+
+// Fields of the Session.
+func (Session) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("token").
+			Sensitive().
+			NotEmpty().
+			Unique().
+			Immutable().
+			Comment("SHA-256 hash of the token stored in the session cookie"),
+		field.String("name").
+			NotEmpty().
+			Immutable(),
+		field.Bytes("data").
+			Sensitive().
+			Optional(),
+		field.Int("user_id").
+			Optional().
+			Nillable().
+			Comment("The authenticated user the session belongs to, if any"),
+		field.String("user_agent").
+			Optional(),
+		field.String("ip_address").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("last_seen_at").
+			Default(time.Now),
+		field.Time("expires_at"),
+	}
+}
+
+// Edges of the Session.
+func (Session) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).
+			Field("user_id").
+			Unique().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the Session.
+func (Session) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+		index.Fields("expires_at"),
+	}
+}
@@ -67,6 +67,9 @@ func (Subscription) Fields() []ent.Field {
 		field.JSON("metadata", map[string]interface{}{}).
 			Optional().
 			Comment("Additional subscription data"),
+		field.Time("synced_at").
+			Optional().
+			Comment("When the latest provider event applied to the subscription was created"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -32,13 +32,36 @@ func (User) Fields() []ent.Field {
 				_, err := mail.ParseAddress(s)
 				return err
 			}),
+		field.String("pending_email").
+			Optional().
+			Validate(func(s string) error {
+				if s == "" {
+					return nil
+				}
+				_, err := mail.ParseAddress(s)
+				return err
+			}).
+			Comment("New email address awaiting confirmation before it replaces the current one"),
 		field.String("password").
 			Sensitive().
 			NotEmpty(),
 		field.Bool("verified").
 			Default(false),
-		field.Bool("admin").
-			Default(false),
+		field.String("totp_secret").
+			Sensitive().
+			Optional().
+			Comment("TOTP secret, encrypted with the encryption key, set once two-factor authentication has been enabled"),
+		field.Int64("totp_last_step").
+			Default(0).
+			Comment("Time step of the last accepted TOTP code, so codes cannot be used again"),
+		field.Time("locked_until").
+			Optional().
+			Nillable().
+			Comment("Set when the account is locked out after too many failed login attempts"),
+		field.Time("deleted_at").
+			Optional().
+			Nillable().
+			Comment("Set when the user deletes their account, which is purged once the grace period has passed"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -50,9 +73,28 @@ func (User) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("owner", PasswordToken.Type).
 			Ref("user"),
+		edge.From("recovery_codes", RecoveryCode.Type).
+			Ref("user"),
+		edge.From("login_tokens", LoginToken.Type).
+			Ref("user"),
+		edge.From("login_attempts", LoginAttempt.Type).
+			Ref("user"),
+		edge.From("sessions", Session.Type).
+			Ref("user"),
+		edge.From("identities", Identity.Type).
+			Ref("user"),
+		edge.From("access_tokens", AccessToken.Type).
+			Ref("user"),
+		edge.From("credentials", Credential.Type).
+			Ref("user"),
+		edge.From("memberships", Membership.Type).
+			Ref("user"),
+		edge.From("invitations_sent", Invitation.Type).
+			Ref("invited_by"),
 		edge.From("payment_customer", PaymentCustomer.Type).
 			Ref("user").
 			Unique(),
+		edge.To("roles", Role.Type),
 		edge.To("owned_chat_rooms", ChatRoom.Type),
 		edge.To("chat_messages", ChatMessage.Type),
 		edge.To("chat_bans", ChatBan.Type),
@@ -70,6 +112,10 @@ func (User) Hooks() []ent.Hook {
 						m.SetEmail(strings.ToLower(v))
 					}
 
+					if v, exists := m.PendingEmail(); exists {
+						m.SetPendingEmail(strings.ToLower(v))
+					}
+
 					if v, exists := m.Password(); exists {
 						hash, err := bcrypt.GenerateFromPassword([]byte(v), bcrypt.DefaultCost)
 						if err != nil {
@@ -49,6 +49,8 @@ type Subscription struct {
 	EndedAt time.Time `json:"ended_at,omitempty"`
 	// Additional subscription data
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// When the latest provider event applied to the subscription was created
+	SyncedAt time.Time `json:"synced_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -91,7 +93,7 @@ func (*Subscription) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullInt64)
 		case subscription.FieldProviderSubscriptionID, subscription.FieldProvider, subscription.FieldStatus, subscription.FieldPriceID, subscription.FieldCurrency, subscription.FieldInterval:
 			values[i] = new(sql.NullString)
-		case subscription.FieldCurrentPeriodStart, subscription.FieldCurrentPeriodEnd, subscription.FieldTrialStart, subscription.FieldTrialEnd, subscription.FieldCanceledAt, subscription.FieldEndedAt, subscription.FieldCreatedAt, subscription.FieldUpdatedAt:
+		case subscription.FieldCurrentPeriodStart, subscription.FieldCurrentPeriodEnd, subscription.FieldTrialStart, subscription.FieldTrialEnd, subscription.FieldCanceledAt, subscription.FieldEndedAt, subscription.FieldSyncedAt, subscription.FieldCreatedAt, subscription.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		case subscription.ForeignKeys[0]: // payment_customer_subscriptions
 			values[i] = new(sql.NullInt64)
@@ -208,6 +210,12 @@ func (_m *Subscription) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field metadata: %w", err)
 				}
 			}
+		case subscription.FieldSyncedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field synced_at", values[i])
+			} else if value.Valid {
+				_m.SyncedAt = value.Time
+			}
 		case subscription.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -313,6 +321,9 @@ func (_m *Subscription) String() string {
 	builder.WriteString("metadata=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Metadata))
 	builder.WriteString(", ")
+	builder.WriteString("synced_at=")
+	builder.WriteString(_m.SyncedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
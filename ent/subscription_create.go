@@ -191,6 +191,20 @@ func (_c *SubscriptionCreate) SetMetadata(v map[string]interface{}) *Subscriptio
 	return _c
 }
 
+// SetSyncedAt sets the "synced_at" field.
+func (_c *SubscriptionCreate) SetSyncedAt(v time.Time) *SubscriptionCreate {
+	_c.mutation.SetSyncedAt(v)
+	return _c
+}
+
+// SetNillableSyncedAt sets the "synced_at" field if the given value is not nil.
+func (_c *SubscriptionCreate) SetNillableSyncedAt(v *time.Time) *SubscriptionCreate {
+	if v != nil {
+		_c.SetSyncedAt(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *SubscriptionCreate) SetCreatedAt(v time.Time) *SubscriptionCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -452,6 +466,10 @@ func (_c *SubscriptionCreate) createSpec() (*Subscription, *sqlgraph.CreateSpec)
 		_spec.SetField(subscription.FieldMetadata, field.TypeJSON, value)
 		_node.Metadata = value
 	}
+	if value, ok := _c.mutation.SyncedAt(); ok {
+		_spec.SetField(subscription.FieldSyncedAt, field.TypeTime, value)
+		_node.SyncedAt = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(subscription.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
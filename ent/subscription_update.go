@@ -287,6 +287,26 @@ func (_u *SubscriptionUpdate) ClearMetadata() *SubscriptionUpdate {
 	return _u
 }
 
+// SetSyncedAt sets the "synced_at" field.
+func (_u *SubscriptionUpdate) SetSyncedAt(v time.Time) *SubscriptionUpdate {
+	_u.mutation.SetSyncedAt(v)
+	return _u
+}
+
+// SetNillableSyncedAt sets the "synced_at" field if the given value is not nil.
+func (_u *SubscriptionUpdate) SetNillableSyncedAt(v *time.Time) *SubscriptionUpdate {
+	if v != nil {
+		_u.SetSyncedAt(*v)
+	}
+	return _u
+}
+
+// ClearSyncedAt clears the value of the "synced_at" field.
+func (_u *SubscriptionUpdate) ClearSyncedAt() *SubscriptionUpdate {
+	_u.mutation.ClearSyncedAt()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *SubscriptionUpdate) SetUpdatedAt(v time.Time) *SubscriptionUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -483,6 +503,12 @@ func (_u *SubscriptionUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if _u.mutation.MetadataCleared() {
 		_spec.ClearField(subscription.FieldMetadata, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.SyncedAt(); ok {
+		_spec.SetField(subscription.FieldSyncedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SyncedAtCleared() {
+		_spec.ClearField(subscription.FieldSyncedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(subscription.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -793,6 +819,26 @@ func (_u *SubscriptionUpdateOne) ClearMetadata() *SubscriptionUpdateOne {
 	return _u
 }
 
+// SetSyncedAt sets the "synced_at" field.
+func (_u *SubscriptionUpdateOne) SetSyncedAt(v time.Time) *SubscriptionUpdateOne {
+	_u.mutation.SetSyncedAt(v)
+	return _u
+}
+
+// SetNillableSyncedAt sets the "synced_at" field if the given value is not nil.
+func (_u *SubscriptionUpdateOne) SetNillableSyncedAt(v *time.Time) *SubscriptionUpdateOne {
+	if v != nil {
+		_u.SetSyncedAt(*v)
+	}
+	return _u
+}
+
+// ClearSyncedAt clears the value of the "synced_at" field.
+func (_u *SubscriptionUpdateOne) ClearSyncedAt() *SubscriptionUpdateOne {
+	_u.mutation.ClearSyncedAt()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *SubscriptionUpdateOne) SetUpdatedAt(v time.Time) *SubscriptionUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -1019,6 +1065,12 @@ func (_u *SubscriptionUpdateOne) sqlSave(ctx context.Context) (_node *Subscripti
 	if _u.mutation.MetadataCleared() {
 		_spec.ClearField(subscription.FieldMetadata, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.SyncedAt(); ok {
+		_spec.SetField(subscription.FieldSyncedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SyncedAtCleared() {
+		_spec.ClearField(subscription.FieldSyncedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(subscription.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -22,12 +22,20 @@ type User struct {
 	Name string `json:"name,omitempty"`
 	// Email holds the value of the "email" field.
 	Email string `json:"email,omitempty"`
+	// New email address awaiting confirmation before it replaces the current one
+	PendingEmail string `json:"pending_email,omitempty"`
 	// Password holds the value of the "password" field.
 	Password string `json:"-"`
 	// Verified holds the value of the "verified" field.
 	Verified bool `json:"verified,omitempty"`
-	// Admin holds the value of the "admin" field.
-	Admin bool `json:"admin,omitempty"`
+	// TOTP secret, encrypted with the encryption key, set once two-factor authentication has been enabled
+	TotpSecret string `json:"-"`
+	// Time step of the last accepted TOTP code, so codes cannot be used again
+	TotpLastStep int64 `json:"totp_last_step,omitempty"`
+	// Set when the account is locked out after too many failed login attempts
+	LockedUntil *time.Time `json:"locked_until,omitempty"`
+	// Set when the user deletes their account, which is purged once the grace period has passed
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -41,8 +49,28 @@ type User struct {
 type UserEdges struct {
 	// Owner holds the value of the owner edge.
 	Owner []*PasswordToken `json:"owner,omitempty"`
+	// RecoveryCodes holds the value of the recovery_codes edge.
+	RecoveryCodes []*RecoveryCode `json:"recovery_codes,omitempty"`
+	// LoginTokens holds the value of the login_tokens edge.
+	LoginTokens []*LoginToken `json:"login_tokens,omitempty"`
+	// LoginAttempts holds the value of the login_attempts edge.
+	LoginAttempts []*LoginAttempt `json:"login_attempts,omitempty"`
+	// Sessions holds the value of the sessions edge.
+	Sessions []*Session `json:"sessions,omitempty"`
+	// Identities holds the value of the identities edge.
+	Identities []*Identity `json:"identities,omitempty"`
+	// AccessTokens holds the value of the access_tokens edge.
+	AccessTokens []*AccessToken `json:"access_tokens,omitempty"`
+	// Credentials holds the value of the credentials edge.
+	Credentials []*Credential `json:"credentials,omitempty"`
+	// Memberships holds the value of the memberships edge.
+	Memberships []*Membership `json:"memberships,omitempty"`
+	// InvitationsSent holds the value of the invitations_sent edge.
+	InvitationsSent []*Invitation `json:"invitations_sent,omitempty"`
 	// PaymentCustomer holds the value of the payment_customer edge.
 	PaymentCustomer *PaymentCustomer `json:"payment_customer,omitempty"`
+	// Roles holds the value of the roles edge.
+	Roles []*Role `json:"roles,omitempty"`
 	// OwnedChatRooms holds the value of the owned_chat_rooms edge.
 	OwnedChatRooms []*ChatRoom `json:"owned_chat_rooms,omitempty"`
 	// ChatMessages holds the value of the chat_messages edge.
@@ -53,7 +81,7 @@ type UserEdges struct {
 	ChatBansIssued []*ChatBan `json:"chat_bans_issued,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [6]bool
+	loadedTypes [16]bool
 }
 
 // OwnerOrErr returns the Owner value or an error if the edge
@@ -65,21 +93,111 @@ func (e UserEdges) OwnerOrErr() ([]*PasswordToken, error) {
 	return nil, &NotLoadedError{edge: "owner"}
 }
 
+// RecoveryCodesOrErr returns the RecoveryCodes value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) RecoveryCodesOrErr() ([]*RecoveryCode, error) {
+	if e.loadedTypes[1] {
+		return e.RecoveryCodes, nil
+	}
+	return nil, &NotLoadedError{edge: "recovery_codes"}
+}
+
+// LoginTokensOrErr returns the LoginTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) LoginTokensOrErr() ([]*LoginToken, error) {
+	if e.loadedTypes[2] {
+		return e.LoginTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "login_tokens"}
+}
+
+// LoginAttemptsOrErr returns the LoginAttempts value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) LoginAttemptsOrErr() ([]*LoginAttempt, error) {
+	if e.loadedTypes[3] {
+		return e.LoginAttempts, nil
+	}
+	return nil, &NotLoadedError{edge: "login_attempts"}
+}
+
+// SessionsOrErr returns the Sessions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SessionsOrErr() ([]*Session, error) {
+	if e.loadedTypes[4] {
+		return e.Sessions, nil
+	}
+	return nil, &NotLoadedError{edge: "sessions"}
+}
+
+// IdentitiesOrErr returns the Identities value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) IdentitiesOrErr() ([]*Identity, error) {
+	if e.loadedTypes[5] {
+		return e.Identities, nil
+	}
+	return nil, &NotLoadedError{edge: "identities"}
+}
+
+// AccessTokensOrErr returns the AccessTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) AccessTokensOrErr() ([]*AccessToken, error) {
+	if e.loadedTypes[6] {
+		return e.AccessTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "access_tokens"}
+}
+
+// CredentialsOrErr returns the Credentials value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) CredentialsOrErr() ([]*Credential, error) {
+	if e.loadedTypes[7] {
+		return e.Credentials, nil
+	}
+	return nil, &NotLoadedError{edge: "credentials"}
+}
+
+// MembershipsOrErr returns the Memberships value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MembershipsOrErr() ([]*Membership, error) {
+	if e.loadedTypes[8] {
+		return e.Memberships, nil
+	}
+	return nil, &NotLoadedError{edge: "memberships"}
+}
+
+// InvitationsSentOrErr returns the InvitationsSent value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) InvitationsSentOrErr() ([]*Invitation, error) {
+	if e.loadedTypes[9] {
+		return e.InvitationsSent, nil
+	}
+	return nil, &NotLoadedError{edge: "invitations_sent"}
+}
+
 // PaymentCustomerOrErr returns the PaymentCustomer value or an error if the edge
 // was not loaded in eager-loading, or loaded but was not found.
 func (e UserEdges) PaymentCustomerOrErr() (*PaymentCustomer, error) {
 	if e.PaymentCustomer != nil {
 		return e.PaymentCustomer, nil
-	} else if e.loadedTypes[1] {
+	} else if e.loadedTypes[10] {
 		return nil, &NotFoundError{label: paymentcustomer.Label}
 	}
 	return nil, &NotLoadedError{edge: "payment_customer"}
 }
 
+// RolesOrErr returns the Roles value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) RolesOrErr() ([]*Role, error) {
+	if e.loadedTypes[11] {
+		return e.Roles, nil
+	}
+	return nil, &NotLoadedError{edge: "roles"}
+}
+
 // OwnedChatRoomsOrErr returns the OwnedChatRooms value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) OwnedChatRoomsOrErr() ([]*ChatRoom, error) {
-	if e.loadedTypes[2] {
+	if e.loadedTypes[12] {
 		return e.OwnedChatRooms, nil
 	}
 	return nil, &NotLoadedError{edge: "owned_chat_rooms"}
@@ -88,7 +206,7 @@ func (e UserEdges) OwnedChatRoomsOrErr() ([]*ChatRoom, error) {
 // ChatMessagesOrErr returns the ChatMessages value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) ChatMessagesOrErr() ([]*ChatMessage, error) {
-	if e.loadedTypes[3] {
+	if e.loadedTypes[13] {
 		return e.ChatMessages, nil
 	}
 	return nil, &NotLoadedError{edge: "chat_messages"}
@@ -97,7 +215,7 @@ func (e UserEdges) ChatMessagesOrErr() ([]*ChatMessage, error) {
 // ChatBansOrErr returns the ChatBans value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) ChatBansOrErr() ([]*ChatBan, error) {
-	if e.loadedTypes[4] {
+	if e.loadedTypes[14] {
 		return e.ChatBans, nil
 	}
 	return nil, &NotLoadedError{edge: "chat_bans"}
@@ -106,7 +224,7 @@ func (e UserEdges) ChatBansOrErr() ([]*ChatBan, error) {
 // ChatBansIssuedOrErr returns the ChatBansIssued value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) ChatBansIssuedOrErr() ([]*ChatBan, error) {
-	if e.loadedTypes[5] {
+	if e.loadedTypes[15] {
 		return e.ChatBansIssued, nil
 	}
 	return nil, &NotLoadedError{edge: "chat_bans_issued"}
@@ -117,13 +235,13 @@ func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldVerified, user.FieldAdmin:
+		case user.FieldVerified:
 			values[i] = new(sql.NullBool)
-		case user.FieldID:
+		case user.FieldID, user.FieldTotpLastStep:
 			values[i] = new(sql.NullInt64)
-		case user.FieldName, user.FieldEmail, user.FieldPassword:
+		case user.FieldName, user.FieldEmail, user.FieldPendingEmail, user.FieldPassword, user.FieldTotpSecret:
 			values[i] = new(sql.NullString)
-		case user.FieldCreatedAt:
+		case user.FieldLockedUntil, user.FieldDeletedAt, user.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
 		case user.ForeignKeys[0]: // payment_customer_user
 			values[i] = new(sql.NullInt64)
@@ -160,6 +278,12 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Email = value.String
 			}
+		case user.FieldPendingEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pending_email", values[i])
+			} else if value.Valid {
+				_m.PendingEmail = value.String
+			}
 		case user.FieldPassword:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field password", values[i])
@@ -172,11 +296,31 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Verified = value.Bool
 			}
-		case user.FieldAdmin:
-			if value, ok := values[i].(*sql.NullBool); !ok {
-				return fmt.Errorf("unexpected type %T for field admin", values[i])
+		case user.FieldTotpSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_secret", values[i])
 			} else if value.Valid {
-				_m.Admin = value.Bool
+				_m.TotpSecret = value.String
+			}
+		case user.FieldTotpLastStep:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_last_step", values[i])
+			} else if value.Valid {
+				_m.TotpLastStep = value.Int64
+			}
+		case user.FieldLockedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field locked_until", values[i])
+			} else if value.Valid {
+				_m.LockedUntil = new(time.Time)
+				*_m.LockedUntil = value.Time
+			}
+		case user.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
 			}
 		case user.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
@@ -209,11 +353,61 @@ func (_m *User) QueryOwner() *PasswordTokenQuery {
 	return NewUserClient(_m.config).QueryOwner(_m)
 }
 
+// QueryRecoveryCodes queries the "recovery_codes" edge of the User entity.
+func (_m *User) QueryRecoveryCodes() *RecoveryCodeQuery {
+	return NewUserClient(_m.config).QueryRecoveryCodes(_m)
+}
+
+// QueryLoginTokens queries the "login_tokens" edge of the User entity.
+func (_m *User) QueryLoginTokens() *LoginTokenQuery {
+	return NewUserClient(_m.config).QueryLoginTokens(_m)
+}
+
+// QueryLoginAttempts queries the "login_attempts" edge of the User entity.
+func (_m *User) QueryLoginAttempts() *LoginAttemptQuery {
+	return NewUserClient(_m.config).QueryLoginAttempts(_m)
+}
+
+// QuerySessions queries the "sessions" edge of the User entity.
+func (_m *User) QuerySessions() *SessionQuery {
+	return NewUserClient(_m.config).QuerySessions(_m)
+}
+
+// QueryIdentities queries the "identities" edge of the User entity.
+func (_m *User) QueryIdentities() *IdentityQuery {
+	return NewUserClient(_m.config).QueryIdentities(_m)
+}
+
+// QueryAccessTokens queries the "access_tokens" edge of the User entity.
+func (_m *User) QueryAccessTokens() *AccessTokenQuery {
+	return NewUserClient(_m.config).QueryAccessTokens(_m)
+}
+
+// QueryCredentials queries the "credentials" edge of the User entity.
+func (_m *User) QueryCredentials() *CredentialQuery {
+	return NewUserClient(_m.config).QueryCredentials(_m)
+}
+
+// QueryMemberships queries the "memberships" edge of the User entity.
+func (_m *User) QueryMemberships() *MembershipQuery {
+	return NewUserClient(_m.config).QueryMemberships(_m)
+}
+
+// QueryInvitationsSent queries the "invitations_sent" edge of the User entity.
+func (_m *User) QueryInvitationsSent() *InvitationQuery {
+	return NewUserClient(_m.config).QueryInvitationsSent(_m)
+}
+
 // QueryPaymentCustomer queries the "payment_customer" edge of the User entity.
 func (_m *User) QueryPaymentCustomer() *PaymentCustomerQuery {
 	return NewUserClient(_m.config).QueryPaymentCustomer(_m)
 }
 
+// QueryRoles queries the "roles" edge of the User entity.
+func (_m *User) QueryRoles() *RoleQuery {
+	return NewUserClient(_m.config).QueryRoles(_m)
+}
+
 // QueryOwnedChatRooms queries the "owned_chat_rooms" edge of the User entity.
 func (_m *User) QueryOwnedChatRooms() *ChatRoomQuery {
 	return NewUserClient(_m.config).QueryOwnedChatRooms(_m)
@@ -263,13 +457,28 @@ func (_m *User) String() string {
 	builder.WriteString("email=")
 	builder.WriteString(_m.Email)
 	builder.WriteString(", ")
+	builder.WriteString("pending_email=")
+	builder.WriteString(_m.PendingEmail)
+	builder.WriteString(", ")
 	builder.WriteString("password=<sensitive>")
 	builder.WriteString(", ")
 	builder.WriteString("verified=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Verified))
 	builder.WriteString(", ")
-	builder.WriteString("admin=")
-	builder.WriteString(fmt.Sprintf("%v", _m.Admin))
+	builder.WriteString("totp_secret=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("totp_last_step=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotpLastStep))
+	builder.WriteString(", ")
+	if v := _m.LockedUntil; v != nil {
+		builder.WriteString("locked_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
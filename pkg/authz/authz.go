@@ -0,0 +1,110 @@
+package authz
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/occult/pagode/ent"
+)
+
+// Permission is the name of a permission which can be granted to users through their roles.
+type Permission string
+
+const (
+	// UsersManage allows managing users and assigning their roles.
+	UsersManage Permission = "users.manage"
+
+	// UsersImpersonate allows logging in as other users to see what they see.
+	UsersImpersonate Permission = "users.impersonate"
+
+	// EntitiesManage allows managing all entities in the admin panel.
+	EntitiesManage Permission = "entities.manage"
+
+	// TasksManage allows monitoring the task queues.
+	TasksManage Permission = "tasks.manage"
+
+	// BillingManage allows managing payments, such as replaying payment webhook events.
+	BillingManage Permission = "billing.manage"
+
+	// ChatModerate allows moderating all chat rooms, as if owning them.
+	ChatModerate Permission = "chat.moderate"
+
+	// AuditView allows browsing the audit log.
+	AuditView Permission = "audit.view"
+
+	// CacheManage allows monitoring the cache and flushing cached pages.
+	CacheManage Permission = "cache.manage"
+)
+
+// AdminRole is the name of the built-in role which is granted all permissions.
+const AdminRole = "admin"
+
+// Permissions stores all permissions along with their descriptions.
+var Permissions = map[Permission]string{
+	UsersManage:      "Manage users and their roles",
+	UsersImpersonate: "Log in as other users",
+	EntitiesManage:   "Manage all entities in the admin panel",
+	TasksManage:      "Monitor the task queues",
+	BillingManage:    "Manage payments and payment webhook events",
+	ChatModerate:     "Moderate all chat rooms",
+	AuditView:        "Browse the audit log",
+	CacheManage:      "Monitor the cache and flush cached pages",
+}
+
+// Scope is the name of a scope which limits the requests an access token can be used to authenticate.
+type Scope string
+
+const (
+	// ScopeRead allows requests which do not make changes, such as GET requests.
+	ScopeRead Scope = "read"
+
+	// ScopeWrite allows requests which make changes, such as POST requests.
+	ScopeWrite Scope = "write"
+)
+
+// Scopes stores all access token scopes along with their descriptions.
+var Scopes = map[Scope]string{
+	ScopeRead:  "Read data",
+	ScopeWrite: "Make changes",
+}
+
+// RequiredScope returns the scope an access token needs in order to make a request with a given HTTP method.
+func RequiredScope(method string) Scope {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return ScopeRead
+	default:
+		return ScopeWrite
+	}
+}
+
+// Allows returns true if a given access token has been granted a given scope.
+func Allows(t *ent.AccessToken, scope Scope) bool {
+	return t != nil && slices.Contains(t.Scopes, string(scope))
+}
+
+// Has returns true if a given user has been granted a given permission by any of their roles.
+// The roles of the user and their permissions must have been loaded.
+func Has(u *ent.User, permission Permission) bool {
+	return slices.Contains(List(u), permission)
+}
+
+// List returns all permissions a given user has been granted by their roles.
+// The roles of the user and their permissions must have been loaded.
+func List(u *ent.User) []Permission {
+	permissions := make([]Permission, 0)
+	if u == nil {
+		return permissions
+	}
+
+	for _, r := range u.Edges.Roles {
+		for _, p := range r.Edges.Permissions {
+			if !slices.Contains(permissions, Permission(p.Name)) {
+				permissions = append(permissions, Permission(p.Name))
+			}
+		}
+	}
+
+	slices.Sort(permissions)
+	return permissions
+}
@@ -0,0 +1,54 @@
+package authz
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/occult/pagode/ent"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHas(t *testing.T) {
+	u := &ent.User{
+		Edges: ent.UserEdges{
+			Roles: []*ent.Role{
+				{
+					Name: "moderator",
+					Edges: ent.RoleEdges{
+						Permissions: []*ent.Permission{
+							{Name: string(ChatModerate)},
+						},
+					},
+				},
+				{
+					Name: "billing",
+					Edges: ent.RoleEdges{
+						Permissions: []*ent.Permission{
+							{Name: string(BillingManage)},
+							{Name: string(ChatModerate)},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	assert.True(t, Has(u, ChatModerate))
+	assert.True(t, Has(u, BillingManage))
+	assert.False(t, Has(u, UsersManage))
+	assert.False(t, Has(nil, ChatModerate))
+	assert.False(t, Has(&ent.User{}, ChatModerate))
+	assert.Equal(t, []Permission{BillingManage, ChatModerate}, List(u))
+	assert.Empty(t, List(nil))
+}
+
+func TestAllows(t *testing.T) {
+	token := &ent.AccessToken{Scopes: []string{string(ScopeRead)}}
+
+	assert.True(t, Allows(token, RequiredScope(http.MethodGet)))
+	assert.True(t, Allows(token, RequiredScope(http.MethodHead)))
+	assert.False(t, Allows(token, RequiredScope(http.MethodPost)))
+	assert.False(t, Allows(token, RequiredScope(http.MethodDelete)))
+	assert.False(t, Allows(nil, ScopeRead))
+}
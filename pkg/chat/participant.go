@@ -19,14 +19,14 @@ const (
 
 // Participant represents a connected WebSocket client.
 type Participant struct {
-	Conn    *websocket.Conn
-	Name    string
-	UserID  int
-	IsOwner bool
-	IsAdmin bool
-	Send    chan []byte
-	Hub     *Hub
-	IP      string
+	Conn        *websocket.Conn
+	Name        string
+	UserID      int
+	IsOwner     bool
+	IsModerator bool
+	Send        chan []byte
+	Hub         *Hub
+	IP          string
 
 	mu            sync.Mutex
 	messageCount  int
@@ -11,6 +11,12 @@ const (
 	// AuthenticatedUserKey is the key used to store the authenticated user in context.
 	AuthenticatedUserKey = "auth_user"
 
+	// ImpersonatorKey is the key used to store the admin who is impersonating the authenticated user in context.
+	ImpersonatorKey = "impersonator"
+
+	// AccessTokenKey is the key used to store the access token which authenticated the request in context.
+	AccessTokenKey = "access_token"
+
 	// UserKey is the key used to store a user in context.
 	UserKey = "user"
 
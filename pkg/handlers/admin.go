@@ -14,8 +14,12 @@ import (
 	"github.com/mikestefanello/backlite/ui"
 	"github.com/occult/pagode/ent"
 	"github.com/occult/pagode/ent/admin"
+	"github.com/occult/pagode/ent/paymentevent"
+	"github.com/occult/pagode/pkg/authz"
 	"github.com/occult/pagode/pkg/context"
 	"github.com/occult/pagode/pkg/form"
+	"github.com/occult/pagode/pkg/log"
+	"github.com/occult/pagode/pkg/middleware"
 	"github.com/occult/pagode/pkg/msg"
 	"github.com/occult/pagode/pkg/pager"
 	"github.com/occult/pagode/pkg/redirect"
@@ -27,6 +31,10 @@ import (
 
 type Admin struct {
 	orm      *ent.Client
+	auth     *services.AuthClient
+	audit    *services.AuditClient
+	cache    *services.CacheClient
+	payment  *services.PaymentClient
 	graph    *gen.Graph
 	admin    *admin.Handler
 	backlite *ui.Handler
@@ -44,6 +52,10 @@ func (h *Admin) Init(c *services.Container) error {
 	h.Inertia = c.Inertia
 	h.graph = c.Graph
 	h.orm = c.ORM
+	h.payment = c.Payment
+	h.auth = c.Auth
+	h.audit = c.Audit
+	h.cache = c.Cache
 	h.admin = admin.NewHandler(h.orm, admin.HandlerConfig{
 		ItemsPerPage: 25,
 		PageQueryKey: pager.QueryKey,
@@ -59,14 +71,24 @@ func (h *Admin) Init(c *services.Container) error {
 }
 
 func (h *Admin) Routes(g *echo.Group) {
-	ag := g.Group("/admin/users")
-
-	ag.GET("", h.Page).Name = routenames.AdminDashboard
-	ag.POST("/add", h.AddUser).Name = routenames.AdminUserAdd
-	ag.POST("/:id/edit", h.EditUser).Name = routenames.AdminUserEdit
-	ag.POST("/:id/delete", h.DeleteUser).Name = routenames.AdminUserDelete
-
-	entities := ag.Group("/entity")
+	// The app cannot be administered with an access token.
+	ag := g.Group("/admin/users", middleware.RequireSession)
+
+	users := ag.Group("", middleware.RequirePermission(authz.UsersManage))
+	users.GET("", h.Page).Name = routenames.AdminDashboard
+	users.POST("/add", h.AddUser).Name = routenames.AdminUserAdd
+	users.POST("/:id/edit", h.EditUser).Name = routenames.AdminUserEdit
+	users.POST("/:id/delete", h.DeleteUser).Name = routenames.AdminUserDelete
+	users.POST("/:id/unlock", h.UnlockUser).Name = routenames.AdminUserUnlock
+	users.POST("/:id/roles", h.UserRoles).Name = routenames.AdminUserRoles
+	users.POST("/:id/impersonate", h.ImpersonateUser, middleware.RequirePermission(authz.UsersImpersonate)).
+		Name = routenames.AdminUserImpersonate
+
+	// Impersonated users do not have the permissions of the admin, so stopping only requires authentication.
+	g.POST("/impersonation/stop", h.StopImpersonation, middleware.RequireAuthentication).
+		Name = routenames.ImpersonationStop
+
+	entities := ag.Group("/entity", middleware.RequirePermission(authz.EntitiesManage))
 	for _, n := range h.graph.Nodes {
 		ng := entities.Group(fmt.Sprintf("/%s", strings.ToLower(n.Name)))
 		ng.GET("", h.EntityList(n)).
@@ -85,13 +107,24 @@ func (h *Admin) Routes(g *echo.Group) {
 			Name = routenames.AdminEntityDeleteSubmit(n.Name)
 	}
 
-	tasks := ag.Group("/tasks")
+	tasks := ag.Group("/tasks", middleware.RequirePermission(authz.TasksManage))
 	tasks.GET("", h.Backlite(h.backlite.Running)).Name = routenames.AdminTasks
 	tasks.GET("/succeeded", h.Backlite(h.backlite.Succeeded))
 	tasks.GET("/failed", h.Backlite(h.backlite.Failed))
 	tasks.GET("/upcoming", h.Backlite(h.backlite.Upcoming))
 	tasks.GET("/task/:id", h.Backlite(h.backlite.Task))
 	tasks.GET("/completed/:id", h.Backlite(h.backlite.TaskCompleted))
+
+	events := ag.Group("/payment-events", middleware.RequirePermission(authz.BillingManage))
+	events.GET("", h.PaymentEvents).Name = routenames.AdminPaymentEvents
+	events.POST("/replay", h.PaymentEventReplayFailed).Name = routenames.AdminPaymentEventReplayFailed
+	events.POST("/:id/replay", h.PaymentEventReplay).Name = routenames.AdminPaymentEventReplay
+
+	ag.GET("/audit", h.AuditLog, middleware.RequirePermission(authz.AuditView)).Name = routenames.AdminAuditLog
+
+	cache := ag.Group("/cache", middleware.RequirePermission(authz.CacheManage))
+	cache.GET("", h.Cache).Name = routenames.AdminCache
+	cache.POST("/flush-pages", h.CacheFlushPages).Name = routenames.AdminCacheFlushPages
 }
 
 func (h *Admin) Page(ctx echo.Context) error {
@@ -112,6 +145,7 @@ func (h *Admin) Page(ctx echo.Context) error {
 
 	users, err := h.orm.User.
 		Query().
+		WithRoles().
 		Limit(limit).
 		Offset(offset).
 		All(ctx.Request().Context())
@@ -120,6 +154,12 @@ func (h *Admin) Page(ctx echo.Context) error {
 		return ctx.NoContent(500)
 	}
 
+	roles, err := h.auth.GetRoles(ctx)
+	if err != nil {
+		msg.Danger(ctx, "Failed to load roles.")
+		return ctx.NoContent(500)
+	}
+
 	totalPages := (total + limit - 1) / limit
 
 	err = h.Inertia.Render(
@@ -128,6 +168,7 @@ func (h *Admin) Page(ctx echo.Context) error {
 		"Admin/AdminView",
 		inertia.Props{
 			"users": users,
+			"roles": roles,
 			"pagination": map[string]any{
 				"total":      total,
 				"page":       page,
@@ -147,11 +188,15 @@ func (h *Admin) Page(ctx echo.Context) error {
 type AdminUserForm struct {
 	Name          string `form:"name" validate:"required"`
 	Email         string `form:"email" validate:"required,email"`
-	Admin         bool   `form:"admin"`
 	EmailVerified bool   `form:"emailVerified"`
 	form.Submission
 }
 
+type AdminUserRolesForm struct {
+	Roles []int `form:"roles" json:"roles"`
+	form.Submission
+}
+
 func (h *Admin) AddUser(ctx echo.Context) error {
 	w := ctx.Response().Writer
 	r := ctx.Request()
@@ -176,7 +221,6 @@ func (h *Admin) AddUser(ctx echo.Context) error {
 		Create().
 		SetName(input.Name).
 		SetEmail(strings.ToLower(input.Email)).
-		SetAdmin(input.Admin).
 		SetVerified(input.EmailVerified).
 		Save(r.Context())
 	if err != nil {
@@ -221,7 +265,6 @@ func (h *Admin) EditUser(ctx echo.Context) error {
 		UpdateOneID(id).
 		SetName(input.Name).
 		SetEmail(strings.ToLower(input.Email)).
-		SetAdmin(input.Admin).
 		SetVerified(input.EmailVerified).
 		Exec(r.Context())
 	if err != nil {
@@ -259,6 +302,133 @@ func (h *Admin) DeleteUser(ctx echo.Context) error {
 	return nil
 }
 
+func (h *Admin) UnlockUser(ctx echo.Context) error {
+	w := ctx.Response().Writer
+	r := ctx.Request()
+	uri := ctx.Echo().Reverse("admin_dashboard")
+
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		msg.Danger(ctx, "Invalid user ID.")
+		h.Inertia.Redirect(w, r, uri)
+		return nil
+	}
+
+	err = h.auth.UnlockUser(ctx, id)
+	if err != nil {
+		msg.Danger(ctx, "Failed to unlock user: "+err.Error())
+		h.Inertia.Redirect(w, r, uri)
+		return nil
+	}
+
+	msg.Success(ctx, "User successfully unlocked.")
+	h.Inertia.Redirect(w, r, uri)
+	return nil
+}
+
+func (h *Admin) UserRoles(ctx echo.Context) error {
+	w := ctx.Response().Writer
+	r := ctx.Request()
+	uri := ctx.Echo().Reverse("admin_dashboard")
+
+	var input AdminUserRolesForm
+	if err := form.Submit(ctx, &input); err != nil {
+		msg.Danger(ctx, "Invalid form data.")
+		h.Inertia.Redirect(w, r, uri)
+		return nil
+	}
+
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		msg.Danger(ctx, "Invalid user ID.")
+		h.Inertia.Redirect(w, r, uri)
+		return nil
+	}
+
+	err = h.auth.SetUserRoles(ctx, id, input.Roles...)
+	if err != nil {
+		msg.Danger(ctx, "Failed to update roles: "+err.Error())
+		h.Inertia.Redirect(w, r, uri)
+		return nil
+	}
+
+	msg.Success(ctx, "User roles successfully updated.")
+	h.Inertia.Redirect(w, r, uri)
+	return nil
+}
+
+func (h *Admin) ImpersonateUser(ctx echo.Context) error {
+	w := ctx.Response().Writer
+	r := ctx.Request()
+	uri := ctx.Echo().Reverse("admin_dashboard")
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		msg.Danger(ctx, "Invalid user ID.")
+		h.Inertia.Redirect(w, r, uri)
+		return nil
+	}
+
+	target, err := h.orm.User.Get(r.Context(), id)
+	if err != nil {
+		msg.Danger(ctx, "User not found.")
+		h.Inertia.Redirect(w, r, uri)
+		return nil
+	}
+
+	// Impersonation is only allowed once it has been recorded.
+	err = h.audit.Record(ctx, services.AuditActionImpersonationStart, usr.ID, "User", target.ID)
+	if err != nil {
+		return fail(err, "unable to record impersonation", h.Inertia, ctx)
+	}
+
+	err = h.auth.StartImpersonation(ctx, usr, target.ID)
+	switch err.(type) {
+	case nil:
+	case services.InvalidImpersonationError:
+		msg.Warning(ctx, fmt.Sprintf("You cannot impersonate %s.", target.Name))
+		h.Inertia.Redirect(w, r, uri)
+		return nil
+	default:
+		return fail(err, "unable to impersonate user", h.Inertia, ctx)
+	}
+
+	log.Ctx(ctx).Info("admin started impersonating user",
+		"admin_id", usr.ID,
+		"user_id", target.ID,
+	)
+
+	msg.Info(ctx, fmt.Sprintf("You are now impersonating %s.", target.Name))
+	h.Inertia.Redirect(w, r, ctx.Echo().Reverse(routenames.Dashboard))
+	return nil
+}
+
+func (h *Admin) StopImpersonation(ctx echo.Context) error {
+	impersonator, ok := ctx.Get(context.ImpersonatorKey).(*ent.User)
+	if !ok {
+		return echo.NewHTTPError(http.StatusBadRequest)
+	}
+
+	userID, err := h.auth.StopImpersonation(ctx)
+	if err != nil {
+		return fail(err, "unable to stop impersonating user", h.Inertia, ctx)
+	}
+
+	err = h.audit.Record(ctx, services.AuditActionImpersonationStop, impersonator.ID, "User", userID)
+	if err != nil {
+		log.Ctx(ctx).Error("unable to record end of impersonation",
+			"admin_id", impersonator.ID,
+			"user_id", userID,
+			"error", err,
+		)
+	}
+
+	msg.Success(ctx, "You are no longer impersonating a user.")
+	h.Inertia.Redirect(ctx.Response().Writer, ctx.Request(), ctx.Echo().Reverse(routenames.AdminDashboard))
+	return nil
+}
+
 // middlewareEntityLoad is middleware to extract the entity ID and attempt to load the given entity.
 func (h *Admin) middlewareEntityLoad(n *gen.Type) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
@@ -377,6 +547,111 @@ func (h *Admin) getEntitySchema(n *gen.Type) *load.Schema {
 	return nil
 }
 
+func (h *Admin) PaymentEvents(ctx echo.Context) error {
+	pgr := pager.NewPager(ctx, 25)
+
+	total, err := h.orm.PaymentEvent.Query().Count(ctx.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+	pgr.SetItems(total)
+
+	events, err := h.orm.PaymentEvent.Query().
+		Order(ent.Desc(paymentevent.FieldID)).
+		Limit(pgr.ItemsPerPage).
+		Offset(pgr.GetOffset()).
+		All(ctx.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	return pages.AdminPaymentEvents(ctx, events, pgr)
+}
+
+func (h *Admin) AuditLog(ctx echo.Context) error {
+	pgr := pager.NewPager(ctx, 25)
+
+	filter := services.AuditFilter{
+		Action:     ctx.QueryParam("action"),
+		EntityType: ctx.QueryParam("entity_type"),
+	}
+	filter.EntityID, _ = strconv.Atoi(ctx.QueryParam("entity_id"))
+	filter.ActorID, _ = strconv.Atoi(ctx.QueryParam("actor_id"))
+
+	events, total, err := h.audit.GetEvents(ctx, filter, pgr.ItemsPerPage, pgr.GetOffset())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+	pgr.SetItems(total)
+
+	return pages.AdminAuditLog(ctx, events, filter, h.audit.GetEntityTypes(), pgr)
+}
+
+func (h *Admin) Cache(ctx echo.Context) error {
+	stats, err := h.cache.Stats(ctx.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	return pages.AdminCache(ctx, stats)
+}
+
+func (h *Admin) CacheFlushPages(ctx echo.Context) error {
+	err := h.cache.
+		Flush().
+		Tags(middleware.PageCacheTag).
+		Execute(ctx.Request().Context())
+	if err != nil {
+		msg.Danger(ctx, fmt.Sprintf("Failed to flush the cached pages: %s", err))
+	} else {
+		msg.Success(ctx, "Successfully flushed the cached pages.")
+	}
+
+	return redirect.
+		New(ctx).
+		Route(routenames.AdminCache).
+		StatusCode(http.StatusFound).
+		Go()
+}
+
+func (h *Admin) PaymentEventReplay(ctx echo.Context) error {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid event ID")
+	}
+
+	err = h.payment.ReplayWebhookEvent(ctx.Request().Context(), id)
+	switch {
+	case err == nil:
+		msg.Success(ctx, fmt.Sprintf("Successfully replayed event (ID %d).", id))
+	case ent.IsNotFound(err):
+		return echo.NewHTTPError(http.StatusNotFound, "event not found")
+	default:
+		msg.Danger(ctx, fmt.Sprintf("Failed to replay event (ID %d): %s", id, err))
+	}
+
+	return redirect.
+		New(ctx).
+		Route(routenames.AdminPaymentEvents).
+		StatusCode(http.StatusFound).
+		Go()
+}
+
+func (h *Admin) PaymentEventReplayFailed(ctx echo.Context) error {
+	replayed, err := h.payment.ReplayFailedWebhookEvents(ctx.Request().Context())
+	if err != nil {
+		msg.Danger(ctx, fmt.Sprintf("Replayed %d event(s) but some failed: %s", replayed, err))
+	} else {
+		msg.Success(ctx, fmt.Sprintf("Successfully replayed %d event(s).", replayed))
+	}
+
+	return redirect.
+		New(ctx).
+		Route(routenames.AdminPaymentEvents).
+		StatusCode(http.StatusFound).
+		Go()
+}
+
 func (h *Admin) Backlite(handler func(http.ResponseWriter, *http.Request) error) echo.HandlerFunc {
 	return func(c echo.Context) error {
 		if id := c.Param("id"); id != "" {
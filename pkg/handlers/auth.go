@@ -20,6 +20,8 @@ import (
 	"github.com/occult/pagode/pkg/routenames"
 	"github.com/occult/pagode/pkg/services"
 	"github.com/occult/pagode/pkg/ui"
+	"github.com/occult/pagode/pkg/ui/emails"
+	"github.com/occult/pagode/pkg/webauthn"
 
 	inertia "github.com/romsar/gonertia/v2"
 )
@@ -27,11 +29,23 @@ import (
 type Auth struct {
 	config  *config.Config
 	auth    *services.AuthClient
+	audit   *services.AuditClient
+	oauth   *services.OAuthClient
+	passkey *services.WebAuthnClient
 	mail    *services.MailClient
+	cache   *services.CacheClient
 	orm     *ent.Client
 	Inertia *inertia.Inertia
 }
 
+// loginResponder responds to the steps of a login. Logins completed by an external provider respond differently from
+// those submitted within the app, since the browser does not send the session cookies with redirects which follow a
+// cross-site request.
+type loginResponder interface {
+	loginRedirect(ctx echo.Context, url string) error
+	loginFailed(ctx echo.Context, err error, message string) error
+}
+
 type RegisterForm struct {
 	Name            string `form:"name" validate:"required"`
 	Email           string `form:"email" validate:"required,email"`
@@ -46,6 +60,29 @@ type LoginForm struct {
 	form.Submission
 }
 
+type TwoFactorChallengeForm struct {
+	Code         string `form:"code" validate:"required_without=RecoveryCode"`
+	RecoveryCode string `form:"recovery_code"`
+	Remember     bool   `form:"remember"`
+	form.Submission
+}
+
+type LoginLinkForm struct {
+	Email string `form:"email" validate:"required,email"`
+	form.Submission
+}
+
+type PasskeyLoginForm struct {
+	Credential webauthn.AssertionResponse `json:"credential"`
+	form.Submission
+}
+
+type PasskeyRegisterForm struct {
+	Name       string                        `form:"name" json:"name" validate:"required"`
+	Credential webauthn.RegistrationResponse `json:"credential"`
+	form.Submission
+}
+
 type ForgotPassword struct {
 	Email string `form:"email" validate:"required,email"`
 	form.Submission
@@ -66,7 +103,11 @@ func (h *Auth) Init(c *services.Container) error {
 	h.config = c.Config
 	h.orm = c.ORM
 	h.auth = c.Auth
+	h.audit = c.Audit
+	h.oauth = c.OAuth
+	h.passkey = c.WebAuthn
 	h.mail = c.Mail
+	h.cache = c.Cache
 	h.Inertia = c.Inertia
 	return nil
 }
@@ -74,21 +115,38 @@ func (h *Auth) Init(c *services.Container) error {
 func (h *Auth) Routes(g *echo.Group) {
 	g.GET("/logout", h.Logout, middleware.RequireAuthentication).Name = routenames.Logout
 	g.GET("/email/verify/:token", h.VerifyEmail).Name = routenames.VerifyEmail
+	g.GET("/email/change/:token", h.ConfirmEmailChange).Name = routenames.ConfirmEmailChange
+
+	passkeys := g.Group("/user/passkeys", middleware.RequireAuthentication, middleware.RequireSession)
+	passkeys.POST("/options", h.PasskeyRegisterOptions, middleware.RequireNoImpersonation).Name = routenames.PasskeyRegisterOptions
+	passkeys.POST("", h.PasskeyRegisterSubmit, middleware.RequireNoImpersonation).Name = routenames.PasskeyRegisterSubmit
+
+	loginLimit := middleware.RateLimit(h.cache, "login", h.config.RateLimit.Login)
+	registerLimit := middleware.RateLimit(h.cache, "register", h.config.RateLimit.Register)
+	passwordResetLimit := middleware.RateLimit(h.cache, "password_reset", h.config.RateLimit.PasswordReset)
 
 	noAuth := g.Group("/user", middleware.RequireNoAuthentication)
 	noAuth.GET("/login", h.LoginPage).Name = routenames.Login
-	noAuth.POST("/login", h.LoginSubmit).Name = routenames.LoginSubmit
+	noAuth.POST("/login", h.LoginSubmit, loginLimit).Name = routenames.LoginSubmit
+	noAuth.GET("/login/link", h.LoginLinkPage).Name = routenames.LoginLink
+	noAuth.POST("/login/link", h.LoginLinkSubmit, loginLimit).Name = routenames.LoginLinkSubmit
+	noAuth.GET("/login/link/:token", h.LoginLinkConfirmPage).Name = routenames.LoginLinkConfirm
+	noAuth.POST("/login/link/:token", h.LoginLinkConfirmSubmit).Name = routenames.LoginLinkConfirmSubmit
+	noAuth.POST("/login/passkey/options", h.LoginPasskeyOptions).Name = routenames.LoginPasskeyOptions
+	noAuth.POST("/login/passkey", h.LoginPasskeySubmit, loginLimit).Name = routenames.LoginPasskeySubmit
+	noAuth.GET("/login/two-factor", h.TwoFactorChallengePage).Name = routenames.TwoFactorChallenge
+	noAuth.POST("/login/two-factor", h.TwoFactorChallengeSubmit, loginLimit).Name = routenames.TwoFactorChallengeSubmit
 	noAuth.GET("/register", h.RegisterPage).Name = routenames.Register
-	noAuth.POST("/register", h.RegisterSubmit).Name = routenames.RegisterSubmit
+	noAuth.POST("/register", h.RegisterSubmit, registerLimit).Name = routenames.RegisterSubmit
 	noAuth.GET("/password", h.ForgotPasswordPage).Name = routenames.ForgotPassword
-	noAuth.POST("/password", h.ForgotPasswordSubmit).Name = routenames.ForgotPasswordSubmit
+	noAuth.POST("/password", h.ForgotPasswordSubmit, passwordResetLimit).Name = routenames.ForgotPasswordSubmit
 
 	resetGroup := noAuth.Group("/password/reset",
 		middleware.LoadUser(h.orm),
 		middleware.LoadValidPasswordToken(h.auth),
 	)
 	resetGroup.GET("/token/:user/:password_token/:token", h.ResetPasswordPage).Name = routenames.ResetPassword
-	resetGroup.POST("/token/:user/:password_token/:token", h.ResetPasswordSubmit).Name = routenames.ResetPasswordSubmit
+	resetGroup.POST("/token/:user/:password_token/:token", h.ResetPasswordSubmit, passwordResetLimit).Name = routenames.ResetPasswordSubmit
 }
 
 func (h *Auth) LoginPage(ctx echo.Context) error {
@@ -100,6 +158,7 @@ func (h *Auth) LoginPage(ctx echo.Context) error {
 		"Auth/Login",
 		inertia.Props{
 			"canResetPassword": canResetPassword,
+			"providers":        oauthProviders(h.oauth),
 		},
 	)
 	if err != nil {
@@ -144,33 +203,456 @@ func (h *Auth) LoginSubmit(ctx echo.Context) error {
 
 	switch err.(type) {
 	case *ent.NotFoundError:
-		return authFailed()
+		u = nil
 	case nil:
 	default:
 		return fail(err, "error querying user during login", h.Inertia, ctx)
 	}
 
+	// Block brute-force attempts before checking the password.
+	if locked, err := h.lockedOut(ctx, input.Email, u, h); locked {
+		return err
+	}
+
 	// Check if the password is correct.
-	err = h.auth.CheckPassword(input.Password, u.Password)
-	if err != nil {
+	if u == nil || h.auth.CheckPassword(input.Password, u.Password) != nil {
+		if err = h.recordFailedLogin(ctx, strings.ToLower(input.Email), u); err != nil {
+			return err
+		}
 		return authFailed()
 	}
 
-	// Log the user in.
-	err = h.auth.Login(ctx, u.ID)
+	return h.authenticated(ctx, u, h)
+}
+
+// loginRedirect redirects a login submitted within the app.
+func (h *Auth) loginRedirect(ctx echo.Context, url string) error {
+	h.Inertia.Redirect(ctx.Response().Writer, ctx.Request(), url)
+	return nil
+}
+
+// loginFailed fails a login submitted within the app.
+func (h *Auth) loginFailed(ctx echo.Context, err error, message string) error {
+	return fail(err, message, h.Inertia, ctx)
+}
+
+// lockedOut checks if logging in with a given email address to a given user, which is nil if no user matched, is
+// blocked after too many failed attempts and if so, redirects to the login page. This must be checked on every login
+// path.
+func (h *Auth) lockedOut(ctx echo.Context, email string, u *ent.User, resp loginResponder) (bool, error) {
+	err := h.auth.CheckLoginAllowed(ctx, email, u)
+	switch err.(type) {
+	case nil:
+		return false, nil
+	case services.LoginLockedError:
+		msg.Danger(ctx, "Too many failed login attempts. Please try again later.")
+		return true, resp.loginRedirect(ctx, ctx.Echo().Reverse(routenames.Login))
+	default:
+		return true, resp.loginFailed(ctx, err, "error checking login lockout")
+	}
+}
+
+// recordFailedLogin records a failed attempt to provide an authentication factor and notifies the user if this
+// locked their account.
+func (h *Auth) recordFailedLogin(ctx echo.Context, email string, u *ent.User) error {
+	locked, err := h.auth.RecordFailedLogin(ctx, email, u)
 	if err != nil {
-		return fail(err, "unable to log in user", h.Inertia, ctx)
+		return fail(err, "error recording failed login", h.Inertia, ctx)
+	}
+
+	if locked != nil {
+		h.sendLockoutEmail(ctx, locked)
+	}
+
+	return nil
+}
+
+// sendLockoutEmail notifies a user that their account has been locked after too many failed login attempts.
+func (h *Auth) sendLockoutEmail(ctx echo.Context, u *ent.User) {
+	log.Ctx(ctx).Warn("user locked out after failed login attempts",
+		"user_id", u.ID,
+		"locked_until", u.LockedUntil,
+	)
+
+	err := h.mail.Compose().
+		To(u.Email).
+		Subject("Your account has been locked").
+		Component(emails.AccountLocked(ctx, u.Name, *u.LockedUntil)).
+		Send(ctx)
+	if err != nil {
+		log.Ctx(ctx).Error("unable to send account locked email",
+			"user_id", u.ID,
+			"error", err,
+		)
+	}
+}
+
+// authenticated continues logging in a user who has provided their first authentication factor, which every login
+// path must go through.
+func (h *Auth) authenticated(ctx echo.Context, u *ent.User, resp loginResponder) error {
+	if locked, err := h.lockedOut(ctx, u.Email, u, resp); locked {
+		return err
+	}
+
+	// Require the second factor, unless this device has been remembered.
+	if u.TotpSecret != "" && !h.auth.IsTwoFactorDeviceRemembered(ctx, u) {
+		if err := h.auth.SetPendingTwoFactorUserID(ctx, u.ID); err != nil {
+			return resp.loginFailed(ctx, err, "unable to start two-factor authentication")
+		}
+
+		return resp.loginRedirect(ctx, ctx.Echo().Reverse(routenames.TwoFactorChallenge))
+	}
+
+	return h.login(ctx, u, resp)
+}
+
+// login logs a user in who has provided all required authentication factors.
+func (h *Auth) login(ctx echo.Context, u *ent.User, resp loginResponder) error {
+	err := h.auth.Login(ctx, u.ID)
+	if err != nil {
+		return resp.loginFailed(ctx, err, "unable to log in user")
+	}
+
+	// Failed attempts only stop counting once every factor has been provided.
+	if err = h.auth.ClearFailedLogins(ctx, u.ID); err != nil {
+		log.Ctx(ctx).Error("unable to clear failed logins",
+			"user_id", u.ID,
+			"error", err,
+		)
 	}
 
 	uriDashboard := ctx.Echo().Reverse(routenames.Dashboard)
 
 	msg.Success(ctx, fmt.Sprintf("Welcome back, %s. You are now logged in.", u.Name))
+	if u.DeletedAt != nil {
+		msg.Info(ctx, "Your account has been restored and will no longer be deleted.")
+	}
 
-	h.Inertia.Redirect(w, r, uriDashboard)
+	return resp.loginRedirect(ctx, uriDashboard)
+}
+
+func (h *Auth) LoginLinkPage(ctx echo.Context) error {
+	err := h.Inertia.Render(
+		ctx.Response().Writer,
+		ctx.Request(),
+		"Auth/LoginLink",
+	)
+	if err != nil {
+		handleServerErr(ctx.Response().Writer, err)
+		return err
+	}
+
+	return nil
+}
+
+func (h *Auth) LoginLinkSubmit(ctx echo.Context) error {
+	var input LoginLinkForm
+
+	w := ctx.Response().Writer
+	r := ctx.Request()
+
+	uriLoginLink := ctx.Echo().Reverse(routenames.LoginLink)
+
+	succeed := func() error {
+		form.Clear(ctx)
+		msg.Success(ctx, "An email containing a link to log in will be sent to this address if it exists in our system.")
+		h.Inertia.Redirect(w, r, uriLoginLink)
+		return nil
+	}
+
+	err := form.Submit(ctx, &input)
+	switch err.(type) {
+	case nil:
+	case validator.ValidationErrors:
+		return h.LoginLinkPage(ctx)
+	default:
+		return fail(err, "form submission error on login link", h.Inertia, ctx)
+	}
+
+	// Attempt to load the user.
+	u, err := h.orm.User.
+		Query().
+		Where(user.Email(strings.ToLower(input.Email))).
+		Only(ctx.Request().Context())
+
+	switch err.(type) {
+	case *ent.NotFoundError:
+		// We return success without revealing the email does not exist. This prevents user enumeration.
+		return succeed()
+	case nil:
+	default:
+		return fail(err, "error querying user during login link", h.Inertia, ctx)
+	}
+
+	// Generate the token.
+	token, err := h.auth.GenerateLoginToken(ctx, u.ID)
+	if err != nil {
+		return fail(err, "error generating login token", h.Inertia, ctx)
+	}
+
+	log.Ctx(ctx).Info("generated login token",
+		"user_id", u.ID,
+	)
+
+	err = h.mail.Compose().
+		To(u.Email).
+		Subject("Your login link").
+		Component(emails.LoginLink(ctx, u.Name, token, h.config.App.LoginTokenExpiration)).
+		Send(ctx)
+	if err != nil {
+		log.Ctx(ctx).Error("unable to send login link email",
+			"user_id", u.ID,
+			"error", err,
+		)
+		return fail(err, "failed to send login link email", h.Inertia, ctx)
+	}
+
+	return succeed()
+}
+
+// LoginLinkConfirmPage asks the user to confirm logging in with a link, rather than logging them in right away,
+// so the link is not used up by email clients and scanners which follow links to preview them.
+func (h *Auth) LoginLinkConfirmPage(ctx echo.Context) error {
+	err := h.Inertia.Render(
+		ctx.Response().Writer,
+		ctx.Request(),
+		"Auth/LoginLinkConfirm",
+		inertia.Props{
+			"token": ctx.Param("token"),
+		},
+	)
+	if err != nil {
+		handleServerErr(ctx.Response().Writer, err)
+		return err
+	}
+
+	return nil
+}
+
+func (h *Auth) LoginLinkConfirmSubmit(ctx echo.Context) error {
+	userID, err := h.auth.UseLoginToken(ctx, ctx.Param("token"))
+	switch err.(type) {
+	case nil:
+	case services.InvalidLoginTokenError:
+		msg.Warning(ctx, "The link is either invalid or has expired. Please request a new one.")
+		h.Inertia.Redirect(ctx.Response().Writer, ctx.Request(), ctx.Echo().Reverse(routenames.LoginLink))
+		return nil
+	default:
+		return fail(err, "error using login token", h.Inertia, ctx)
+	}
+
+	u, err := h.orm.User.Get(ctx.Request().Context(), userID)
+	if err != nil {
+		return fail(err, "error querying user during login link", h.Inertia, ctx)
+	}
+
+	// The user proved they own the email address.
+	if !u.Verified {
+		u, err = u.Update().
+			SetVerified(true).
+			Save(ctx.Request().Context())
+		if err != nil {
+			return fail(err, "failed to set user as verified", h.Inertia, ctx)
+		}
+	}
+
+	return h.authenticated(ctx, u, h)
+}
+
+// LoginPasskeyOptions starts logging in with a passkey and responds with the options the browser needs to use one.
+func (h *Auth) LoginPasskeyOptions(ctx echo.Context) error {
+	options, err := h.passkey.BeginLogin(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("unable to start passkey login: %v", err))
+	}
+
+	return ctx.JSON(http.StatusOK, options)
+}
+
+// LoginPasskeySubmit logs in the user a passkey belongs to. Passkeys require user verification, so they count as
+// both factors and no two-factor challenge is needed.
+func (h *Auth) LoginPasskeySubmit(ctx echo.Context) error {
+	var input PasskeyLoginForm
+
+	if err := form.Submit(ctx, &input); err != nil {
+		msg.Danger(ctx, "The passkey could not be verified.")
+		h.Inertia.Back(ctx.Response().Writer, ctx.Request())
+		return nil
+	}
+
+	u, err := h.passkey.FinishLogin(ctx, &input.Credential)
+	switch err.(type) {
+	case nil:
+	case services.InvalidPasskeyError:
+		log.Ctx(ctx).Info("invalid passkey login", "error", err)
+		msg.Danger(ctx, "The passkey could not be verified.")
+		h.Inertia.Back(ctx.Response().Writer, ctx.Request())
+		return nil
+	default:
+		return fail(err, "error verifying passkey", h.Inertia, ctx)
+	}
+
+	if locked, err := h.lockedOut(ctx, u.Email, u, h); locked {
+		return err
+	}
+
+	return h.login(ctx, u, h)
+}
+
+// PasskeyRegisterOptions starts registering a passkey for the authenticated user and responds with the options
+// the browser needs to create one.
+func (h *Auth) PasskeyRegisterOptions(ctx echo.Context) error {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	options, err := h.passkey.BeginRegistration(ctx, usr)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("unable to start passkey registration: %v", err))
+	}
+
+	return ctx.JSON(http.StatusOK, options)
+}
+
+func (h *Auth) PasskeyRegisterSubmit(ctx echo.Context) error {
+	var input PasskeyRegisterForm
+
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	err := form.Submit(ctx, &input)
+	switch err.(type) {
+	case nil:
+	case validator.ValidationErrors:
+		msg.Warning(ctx, "Please enter a name for the passkey.")
+		h.Inertia.Back(ctx.Response().Writer, ctx.Request())
+		return nil
+	default:
+		return err
+	}
+
+	_, err = h.passkey.FinishRegistration(ctx, usr, input.Name, &input.Credential)
+	switch err.(type) {
+	case nil:
+	case services.InvalidPasskeyError:
+		log.Ctx(ctx).Info("invalid passkey registration", "error", err)
+		msg.Danger(ctx, "The passkey could not be verified.")
+		h.Inertia.Back(ctx.Response().Writer, ctx.Request())
+		return nil
+	default:
+		return fail(err, "error registering passkey", h.Inertia, ctx)
+	}
+
+	msg.Success(ctx, "The passkey has been added.")
+	h.Inertia.Redirect(ctx.Response().Writer, ctx.Request(), ctx.Echo().Reverse(routenames.ProfilePasskeys))
 	return nil
 }
 
+func (h *Auth) TwoFactorChallengePage(ctx echo.Context) error {
+	if _, err := h.auth.GetPendingTwoFactorUserID(ctx); err != nil {
+		msg.Warning(ctx, "Please log in to continue.")
+		h.Inertia.Redirect(ctx.Response().Writer, ctx.Request(), ctx.Echo().Reverse(routenames.Login))
+		return nil
+	}
+
+	err := h.Inertia.Render(
+		ctx.Response().Writer,
+		ctx.Request(),
+		"Auth/TwoFactorChallenge",
+		inertia.Props{
+			"rememberDays": int(h.config.App.TwoFactor.RememberDeviceExpiration.Hours() / 24),
+		},
+	)
+	if err != nil {
+		handleServerErr(ctx.Response().Writer, err)
+		return err
+	}
+
+	return nil
+}
+
+func (h *Auth) TwoFactorChallengeSubmit(ctx echo.Context) error {
+	w := ctx.Response().Writer
+	r := ctx.Request()
+
+	var input TwoFactorChallengeForm
+
+	uriLogin := ctx.Echo().Reverse(routenames.Login)
+	uriChallenge := ctx.Echo().Reverse(routenames.TwoFactorChallenge)
+
+	userID, err := h.auth.GetPendingTwoFactorUserID(ctx)
+	if err != nil {
+		msg.Warning(ctx, "Your login attempt has expired. Please log in again.")
+		h.Inertia.Redirect(w, r, uriLogin)
+		return nil
+	}
+
+	err = form.Submit(ctx, &input)
+
+	switch err.(type) {
+	case nil:
+	case validator.ValidationErrors:
+		msg.Danger(ctx, "Please enter your authentication code.")
+		h.Inertia.Redirect(w, r, uriChallenge)
+		return nil
+	default:
+		return err
+	}
+
+	u, err := h.orm.User.Get(ctx.Request().Context(), userID)
+	if err != nil {
+		return fail(err, "error querying user during two-factor authentication", h.Inertia, ctx)
+	}
+
+	// Block brute-force attempts before checking the code.
+	if locked, err := h.lockedOut(ctx, u.Email, u, h); locked {
+		return err
+	}
+
+	if input.RecoveryCode != "" {
+		err = h.auth.UseRecoveryCode(ctx, u.ID, input.RecoveryCode)
+	} else if valid, verr := h.auth.ValidateTOTP(ctx.Request().Context(), u, input.Code); verr != nil {
+		err = verr
+	} else if !valid {
+		err = services.InvalidTwoFactorCodeError{}
+	}
+
+	switch err.(type) {
+	case nil:
+	case services.InvalidTwoFactorCodeError:
+		if err = h.recordFailedLogin(ctx, u.Email, u); err != nil {
+			return err
+		}
+		msg.Danger(ctx, "Invalid authentication code. Please try again.")
+		h.Inertia.Redirect(w, r, uriChallenge)
+		return nil
+	default:
+		return fail(err, "error verifying two-factor authentication code", h.Inertia, ctx)
+	}
+
+	if input.Remember {
+		if err = h.auth.RememberTwoFactorDevice(ctx, u); err != nil {
+			log.Ctx(ctx).Error("unable to remember two-factor device",
+				"user_id", u.ID,
+				"error", err,
+			)
+		}
+	}
+
+	return h.login(ctx, u, h)
+}
+
 func (h *Auth) Logout(ctx echo.Context) error {
+	// Logging out also ends any impersonation, which is recorded like stopping it.
+	if impersonator, ok := ctx.Get(context.ImpersonatorKey).(*ent.User); ok {
+		usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+		err := h.audit.Record(ctx, services.AuditActionImpersonationStop, impersonator.ID, "User", usr.ID)
+		if err != nil {
+			log.Ctx(ctx).Error("unable to record end of impersonation",
+				"admin_id", impersonator.ID,
+				"user_id", usr.ID,
+				"error", err,
+			)
+		}
+	}
+
 	if err := h.auth.Logout(ctx); err == nil {
 		msg.Success(ctx, "You have been logged out successfully.")
 	} else {
@@ -187,7 +669,8 @@ func (h *Auth) RegisterPage(ctx echo.Context) error {
 		ctx.Request(),
 		"Auth/Register",
 		inertia.Props{
-			"text": "Teste",
+			"text":      "Teste",
+			"providers": oauthProviders(h.oauth),
 		},
 	)
 	if err != nil {
@@ -225,6 +708,10 @@ func (h *Auth) RegisterSubmit(ctx echo.Context) error {
 		return nil
 	}
 
+	if rejectPassword(ctx, h.auth, h.Inertia, input.Password, input.Name, input.Email) {
+		return nil
+	}
+
 	// Attempt to create the user
 	u, err := h.orm.User.
 		Create().
@@ -324,6 +811,7 @@ func (h *Auth) VerifyEmail(ctx echo.Context) error {
 	if err != nil {
 		msg.Warning(ctx, "The link is either invalid or has expired.")
 		h.Inertia.Redirect(w, r, uriForgotPassword)
+		return nil
 	}
 
 	// Check if it matches the authenticated user.
@@ -363,6 +851,38 @@ func (h *Auth) VerifyEmail(ctx echo.Context) error {
 	return nil
 }
 
+func (h *Auth) ConfirmEmailChange(ctx echo.Context) error {
+	usr, err := h.auth.ConfirmEmailChange(ctx, ctx.Param("token"))
+
+	switch err.(type) {
+	case nil:
+	case services.InvalidEmailChangeTokenError:
+		msg.Warning(ctx, "The link is either invalid or has expired.")
+		h.Inertia.Redirect(ctx.Response().Writer, ctx.Request(), ctx.Echo().Reverse(routenames.Welcome))
+		return nil
+	case services.EmailTakenError:
+		msg.Warning(ctx, "A user with this email address already exists.")
+		h.Inertia.Redirect(ctx.Response().Writer, ctx.Request(), ctx.Echo().Reverse(routenames.Welcome))
+		return nil
+	default:
+		return fail(err, "unable to confirm email change", h.Inertia, ctx)
+	}
+
+	log.Ctx(ctx).Info("user changed email address",
+		"user_id", usr.ID,
+	)
+
+	msg.Success(ctx, "Your email address has been changed.")
+
+	if ctx.Get(context.AuthenticatedUserKey) != nil {
+		h.Inertia.Redirect(ctx.Response().Writer, ctx.Request(), ctx.Echo().Reverse(routenames.ProfileEdit))
+		return nil
+	}
+
+	h.Inertia.Redirect(ctx.Response().Writer, ctx.Request(), ctx.Echo().Reverse(routenames.Login))
+	return nil
+}
+
 func (h *Auth) ForgotPasswordPage(ctx echo.Context) error {
 	err := h.Inertia.Render(
 		ctx.Response().Writer,
@@ -519,6 +1039,10 @@ func (h *Auth) ResetPasswordSubmit(ctx echo.Context) error {
 		return nil
 	}
 
+	if rejectPassword(ctx, h.auth, h.Inertia, input.Password, usr.Name, usr.Email) {
+		return nil
+	}
+
 	_, err = usr.Update().
 		SetPassword(input.Password).
 		Save(ctx.Request().Context())
@@ -535,6 +1059,14 @@ func (h *Auth) ResetPasswordSubmit(ctx echo.Context) error {
 		return nil
 	}
 
+	// Sign out all devices and revoke all access tokens, since the account may have been compromised.
+	if err = h.auth.RevokeAllSessions(ctx, usr.ID); err != nil {
+		log.Ctx(ctx).Error("unable to revoke sessions after password reset", "error", err)
+	}
+	if err = h.auth.RevokeAllAccessTokens(ctx, usr.ID); err != nil {
+		log.Ctx(ctx).Error("unable to revoke access tokens after password reset", "error", err)
+	}
+
 	msg.Success(ctx, "Your password has been updated.")
 	h.Inertia.Redirect(w, r, uriLogin)
 	return nil
@@ -16,6 +16,7 @@ type Billing struct {
 	Inertia *inertia.Inertia
 	Payment *services.PaymentClient
 	Auth    *services.AuthClient
+	Orgs    *services.OrganizationClient
 }
 
 func init() {
@@ -26,15 +27,16 @@ func (h *Billing) Init(c *services.Container) error {
 	h.Inertia = c.Inertia
 	h.Payment = c.Payment
 	h.Auth = c.Auth
+	h.Orgs = c.Organization
 	return nil
 }
 
 func (h *Billing) Routes(g *echo.Group) {
 	authGroup := g.Group("")
-	authGroup.Use(middleware.RequireAuthentication)
+	authGroup.Use(middleware.RequireAuthentication, middleware.RequireSession)
 	
 	authGroup.GET("/billing", h.Page).Name = routenames.Billing
-	authGroup.POST("/billing/cancel", h.CancelSubscription).Name = routenames.BillingCancel
+	authGroup.POST("/billing/cancel", h.CancelSubscription, middleware.RequireNoImpersonation).Name = routenames.BillingCancel
 }
 
 func (h *Billing) Page(ctx echo.Context) error {
@@ -45,7 +47,7 @@ func (h *Billing) Page(ctx echo.Context) error {
 	}
 
 	// Get payment customer
-	paymentCustomer, err := h.Payment.CreateOrGetCustomer(ctx, user)
+	paymentCustomer, err := billingCustomer(ctx, h.Payment, h.Orgs, user, false)
 	if err != nil {
 		return err
 	}
@@ -138,7 +140,7 @@ func (h *Billing) CancelSubscription(ctx echo.Context) error {
 	}
 
 	// Get payment customer
-	paymentCustomer, err := h.Payment.CreateOrGetCustomer(ctx, user)
+	paymentCustomer, err := billingCustomer(ctx, h.Payment, h.Orgs, user, true)
 	if err != nil {
 		return fail(err, "Unable to get payment customer", h.Inertia, ctx)
 	}
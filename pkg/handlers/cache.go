@@ -37,15 +37,15 @@ func (h *Cache) Page(ctx echo.Context) error {
 	f := form.Get[forms.Cache](ctx)
 
 	// Fetch the value from the cache.
-	value, err := h.cache.
+	value, err := services.FetchAs[string](ctx.Request().Context(), h.cache.
 		Get().
-		Key("page_cache_example").
-		Fetch(ctx.Request().Context())
+		Key("page_cache_example"),
+	)
 
 	// Store the value in the form, so it can be rendered, if found.
 	switch {
 	case err == nil:
-		f.CurrentValue = value.(string)
+		f.CurrentValue = value
 	case errors.Is(err, services.ErrCacheMiss):
 	default:
 		return fail(err, "failed to fetch from cache", h.inertia, ctx)
@@ -62,12 +62,12 @@ func (h *Cache) Submit(ctx echo.Context) error {
 	}
 
 	// Set the cache.
-	err := h.cache.
+	err := services.SaveAs(ctx.Request().Context(), h.cache.
 		Set().
 		Key("page_cache_example").
-		Data(input.Value).
-		Expiration(30 * time.Minute).
-		Save(ctx.Request().Context())
+		Expiration(30*time.Minute),
+		input.Value,
+	)
 	if err != nil {
 		return fail(err, "failed to fetch from cache", h.inertia, ctx)
 	}
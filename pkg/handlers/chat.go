@@ -11,7 +11,6 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
-	"sync"
 	"time"
 
 	"github.com/go-playground/validator/v10"
@@ -23,6 +22,7 @@ import (
 	"github.com/occult/pagode/ent/chatmessage"
 	"github.com/occult/pagode/ent/chatroom"
 	"github.com/occult/pagode/ent/user"
+	"github.com/occult/pagode/pkg/authz"
 	"github.com/occult/pagode/pkg/chat"
 	appctx "github.com/occult/pagode/pkg/context"
 	"github.com/occult/pagode/pkg/form"
@@ -39,6 +39,7 @@ type Chat struct {
 	chat    *chat.RoomManager
 	auth    *services.AuthClient
 	config  *config.Config
+	cache   *services.CacheClient
 	Inertia *inertia.Inertia
 }
 
@@ -58,6 +59,7 @@ func (h *Chat) Init(c *services.Container) error {
 	h.chat = c.Chat
 	h.auth = c.Auth
 	h.config = c.Config
+	h.cache = c.Cache
 	h.Inertia = c.Inertia
 	return nil
 }
@@ -70,7 +72,7 @@ func (h *Chat) Routes(g *echo.Group) {
 	g.GET("/chat", h.Index).Name = routenames.ChatRooms
 	g.GET("/chat/rooms/:id", h.Room).Name = routenames.ChatRoom
 	g.GET("/chat/rooms/:id/messages", h.Messages)
-	g.POST("/chat/upload", h.UploadFile)
+	g.POST("/chat/upload", h.UploadFile, middleware.RateLimit(h.cache, "upload", h.config.RateLimit.Upload))
 
 	authGroup := g.Group("")
 	authGroup.Use(middleware.RequireAuthentication)
@@ -341,7 +343,7 @@ func (h *Chat) BanUser(ctx echo.Context) error {
 		return err
 	}
 
-	// Verify owner or admin
+	// Verify owner or moderator
 	room, err := h.orm.ChatRoom.Query().
 		Where(chatroom.IDEQ(roomID)).
 		WithOwner().
@@ -351,7 +353,7 @@ func (h *Chat) BanUser(ctx echo.Context) error {
 	}
 
 	owner, _ := room.Edges.OwnerOrErr()
-	if !authUser.Admin && (owner == nil || owner.ID != authUser.ID) {
+	if !authz.Has(authUser, authz.ChatModerate) && (owner == nil || owner.ID != authUser.ID) {
 		return echo.NewHTTPError(http.StatusForbidden)
 	}
 
@@ -390,7 +392,7 @@ func (h *Chat) UnbanUser(ctx echo.Context) error {
 		return err
 	}
 
-	// Verify owner or admin
+	// Verify owner or moderator
 	room, err := h.orm.ChatRoom.Query().
 		Where(chatroom.IDEQ(roomID)).
 		WithOwner().
@@ -400,7 +402,7 @@ func (h *Chat) UnbanUser(ctx echo.Context) error {
 	}
 
 	owner, _ := room.Edges.OwnerOrErr()
-	if !authUser.Admin && (owner == nil || owner.ID != authUser.ID) {
+	if !authz.Has(authUser, authz.ChatModerate) && (owner == nil || owner.ID != authUser.ID) {
 		return echo.NewHTTPError(http.StatusForbidden)
 	}
 
@@ -447,7 +449,7 @@ func (h *Chat) DeleteRoom(ctx echo.Context) error {
 	}
 
 	owner, _ := room.Edges.OwnerOrErr()
-	if !authUser.Admin && (owner == nil || owner.ID != authUser.ID) {
+	if !authz.Has(authUser, authz.ChatModerate) && (owner == nil || owner.ID != authUser.ID) {
 		return echo.NewHTTPError(http.StatusForbidden)
 	}
 
@@ -469,39 +471,6 @@ func (h *Chat) DeleteRoom(ctx echo.Context) error {
 	return ctx.Redirect(http.StatusSeeOther, "/chat")
 }
 
-// uploadRateLimiter tracks per-IP upload counts for rate limiting.
-type uploadRateLimiter struct {
-	mu      sync.Mutex
-	buckets map[string]*uploadBucket
-}
-
-type uploadBucket struct {
-	count  int
-	expiry time.Time
-}
-
-var uploadLimiter = &uploadRateLimiter{
-	buckets: make(map[string]*uploadBucket),
-}
-
-// allow checks if the IP is within the upload rate limit (10 per minute).
-func (l *uploadRateLimiter) allow(ip string) bool {
-	l.mu.Lock()
-	defer l.mu.Unlock()
-
-	now := time.Now()
-	b, ok := l.buckets[ip]
-	if !ok || now.After(b.expiry) {
-		l.buckets[ip] = &uploadBucket{count: 1, expiry: now.Add(time.Minute)}
-		return true
-	}
-	if b.count >= 10 {
-		return false
-	}
-	b.count++
-	return true
-}
-
 // allowedMIMETypes maps detected MIME types to file extensions.
 // We validate by reading actual file bytes, not the Content-Type header.
 var allowedMIMETypes = map[string]string{
@@ -567,13 +536,13 @@ func (h *Chat) WebSocket(ctx echo.Context) error {
 	var userID int
 	var userName string
 	var isOwner bool
-	var isAdmin bool
+	var isModerator bool
 
 	if u := ctx.Get(appctx.AuthenticatedUserKey); u != nil {
 		authUser := u.(*ent.User)
 		userID = authUser.ID
 		userName = authUser.Name
-		isAdmin = authUser.Admin
+		isModerator = authz.Has(authUser, authz.ChatModerate)
 		if owner, err := room.Edges.OwnerOrErr(); err == nil {
 			isOwner = owner.ID == authUser.ID
 		}
@@ -601,8 +570,8 @@ func (h *Chat) WebSocket(ctx echo.Context) error {
 		}
 	}
 
-	// Password check — room owner and admins skip this
-	if room.PasswordHash != "" && !isOwner && !isAdmin {
+	// Password check — room owner and moderators skip this
+	if room.PasswordHash != "" && !isOwner && !isModerator {
 		password := ctx.QueryParam("password")
 		if err := bcrypt.CompareHashAndPassword([]byte(room.PasswordHash), []byte(password)); err != nil {
 			return echo.NewHTTPError(http.StatusForbidden, "incorrect room password")
@@ -627,14 +596,14 @@ func (h *Chat) WebSocket(ctx echo.Context) error {
 	hub := h.chat.GetOrCreateHub(roomID)
 
 	participant := &chat.Participant{
-		Conn:    conn,
-		Name:    userName,
-		UserID:  userID,
-		IsOwner: isOwner,
-		IsAdmin: isAdmin,
-		Send:    make(chan []byte, 256),
-		Hub:     hub,
-		IP:      ip,
+		Conn:        conn,
+		Name:        userName,
+		UserID:      userID,
+		IsOwner:     isOwner,
+		IsModerator: isModerator,
+		Send:        make(chan []byte, 256),
+		Hub:         hub,
+		IP:          ip,
 	}
 	participant.SetRateLimits(h.config.Chat.RateLimitMessages, h.config.Chat.RateLimitWindowSeconds)
 
@@ -647,12 +616,6 @@ func (h *Chat) WebSocket(ctx echo.Context) error {
 }
 
 func (h *Chat) UploadFile(ctx echo.Context) error {
-	// Rate limit: 10 uploads per minute per IP
-	ip := ctx.RealIP()
-	if !uploadLimiter.allow(ip) {
-		return echo.NewHTTPError(http.StatusTooManyRequests, "upload rate limit exceeded, try again in a minute")
-	}
-
 	// Try "file" field first, fall back to "image" for backwards compatibility
 	file, err := ctx.FormFile("file")
 	if err != nil {
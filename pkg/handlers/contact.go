@@ -5,7 +5,9 @@ import (
 
 	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
+	"github.com/occult/pagode/config"
 	"github.com/occult/pagode/pkg/form"
+	"github.com/occult/pagode/pkg/middleware"
 	"github.com/occult/pagode/pkg/routenames"
 	"github.com/occult/pagode/pkg/services"
 	"github.com/occult/pagode/pkg/ui/forms"
@@ -14,7 +16,9 @@ import (
 )
 
 type Contact struct {
+	config  *config.Config
 	mail    *services.MailClient
+	cache   *services.CacheClient
 	Inertia *inertia.Inertia
 }
 
@@ -23,13 +27,15 @@ func init() {
 }
 
 func (h *Contact) Init(c *services.Container) error {
+	h.config = c.Config
 	h.mail = c.Mail
+	h.cache = c.Cache
 	return nil
 }
 
 func (h *Contact) Routes(g *echo.Group) {
 	g.GET("/contact", h.Page).Name = routenames.Contact
-	g.POST("/contact", h.Submit).Name = routenames.ContactSubmit
+	g.POST("/contact", h.Submit, middleware.RateLimit(h.cache, "contact", h.config.RateLimit.Contact)).Name = routenames.ContactSubmit
 }
 
 func (h *Contact) Page(ctx echo.Context) error {
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/labstack/echo/v4"
+	"github.com/occult/pagode/config"
 	"github.com/occult/pagode/pkg/middleware"
 	"github.com/occult/pagode/pkg/msg"
 	"github.com/occult/pagode/pkg/routenames"
@@ -15,7 +16,9 @@ import (
 )
 
 type Files struct {
+	config  *config.Config
 	files   afero.Fs
+	cache   *services.CacheClient
 	Inertia *inertia.Inertia
 }
 
@@ -24,7 +27,9 @@ func init() {
 }
 
 func (h *Files) Init(c *services.Container) error {
+	h.config = c.Config
 	h.files = c.Files
+	h.cache = c.Cache
 	h.Inertia = c.Inertia
 	return nil
 }
@@ -33,7 +38,7 @@ func (h *Files) Routes(g *echo.Group) {
 	authGroup := g.Group("")
 	authGroup.Use(middleware.RequireAuthentication)
 	authGroup.GET("/files", h.UploadFilePage).Name = routenames.Files
-	authGroup.POST("/files", h.Submit).Name = routenames.FilesSubmit
+	authGroup.POST("/files", h.Submit, middleware.RateLimit(h.cache, "upload", h.config.RateLimit.Upload)).Name = routenames.FilesSubmit
 }
 
 func (h *Files) UploadFilePage(ctx echo.Context) error {
@@ -44,6 +49,10 @@ func (h *Files) UploadFilePage(ctx echo.Context) error {
 
 	files := make([]map[string]interface{}, 0, len(info))
 	for _, file := range info {
+		// Directories hold files stored by the application, such as data exports, rather than uploads.
+		if file.IsDir() {
+			continue
+		}
 		files = append(files, map[string]interface{}{
 			"id":       file.Name(),
 			"name":     file.Name(),
@@ -46,3 +46,25 @@ func fail(err error, log string, inertia InertiaBacker, c echo.Context) error {
 	inertia.Back(res, req)
 	return nil
 }
+
+// rejectPassword checks that a password chosen by the user with a given name and email address complies with the
+// password policy. If it does not, the reasons are flashed, the request is redirected back and true is returned.
+func rejectPassword(
+	ctx echo.Context,
+	auth *services.AuthClient,
+	inertia InertiaBacker,
+	password, name, email string,
+) bool {
+	switch err := auth.ValidatePassword(password, name, email).(type) {
+	case nil:
+		return false
+	case services.PasswordPolicyError:
+		for _, reason := range err.Reasons {
+			msg.Warning(ctx, reason)
+		}
+		inertia.Back(ctx.Response(), ctx.Request())
+	default:
+		_ = fail(err, "unable to validate password", inertia, ctx)
+	}
+	return true
+}
@@ -0,0 +1,133 @@
+package handlers
+
+import (
+	"fmt"
+	"html"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/occult/pagode/pkg/log"
+	"github.com/occult/pagode/pkg/middleware"
+	"github.com/occult/pagode/pkg/msg"
+	"github.com/occult/pagode/pkg/routenames"
+	"github.com/occult/pagode/pkg/services"
+	"github.com/occult/pagode/pkg/ui"
+)
+
+type OAuth struct {
+	oauth *services.OAuthClient
+	login *Auth
+}
+
+func init() {
+	Register(new(OAuth))
+}
+
+func (h *OAuth) Init(c *services.Container) error {
+	h.oauth = c.OAuth
+	h.login = new(Auth)
+	return h.login.Init(c)
+}
+
+func (h *OAuth) Routes(g *echo.Group) {
+	oauth := g.Group("/auth", middleware.RequireNoAuthentication)
+	oauth.GET("/:provider", h.Redirect).Name = routenames.OAuthRedirect
+	oauth.GET("/:provider/callback", h.Callback).Name = routenames.OAuthCallback
+}
+
+// Redirect starts a login with an external provider by redirecting to it.
+func (h *OAuth) Redirect(ctx echo.Context) error {
+	p, ok := h.oauth.Provider(ctx.Param("provider"))
+	if !ok {
+		return echo.NewHTTPError(http.StatusNotFound)
+	}
+
+	url, err := h.oauth.Begin(ctx, p, ui.NewRequest(ctx).Url(routenames.OAuthCallback, p.Name()))
+	if err != nil {
+		log.Ctx(ctx).Error("unable to start oauth login",
+			"provider", p.Name(),
+			"error", err,
+		)
+		msg.Danger(ctx, fmt.Sprintf("Unable to log in with %s. Please try again later.", p.Label()))
+		return ctx.Redirect(http.StatusFound, ctx.Echo().Reverse(routenames.Login))
+	}
+
+	return ctx.Redirect(http.StatusFound, url)
+}
+
+// Callback completes a login with an external provider once it redirected back.
+func (h *OAuth) Callback(ctx echo.Context) error {
+	p, ok := h.oauth.Provider(ctx.Param("provider"))
+	if !ok {
+		return echo.NewHTTPError(http.StatusNotFound)
+	}
+
+	uriLogin := ctx.Echo().Reverse(routenames.Login)
+
+	id, err := h.oauth.Complete(ctx, p, ui.NewRequest(ctx).Url(routenames.OAuthCallback, p.Name()))
+	switch err.(type) {
+	case nil:
+	case services.InvalidOAuthStateError:
+		msg.Danger(ctx, "Your login attempt has expired. Please try again.")
+		return clientRedirect(ctx, uriLogin)
+	default:
+		log.Ctx(ctx).Warn("unable to complete oauth login",
+			"provider", p.Name(),
+			"error", err,
+		)
+		msg.Danger(ctx, fmt.Sprintf("Unable to log in with %s. Please try again.", p.Label()))
+		return clientRedirect(ctx, uriLogin)
+	}
+
+	u, err := h.oauth.ResolveUser(ctx, p.Name(), id)
+	switch err.(type) {
+	case nil:
+	case services.OAuthAccountExistsError:
+		msg.Warning(ctx, "An account with this email address already exists. Please log in with your password.")
+		return clientRedirect(ctx, uriLogin)
+	case services.OAuthEmailRequiredError:
+		msg.Danger(ctx, fmt.Sprintf("%s did not provide an email address for your account.", p.Label()))
+		return clientRedirect(ctx, uriLogin)
+	default:
+		return h.loginFailed(ctx, err, "unable to resolve user for oauth login")
+	}
+
+	return h.login.authenticated(ctx, u, h)
+}
+
+// loginRedirect redirects a login completed by a provider from within the browser.
+func (h *OAuth) loginRedirect(ctx echo.Context, url string) error {
+	return clientRedirect(ctx, url)
+}
+
+// loginFailed fails a login completed by a provider by returning to the login page.
+func (h *OAuth) loginFailed(ctx echo.Context, err error, message string) error {
+	log.Ctx(ctx).Error(message,
+		"provider", ctx.Param("provider"),
+		"error", err,
+	)
+	msg.Danger(ctx, "An error occurred. Please try again.")
+	return clientRedirect(ctx, ctx.Echo().Reverse(routenames.Login))
+}
+
+// oauthProviders provides the external providers users can log in with to the frontend.
+func oauthProviders(c *services.OAuthClient) []map[string]string {
+	providers := make([]map[string]string, 0)
+	for _, p := range c.Providers() {
+		providers = append(providers, map[string]string{
+			"name":  p.Name(),
+			"label": p.Label(),
+		})
+	}
+	return providers
+}
+
+// clientRedirect redirects from within the browser rather than with a redirect response.
+// Requests coming from a provider are cross-site, so the browser does not send the strict session cookies for
+// them, nor for any redirects which follow, while a navigation started by this page is same-site.
+func clientRedirect(ctx echo.Context, url string) error {
+	return ctx.HTML(http.StatusOK, fmt.Sprintf(
+		`<!DOCTYPE html><html><head><meta http-equiv="refresh" content="0;url=%s"></head></html>`,
+		html.EscapeString(url),
+	))
+}
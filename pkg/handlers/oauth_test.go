@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/occult/pagode/config"
+	"github.com/occult/pagode/ent/user"
+	"github.com/occult/pagode/pkg/routenames"
+	"github.com/occult/pagode/pkg/services"
+	"github.com/occult/pagode/pkg/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOAuth__Login(t *testing.T) {
+	idp, err := tests.NewOIDCProvider()
+	require.NoError(t, err)
+	defer idp.Close()
+
+	c.OAuth.Register(services.NewOIDCProvider("stub", config.OAuthProviderConfig{
+		Label:        "Stub",
+		ClientID:     idp.ClientID,
+		ClientSecret: idp.ClientSecret,
+		IssuerURL:    idp.URL(),
+	}))
+
+	identity := tests.OIDCIdentity{
+		Subject:       "handler-stub-user",
+		Email:         "oauth-handler@localhost.localhost",
+		EmailVerified: true,
+		Name:          "Handler User",
+	}
+
+	// login logs in with the provider and returns the URL the browser is sent to afterward
+	login := func(r *httpRequest) string {
+		r.client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		}
+
+		// Start logging in, which should redirect to the provider
+		r.route = srv.URL + c.Web.Reverse(routenames.OAuthRedirect, "stub")
+		resp := r.get().assertStatusCode(http.StatusFound)
+		authURL := resp.Header.Get("Location")
+		assert.Contains(t, authURL, idp.URL())
+
+		code, err := idp.Authorize(authURL, identity)
+		require.NoError(t, err)
+
+		// Complete logging in once the provider redirects back
+		u, err := url.Parse(authURL)
+		require.NoError(t, err)
+		r.route = srv.URL + c.Web.Reverse(routenames.OAuthCallback, "stub") + "?" + url.Values{
+			"code":  {code},
+			"state": {u.Query().Get("state")},
+		}.Encode()
+
+		doc := r.get().
+			assertStatusCode(http.StatusOK).
+			toDoc()
+		refresh, _ := doc.Find(`meta[http-equiv="refresh"]`).Attr("content")
+		return strings.TrimPrefix(refresh, "0;url=")
+	}
+
+	r := request(t)
+	assert.Equal(t, c.Web.Reverse(routenames.Dashboard), login(r))
+
+	// The session should now be authenticated
+	r.route = srv.URL + c.Web.Reverse(routenames.Login)
+	r.get().
+		assertStatusCode(http.StatusSeeOther).
+		assertRedirect(t, routenames.Dashboard)
+
+	// Locked out users cannot log in with a provider either
+	until := time.Now().Add(time.Hour)
+	err = c.ORM.User.Update().
+		Where(user.Email(identity.Email)).
+		SetLockedUntil(until).
+		Exec(context.Background())
+	require.NoError(t, err)
+	r = request(t)
+	assert.Equal(t, c.Web.Reverse(routenames.Login), login(r))
+	r.route = srv.URL + c.Web.Reverse(routenames.Dashboard)
+	r.get().assertStatusCode(http.StatusUnauthorized)
+
+	// An unknown provider should not be found
+	r = request(t)
+	r.route = srv.URL + c.Web.Reverse(routenames.OAuthRedirect, "unknown")
+	r.get().assertStatusCode(http.StatusNotFound)
+}
@@ -0,0 +1,443 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/invitation"
+	"github.com/occult/pagode/pkg/context"
+	"github.com/occult/pagode/pkg/form"
+	"github.com/occult/pagode/pkg/log"
+	"github.com/occult/pagode/pkg/middleware"
+	"github.com/occult/pagode/pkg/msg"
+	"github.com/occult/pagode/pkg/routenames"
+	"github.com/occult/pagode/pkg/services"
+	"github.com/occult/pagode/pkg/ui/emails"
+	inertia "github.com/romsar/gonertia/v2"
+)
+
+// errOrganizationBilling is returned when a member attempts to change the billing of an organization they cannot manage
+var errOrganizationBilling = errors.New("only owners and admins can manage the billing of an organization")
+
+type Organizations struct {
+	orgs    *services.OrganizationClient
+	mail    *services.MailClient
+	Inertia *inertia.Inertia
+}
+
+type OrganizationForm struct {
+	Name string `form:"name" validate:"required"`
+	form.Submission
+}
+
+type OrganizationSwitchForm struct {
+	OrganizationID int `form:"organization_id"`
+	form.Submission
+}
+
+type OrganizationInviteForm struct {
+	Email string `form:"email" validate:"required,email"`
+	Role  string `form:"role" validate:"required,oneof=admin member"`
+	form.Submission
+}
+
+func init() {
+	Register(new(Organizations))
+}
+
+func (h *Organizations) Init(c *services.Container) error {
+	h.orgs = c.Organization
+	h.mail = c.Mail
+	h.Inertia = c.Inertia
+	return nil
+}
+
+func (h *Organizations) Routes(g *echo.Group) {
+	// Organizations cannot be managed with an access token.
+	orgs := g.Group("/organizations", middleware.RequireAuthentication, middleware.RequireSession)
+	orgs.GET("", h.Page).Name = routenames.Organizations
+	orgs.POST("", h.Create, middleware.RequireNoImpersonation).Name = routenames.OrganizationCreate
+	orgs.POST("/switch", h.Switch, middleware.RequireNoImpersonation).Name = routenames.OrganizationSwitch
+	orgs.GET("/:id", h.Show).Name = routenames.Organization
+	orgs.POST("/:id/invitations", h.Invite, middleware.RequireNoImpersonation).Name = routenames.OrganizationInvite
+	orgs.POST("/:id/invitations/:invitation/revoke", h.RevokeInvitation, middleware.RequireNoImpersonation).Name = routenames.OrganizationInvitationRevoke
+	orgs.POST("/:id/members/:user/remove", h.RemoveMember, middleware.RequireNoImpersonation).Name = routenames.OrganizationMemberRemove
+
+	g.GET("/invitations/:token", h.InvitationPage).Name = routenames.Invitation
+	g.POST("/invitations/:token", h.AcceptInvitation, middleware.RequireAuthentication, middleware.RequireSession, middleware.RequireNoImpersonation).Name = routenames.InvitationAccept
+}
+
+func (h *Organizations) Page(ctx echo.Context) error {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	memberships, err := h.orgs.GetMemberships(ctx, usr.ID)
+	if err != nil {
+		return fail(err, "unable to load organizations", h.Inertia, ctx)
+	}
+
+	active, err := h.orgs.GetActiveMembership(ctx, usr)
+	if err != nil {
+		return fail(err, "unable to load active organization", h.Inertia, ctx)
+	}
+
+	organizations := make([]map[string]any, len(memberships))
+	for i, m := range memberships {
+		organizations[i] = map[string]any{
+			"id":     m.OrganizationID,
+			"name":   m.Edges.Organization.Name,
+			"role":   m.Role,
+			"active": active != nil && active.ID == m.ID,
+		}
+	}
+
+	return h.Inertia.Render(
+		ctx.Response().Writer,
+		ctx.Request(),
+		"Settings/Organizations",
+		inertia.Props{
+			"organizations": organizations,
+		},
+	)
+}
+
+func (h *Organizations) Create(ctx echo.Context) error {
+	var input OrganizationForm
+
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	err := form.Submit(ctx, &input)
+	switch err.(type) {
+	case nil:
+	case validator.ValidationErrors:
+		msg.Warning(ctx, "Please enter a name for the organization.")
+		h.Inertia.Back(ctx.Response().Writer, ctx.Request())
+		return nil
+	default:
+		return err
+	}
+
+	org, err := h.orgs.Create(ctx, usr, input.Name)
+	if err != nil {
+		return fail(err, "unable to create organization", h.Inertia, ctx)
+	}
+
+	if err = h.orgs.SetActive(ctx, org.ID); err != nil {
+		return fail(err, "unable to switch organization", h.Inertia, ctx)
+	}
+
+	msg.Success(ctx, fmt.Sprintf("The organization %s has been created.", org.Name))
+	h.Inertia.Redirect(ctx.Response().Writer, ctx.Request(), ctx.Echo().Reverse(routenames.Organization, org.ID))
+	return nil
+}
+
+// Switch sets the organization the user is acting on behalf of, or their personal account when no organization
+// is provided.
+func (h *Organizations) Switch(ctx echo.Context) error {
+	var input OrganizationSwitchForm
+
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	if err := form.Submit(ctx, &input); err != nil {
+		return err
+	}
+
+	if input.OrganizationID != 0 {
+		_, err := h.orgs.GetMembership(ctx, input.OrganizationID, usr.ID)
+		switch {
+		case err == nil:
+		case ent.IsNotFound(err):
+			return echo.NewHTTPError(http.StatusNotFound)
+		default:
+			return fail(err, "unable to load membership", h.Inertia, ctx)
+		}
+	}
+
+	if err := h.orgs.SetActive(ctx, input.OrganizationID); err != nil {
+		return fail(err, "unable to switch organization", h.Inertia, ctx)
+	}
+
+	h.Inertia.Back(ctx.Response().Writer, ctx.Request())
+	return nil
+}
+
+func (h *Organizations) Show(ctx echo.Context) error {
+	m, err := h.membership(ctx)
+	if err != nil {
+		return err
+	}
+
+	members, err := h.orgs.GetMembers(ctx, m.OrganizationID)
+	if err != nil {
+		return fail(err, "unable to load members", h.Inertia, ctx)
+	}
+
+	memberData := make([]map[string]any, len(members))
+	for i, member := range members {
+		memberData[i] = map[string]any{
+			"id":       member.UserID,
+			"name":     member.Edges.User.Name,
+			"email":    member.Edges.User.Email,
+			"role":     member.Role,
+			"joinedAt": member.CreatedAt.Format(time.RFC3339),
+		}
+	}
+
+	invitationData := make([]map[string]any, 0)
+	if services.CanManageOrganization(m) {
+		invitations, err := h.orgs.GetInvitations(ctx, m.OrganizationID)
+		if err != nil {
+			return fail(err, "unable to load invitations", h.Inertia, ctx)
+		}
+
+		for _, inv := range invitations {
+			invitationData = append(invitationData, map[string]any{
+				"id":        inv.ID,
+				"email":     inv.Email,
+				"role":      inv.Role,
+				"expiresAt": inv.ExpiresAt.Format(time.RFC3339),
+			})
+		}
+	}
+
+	return h.Inertia.Render(
+		ctx.Response().Writer,
+		ctx.Request(),
+		"Settings/Organization",
+		inertia.Props{
+			"organization": map[string]any{
+				"id":   m.OrganizationID,
+				"name": m.Edges.Organization.Name,
+			},
+			"role":        m.Role,
+			"canManage":   services.CanManageOrganization(m),
+			"members":     memberData,
+			"invitations": invitationData,
+		},
+	)
+}
+
+func (h *Organizations) Invite(ctx echo.Context) error {
+	var input OrganizationInviteForm
+
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	m, err := h.membership(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !services.CanManageOrganization(m) {
+		return echo.NewHTTPError(http.StatusForbidden)
+	}
+
+	err = form.Submit(ctx, &input)
+	switch err.(type) {
+	case nil:
+	case validator.ValidationErrors:
+		msg.Warning(ctx, "Please enter a valid email address.")
+		h.Inertia.Back(ctx.Response().Writer, ctx.Request())
+		return nil
+	default:
+		return err
+	}
+
+	org := m.Edges.Organization
+
+	token, _, err := h.orgs.Invite(ctx, org, usr, input.Email, invitation.Role(input.Role))
+	if err != nil {
+		return fail(err, "unable to create invitation", h.Inertia, ctx)
+	}
+
+	err = h.mail.Compose().
+		To(input.Email).
+		Subject(fmt.Sprintf("Join %s", org.Name)).
+		Component(emails.OrganizationInvitation(ctx, usr.Name, org.Name, token)).
+		Send(ctx)
+	if err != nil {
+		return fail(err, "unable to send invitation email", h.Inertia, ctx)
+	}
+
+	log.Ctx(ctx).Info("sent organization invitation",
+		"organization_id", org.ID,
+		"user_id", usr.ID,
+	)
+
+	msg.Success(ctx, fmt.Sprintf("An invitation has been sent to %s.", input.Email))
+	h.Inertia.Redirect(ctx.Response().Writer, ctx.Request(), ctx.Echo().Reverse(routenames.Organization, org.ID))
+	return nil
+}
+
+func (h *Organizations) RevokeInvitation(ctx echo.Context) error {
+	m, err := h.membership(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !services.CanManageOrganization(m) {
+		return echo.NewHTTPError(http.StatusForbidden)
+	}
+
+	id, err := strconv.Atoi(ctx.Param("invitation"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound)
+	}
+
+	err = h.orgs.RevokeInvitation(ctx, m.OrganizationID, id)
+	switch {
+	case err == nil:
+	case ent.IsNotFound(err):
+		return echo.NewHTTPError(http.StatusNotFound)
+	default:
+		return fail(err, "unable to revoke invitation", h.Inertia, ctx)
+	}
+
+	msg.Success(ctx, "The invitation has been revoked.")
+	h.Inertia.Redirect(ctx.Response().Writer, ctx.Request(), ctx.Echo().Reverse(routenames.Organization, m.OrganizationID))
+	return nil
+}
+
+// RemoveMember removes a member from an organization, which members can do to leave it.
+func (h *Organizations) RemoveMember(ctx echo.Context) error {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	m, err := h.membership(ctx)
+	if err != nil {
+		return err
+	}
+
+	userID, err := strconv.Atoi(ctx.Param("user"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound)
+	}
+
+	leaving := userID == usr.ID
+	if !leaving && !services.CanManageOrganization(m) {
+		return echo.NewHTTPError(http.StatusForbidden)
+	}
+
+	err = h.orgs.RemoveMember(ctx, m.OrganizationID, userID)
+	switch err.(type) {
+	case nil:
+	case services.LastOwnerError:
+		msg.Danger(ctx, "The last owner of an organization cannot be removed.")
+		h.Inertia.Back(ctx.Response().Writer, ctx.Request())
+		return nil
+	case *ent.NotFoundError:
+		return echo.NewHTTPError(http.StatusNotFound)
+	default:
+		return fail(err, "unable to remove member", h.Inertia, ctx)
+	}
+
+	if leaving {
+		msg.Success(ctx, fmt.Sprintf("You have left %s.", m.Edges.Organization.Name))
+		h.Inertia.Redirect(ctx.Response().Writer, ctx.Request(), ctx.Echo().Reverse(routenames.Organizations))
+		return nil
+	}
+
+	msg.Success(ctx, "The member has been removed.")
+	h.Inertia.Redirect(ctx.Response().Writer, ctx.Request(), ctx.Echo().Reverse(routenames.Organization, m.OrganizationID))
+	return nil
+}
+
+func (h *Organizations) InvitationPage(ctx echo.Context) error {
+	inv, err := h.orgs.GetInvitation(ctx, ctx.Param("token"))
+	switch err.(type) {
+	case nil:
+	case services.InvalidInvitationError:
+		msg.Warning(ctx, "The invitation is either invalid or has expired.")
+		h.Inertia.Redirect(ctx.Response().Writer, ctx.Request(), ctx.Echo().Reverse(routenames.Welcome))
+		return nil
+	default:
+		return fail(err, "unable to load invitation", h.Inertia, ctx)
+	}
+
+	return h.Inertia.Render(
+		ctx.Response().Writer,
+		ctx.Request(),
+		"Auth/Invitation",
+		inertia.Props{
+			"token":        ctx.Param("token"),
+			"organization": inv.Edges.Organization.Name,
+			"email":        inv.Email,
+		},
+	)
+}
+
+func (h *Organizations) AcceptInvitation(ctx echo.Context) error {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	m, err := h.orgs.AcceptInvitation(ctx, ctx.Param("token"), usr)
+	switch err.(type) {
+	case nil:
+	case services.InvalidInvitationError:
+		msg.Warning(ctx, "The invitation is either invalid or has expired.")
+		h.Inertia.Redirect(ctx.Response().Writer, ctx.Request(), ctx.Echo().Reverse(routenames.Organizations))
+		return nil
+	case services.InvitationEmailMismatchError:
+		msg.Danger(ctx, "This invitation was sent to a different email address.")
+		h.Inertia.Back(ctx.Response().Writer, ctx.Request())
+		return nil
+	default:
+		return fail(err, "unable to accept invitation", h.Inertia, ctx)
+	}
+
+	if err = h.orgs.SetActive(ctx, m.OrganizationID); err != nil {
+		return fail(err, "unable to switch organization", h.Inertia, ctx)
+	}
+
+	msg.Success(ctx, fmt.Sprintf("You have joined %s.", m.Edges.Organization.Name))
+	h.Inertia.Redirect(ctx.Response().Writer, ctx.Request(), ctx.Echo().Reverse(routenames.Organization, m.OrganizationID))
+	return nil
+}
+
+// membership loads the membership of the authenticated user in the organization provided in the path.
+func (h *Organizations) membership(ctx echo.Context) (*ent.Membership, error) {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusNotFound)
+	}
+
+	m, err := h.orgs.GetMembership(ctx, id, usr.ID)
+	switch {
+	case err == nil:
+		return m, nil
+	case ent.IsNotFound(err):
+		return nil, echo.NewHTTPError(http.StatusNotFound)
+	default:
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+}
+
+// billingCustomer returns the payment customer billing applies to, which is that of the active organization of the
+// user, if any, so that its members share its subscriptions. Only those who can manage the organization may make
+// changes to its billing.
+func billingCustomer(
+	ctx echo.Context,
+	payment *services.PaymentClient,
+	orgs *services.OrganizationClient,
+	usr *ent.User,
+	manage bool,
+) (*ent.PaymentCustomer, error) {
+	m, err := orgs.GetActiveMembership(ctx, usr)
+	if err != nil {
+		return nil, err
+	}
+
+	if m == nil {
+		return payment.CreateOrGetCustomer(ctx, usr)
+	}
+
+	if manage && !services.CanManageOrganization(m) {
+		return nil, errOrganizationBilling
+	}
+
+	return payment.CreateOrGetOrganizationCustomer(ctx, m.Edges.Organization, usr)
+}
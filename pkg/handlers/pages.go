@@ -6,6 +6,8 @@ import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	"github.com/occult/pagode/config"
+	"github.com/occult/pagode/pkg/middleware"
 	"github.com/occult/pagode/pkg/pager"
 	"github.com/occult/pagode/pkg/routenames"
 	"github.com/occult/pagode/pkg/services"
@@ -16,6 +18,8 @@ import (
 
 type Pages struct {
 	Inertia *inertia.Inertia
+	cache   *services.CacheClient
+	config  *config.Config
 }
 
 func init() {
@@ -24,12 +28,16 @@ func init() {
 
 func (h *Pages) Init(c *services.Container) error {
 	h.Inertia = c.Inertia
+	h.cache = c.Cache
+	h.config = c.Config
 	return nil
 }
 
 func (h *Pages) Routes(g *echo.Group) {
-	g.GET("/", h.Welcome).Name = routenames.Welcome
-	g.GET("/about", h.About).Name = routenames.About
+	cache := middleware.CachePage(h.cache, h.config.Cache.Expiration.Page)
+
+	g.GET("/", h.Welcome, cache).Name = routenames.Welcome
+	g.GET("/about", h.About, cache).Name = routenames.About
 }
 
 func (h *Pages) Welcome(ctx echo.Context) error {
@@ -18,6 +18,7 @@ type Plans struct {
 	Inertia *inertia.Inertia
 	Payment *services.PaymentClient
 	Auth    *services.AuthClient
+	Orgs    *services.OrganizationClient
 }
 
 func init() {
@@ -28,15 +29,16 @@ func (h *Plans) Init(c *services.Container) error {
 	h.Inertia = c.Inertia
 	h.Payment = c.Payment
 	h.Auth = c.Auth
+	h.Orgs = c.Organization
 	return nil
 }
 
 func (h *Plans) Routes(g *echo.Group) {
 	authGroup := g.Group("")
-	authGroup.Use(middleware.RequireAuthentication)
+	authGroup.Use(middleware.RequireAuthentication, middleware.RequireSession)
 	
 	authGroup.GET("/plans", h.Page).Name = routenames.Plans
-	authGroup.POST("/plans/subscribe", h.Subscribe).Name = routenames.PlansSubscribe
+	authGroup.POST("/plans/subscribe", h.Subscribe, middleware.RequireNoImpersonation).Name = routenames.PlansSubscribe
 }
 
 func (h *Plans) Page(ctx echo.Context) error {
@@ -47,7 +49,7 @@ func (h *Plans) Page(ctx echo.Context) error {
 	}
 
 	// Check if user already has a subscription
-	paymentCustomer, err := h.Payment.CreateOrGetCustomer(ctx, user)
+	paymentCustomer, err := billingCustomer(ctx, h.Payment, h.Orgs, user, false)
 	var hasActiveSubscription bool
 	if err == nil {
 		// Check for active subscriptions
@@ -125,7 +127,7 @@ func (h *Plans) Subscribe(ctx echo.Context) error {
 	}
 
 	// Create or get payment customer
-	paymentCustomer, err := h.Payment.CreateOrGetCustomer(ctx, user)
+	paymentCustomer, err := billingCustomer(ctx, h.Payment, h.Orgs, user, true)
 	if err != nil {
 		return fail(err, "Unable to create payment customer", h.Inertia, ctx)
 	}
@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -16,6 +17,7 @@ type Products struct {
 	Inertia *inertia.Inertia
 	Payment *services.PaymentClient
 	Auth    *services.AuthClient
+	Orgs    *services.OrganizationClient
 }
 
 func init() {
@@ -26,15 +28,16 @@ func (h *Products) Init(c *services.Container) error {
 	h.Inertia = c.Inertia
 	h.Payment = c.Payment
 	h.Auth = c.Auth
+	h.Orgs = c.Organization
 	return nil
 }
 
 func (h *Products) Routes(g *echo.Group) {
 	authGroup := g.Group("")
-	authGroup.Use(middleware.RequireAuthentication)
+	authGroup.Use(middleware.RequireAuthentication, middleware.RequireSession)
 	
 	authGroup.GET("/products", h.Page).Name = routenames.Products
-	authGroup.POST("/products/purchase", h.Purchase).Name = routenames.ProductsPurchase
+	authGroup.POST("/products/purchase", h.Purchase, middleware.RequireNoImpersonation).Name = routenames.ProductsPurchase
 }
 
 func (h *Products) Page(ctx echo.Context) error {
@@ -77,7 +80,10 @@ func (h *Products) Purchase(ctx echo.Context) error {
 	}
 
 	// Get or create Stripe customer
-	customer, err := h.Payment.CreateOrGetCustomer(ctx, user)
+	customer, err := billingCustomer(ctx, h.Payment, h.Orgs, user, true)
+	if errors.Is(err, errOrganizationBilling) {
+		return echo.NewHTTPError(http.StatusForbidden, err.Error())
+	}
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create customer")
 	}
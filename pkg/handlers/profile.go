@@ -1,9 +1,20 @@
 package handlers
 
 import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image/png"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/backlite"
 	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/pkg/authz"
 	"github.com/occult/pagode/pkg/context"
 	"github.com/occult/pagode/pkg/form"
 	"github.com/occult/pagode/pkg/log"
@@ -11,6 +22,9 @@ import (
 	"github.com/occult/pagode/pkg/msg"
 	"github.com/occult/pagode/pkg/routenames"
 	"github.com/occult/pagode/pkg/services"
+	"github.com/occult/pagode/pkg/session"
+	"github.com/occult/pagode/pkg/tasks"
+	"github.com/occult/pagode/pkg/ui/emails"
 	inertia "github.com/romsar/gonertia/v2"
 )
 
@@ -18,6 +32,10 @@ type Profile struct {
 	orm     *ent.Client
 	Inertia *inertia.Inertia
 	auth    *services.AuthClient
+	mail    *services.MailClient
+	passkey *services.WebAuthnClient
+	export  *services.ExportClient
+	tasks   *backlite.Client
 }
 
 type UpdateBasicInfoForm struct {
@@ -28,11 +46,34 @@ type UpdateBasicInfoForm struct {
 
 type UpdatePasswordForm struct {
 	CurrentPassword      string `form:"current_password" validate:"required"`
-	Password             string `form:"password" validate:"required,min=8"`
+	Password             string `form:"password" validate:"required"`
 	PasswordConfirmation string `form:"password_confirmation" validate:"required,eqfield=Password"`
 	form.Submission
 }
 
+// recoveryCodesSessionName stores the name of the session which temporarily holds newly generated recovery codes.
+const recoveryCodesSessionName = "recovery_codes"
+
+// accessTokenSessionName stores the name of the session which temporarily holds a newly created access token.
+const accessTokenSessionName = "access_token"
+
+type CreateAccessTokenForm struct {
+	Name      string   `form:"name" json:"name" validate:"required"`
+	Scopes    []string `form:"scopes" json:"scopes" validate:"required,min=1,dive,oneof=read write"`
+	ExpiresIn int      `form:"expires_in" json:"expires_in" validate:"gte=0"`
+	form.Submission
+}
+
+type EnableTwoFactorForm struct {
+	Code string `form:"code" validate:"required"`
+	form.Submission
+}
+
+type ConfirmPasswordForm struct {
+	Password string `form:"password" validate:"required"`
+	form.Submission
+}
+
 type DeleteAccountForm struct {
 	Password string `form:"password" validate:"required"`
 	form.Submission
@@ -46,6 +87,10 @@ func (h *Profile) Init(c *services.Container) error {
 	h.orm = c.ORM
 	h.Inertia = c.Inertia
 	h.auth = c.Auth
+	h.mail = c.Mail
+	h.passkey = c.WebAuthn
+	h.export = c.Export
+	h.tasks = c.Tasks
 	return nil
 }
 
@@ -53,12 +98,34 @@ func (h *Profile) Routes(g *echo.Group) {
 	profile := g.Group("/profile")
 	profile.Use(middleware.RequireAuthentication)
 	profile.GET("/info", h.EditPage).Name = routenames.ProfileEdit
-	profile.POST("/update", h.UpdateBasicInfo).Name = routenames.ProfileUpdate
-	profile.POST("/delete", h.DeleteAccount).Name = routenames.ProfileDestroy
-
+	profile.POST("/export", h.RequestDataExport, middleware.RequireNoImpersonation).Name = routenames.ProfileDataExport
+	profile.GET("/export/:token", h.DownloadDataExport, middleware.RequireNoImpersonation).Name = routenames.ProfileDataExportDownload
 	profile.GET("/appearance", h.AppearancePage).Name = routenames.ProfileAppearance
-	profile.GET("/password", h.PasswordPage).Name = routenames.ProfilePassword
-	profile.POST("/update-password", h.UpdatePassword).Name = routenames.ProfileUpdatePassword
+
+	// Credentials, sessions and tokens cannot be managed with an access token.
+	account := profile.Group("", middleware.RequireSession)
+	account.POST("/update", h.UpdateBasicInfo, middleware.RequireNoImpersonation).Name = routenames.ProfileUpdate
+	account.POST("/email/cancel", h.CancelEmailChange, middleware.RequireNoImpersonation).Name = routenames.ProfileEmailChangeCancel
+	account.POST("/delete", h.DeleteAccount, middleware.RequireNoImpersonation).Name = routenames.ProfileDestroy
+
+	account.GET("/password", h.PasswordPage).Name = routenames.ProfilePassword
+	account.POST("/update-password", h.UpdatePassword, middleware.RequireNoImpersonation).Name = routenames.ProfileUpdatePassword
+
+	account.GET("/two-factor", h.TwoFactorPage).Name = routenames.ProfileTwoFactor
+	account.POST("/two-factor/enable", h.EnableTwoFactor, middleware.RequireNoImpersonation).Name = routenames.ProfileTwoFactorEnable
+	account.POST("/two-factor/disable", h.DisableTwoFactor, middleware.RequireNoImpersonation).Name = routenames.ProfileTwoFactorDisable
+	account.POST("/two-factor/recovery-codes", h.RegenerateRecoveryCodes, middleware.RequireNoImpersonation).Name = routenames.ProfileTwoFactorRecoveryCodes
+
+	account.GET("/sessions", h.SessionsPage).Name = routenames.ProfileSessions
+	account.POST("/sessions/:id/revoke", h.RevokeSession, middleware.RequireNoImpersonation).Name = routenames.ProfileSessionRevoke
+	account.POST("/sessions/revoke", h.RevokeAllSessions, middleware.RequireNoImpersonation).Name = routenames.ProfileSessionsRevokeAll
+
+	account.GET("/passkeys", h.PasskeysPage).Name = routenames.ProfilePasskeys
+	account.POST("/passkeys/:id/delete", h.DeletePasskey, middleware.RequireNoImpersonation).Name = routenames.ProfilePasskeyDelete
+
+	account.GET("/tokens", h.AccessTokensPage).Name = routenames.ProfileAccessTokens
+	account.POST("/tokens", h.CreateAccessToken, middleware.RequireNoImpersonation).Name = routenames.ProfileAccessTokenCreate
+	account.POST("/tokens/:id/revoke", h.RevokeAccessToken, middleware.RequireNoImpersonation).Name = routenames.ProfileAccessTokenRevoke
 }
 
 func (h *Profile) EditPage(ctx echo.Context) error {
@@ -91,21 +158,53 @@ func (h *Profile) UpdateBasicInfo(ctx echo.Context) error {
 		return err
 	}
 
-	if input.Name == usr.Name && input.Email == usr.Email {
+	email := strings.ToLower(input.Email)
+	emailChanged := email != usr.Email && email != usr.PendingEmail
+
+	if input.Name == usr.Name && !emailChanged {
 		msg.Info(ctx, "Nothing to update.")
 		h.Inertia.Back(ctx.Response().Writer, ctx.Request())
 		return nil
 	}
 
-	update := h.orm.User.UpdateOne(usr).
-		SetName(input.Name).
-		SetEmail(input.Email)
+	if input.Name != usr.Name {
+		usr, err = usr.Update().
+			SetName(input.Name).
+			Save(ctx.Request().Context())
+		if err != nil {
+			msg.Danger(ctx, "Failed to update user.")
+			h.Inertia.Back(ctx.Response().Writer, ctx.Request())
+			return nil
+		}
+	}
 
-	_, err = update.Save(ctx.Request().Context())
-	if err != nil {
-		msg.Danger(ctx, "Failed to update user.")
-		h.Inertia.Back(ctx.Response().Writer, ctx.Request())
-		return nil
+	// The email address is only changed once the new one has been confirmed.
+	if emailChanged {
+		if email == usr.Email {
+			usr, err = h.auth.CancelEmailChange(ctx, usr)
+		} else {
+			usr, err = h.auth.RequestEmailChange(ctx, usr, email)
+		}
+
+		switch err.(type) {
+		case nil:
+		case services.EmailTakenError:
+			msg.Warning(ctx, "A user with this email address already exists.")
+			h.Inertia.Back(ctx.Response().Writer, ctx.Request())
+			return nil
+		default:
+			return fail(err, "unable to change email address", h.Inertia, ctx)
+		}
+
+		if usr.PendingEmail != "" {
+			if err = h.sendEmailChangeEmails(ctx, usr); err != nil {
+				return fail(err, "unable to send email change confirmation", h.Inertia, ctx)
+			}
+
+			msg.Info(ctx, fmt.Sprintf("Your profile has been updated. Please check %s to confirm your new email address.", usr.PendingEmail))
+			h.Inertia.Back(ctx.Response().Writer, ctx.Request())
+			return nil
+		}
 	}
 
 	msg.Success(ctx, "Your profile has been updated.")
@@ -113,6 +212,50 @@ func (h *Profile) UpdateBasicInfo(ctx echo.Context) error {
 	return nil
 }
 
+// sendEmailChangeEmails sends the confirmation link to the pending email address of a user and notifies their
+// current address of the requested change.
+func (h *Profile) sendEmailChangeEmails(ctx echo.Context, usr *ent.User) error {
+	token, err := h.auth.GenerateEmailChangeToken(usr)
+	if err != nil {
+		return err
+	}
+
+	err = h.mail.Compose().
+		To(usr.PendingEmail).
+		Subject("Confirm your new email address").
+		Component(emails.ConfirmEmailChange(ctx, usr.Name, token)).
+		Send(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = h.mail.Compose().
+		To(usr.Email).
+		Subject("Your email address is being changed").
+		Component(emails.EmailChangeRequested(ctx, usr.Name, usr.PendingEmail)).
+		Send(ctx)
+	if err != nil {
+		log.Ctx(ctx).Error("unable to send email change notice",
+			"user_id", usr.ID,
+			"error", err,
+		)
+	}
+
+	return nil
+}
+
+func (h *Profile) CancelEmailChange(ctx echo.Context) error {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	if _, err := h.auth.CancelEmailChange(ctx, usr); err != nil {
+		return fail(err, "unable to cancel email change", h.Inertia, ctx)
+	}
+
+	msg.Success(ctx, "Your email address change has been cancelled.")
+	h.Inertia.Back(ctx.Response().Writer, ctx.Request())
+	return nil
+}
+
 func (h *Profile) UpdatePassword(ctx echo.Context) error {
 	var input UpdatePasswordForm
 
@@ -141,6 +284,10 @@ func (h *Profile) UpdatePassword(ctx echo.Context) error {
 		return nil
 	}
 
+	if rejectPassword(ctx, h.auth, h.Inertia, input.Password, usr.Name, usr.Email) {
+		return nil
+	}
+
 	_, err = h.orm.User.
 		UpdateOneID(usr.ID).
 		SetPassword(input.Password).
@@ -158,6 +305,11 @@ func (h *Profile) UpdatePassword(ctx echo.Context) error {
 		return nil
 	}
 
+	// Sign out all other devices, since they may have been signed in with the old password.
+	if err = h.auth.RevokeOtherSessions(ctx, usr.ID); err != nil {
+		log.Ctx(ctx).Error("unable to revoke sessions after password change", "error", err)
+	}
+
 	msg.Success(ctx, "Your password has been updated successfully.")
 	h.Inertia.Redirect(ctx.Response().Writer, ctx.Request(), ctx.Echo().Reverse(routenames.ProfilePassword))
 	return nil
@@ -166,21 +318,74 @@ func (h *Profile) UpdatePassword(ctx echo.Context) error {
 func (h *Profile) DeleteAccount(ctx echo.Context) error {
 	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
 
-	if err := h.auth.Logout(ctx); err != nil {
-		log.Ctx(ctx).Error("error during logout on delete", "error", err)
+	purgeAt, err := h.auth.DeleteAccount(ctx, usr)
+	if err != nil {
+		return fail(err, "unable to delete user account", h.Inertia, ctx)
 	}
 
-	if err := h.orm.User.DeleteOne(usr).Exec(ctx.Request().Context()); err != nil {
-		return fail(err, "unable to delete user account", h.Inertia, ctx)
+	_, err = h.tasks.
+		Add(tasks.AccountPurgeTask{
+			UserID: usr.ID,
+		}).
+		At(purgeAt).
+		Save()
+	if err != nil {
+		// Without the task the account would never be purged, so it is restored instead.
+		if _, rerr := h.auth.RestoreAccount(ctx, usr.ID); rerr != nil {
+			log.Ctx(ctx).Error("unable to restore account after failing to schedule its purge",
+				"user_id", usr.ID,
+				"error", rerr,
+			)
+		}
+		return fail(err, "unable to schedule account purge", h.Inertia, ctx)
 	}
 
 	uri := ctx.Echo().Reverse(routenames.Welcome)
 
-	msg.Success(ctx, "Your account has been deleted.")
+	msg.Success(ctx, fmt.Sprintf(
+		"Your account has been deleted and will be permanently removed on %s. Log in before then to restore it.",
+		purgeAt.Format("January 2, 2006"),
+	))
 	h.Inertia.Redirect(ctx.Response().Writer, ctx.Request(), uri)
 	return nil
 }
 
+func (h *Profile) RequestDataExport(ctx echo.Context) error {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	_, err := h.tasks.
+		Add(tasks.DataExportTask{
+			UserID: usr.ID,
+		}).
+		Save()
+	if err != nil {
+		return fail(err, "unable to queue data export", h.Inertia, ctx)
+	}
+
+	msg.Success(ctx, "We are preparing a copy of your data. You will receive an email with a link to download it shortly.")
+	h.Inertia.Back(ctx.Response().Writer, ctx.Request())
+	return nil
+}
+
+func (h *Profile) DownloadDataExport(ctx echo.Context) error {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	f, err := h.export.Open(ctx.Param("token"), usr.ID)
+	switch err.(type) {
+	case nil:
+	case services.InvalidExportTokenError:
+		msg.Warning(ctx, "This download link is invalid or has expired. Please request a new copy of your data.")
+		h.Inertia.Redirect(ctx.Response().Writer, ctx.Request(), ctx.Echo().Reverse(routenames.ProfileEdit))
+		return nil
+	default:
+		return fail(err, "unable to open data export", h.Inertia, ctx)
+	}
+	defer f.Close()
+
+	ctx.Response().Header().Set(echo.HeaderContentDisposition, `attachment; filename="data-export.zip"`)
+	return ctx.Stream(http.StatusOK, "application/zip", f)
+}
+
 func (h *Profile) AppearancePage(ctx echo.Context) error {
 	return h.Inertia.Render(
 		ctx.Response().Writer,
@@ -196,3 +401,470 @@ func (h *Profile) PasswordPage(ctx echo.Context) error {
 		"Settings/Password",
 	)
 }
+
+func (h *Profile) TwoFactorPage(ctx echo.Context) error {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	props := inertia.Props{
+		"enabled":       usr.TotpSecret != "",
+		"recoveryCodes": getRecoveryCodes(ctx),
+	}
+
+	if usr.TotpSecret == "" {
+		key, err := h.auth.GetTOTPEnrollmentKey(ctx, usr)
+		if err != nil {
+			return fail(err, "unable to generate totp key", h.Inertia, ctx)
+		}
+
+		img, err := key.Image(200, 200)
+		if err != nil {
+			return fail(err, "unable to generate totp qr code", h.Inertia, ctx)
+		}
+
+		var buf bytes.Buffer
+		if err = png.Encode(&buf, img); err != nil {
+			return fail(err, "unable to encode totp qr code", h.Inertia, ctx)
+		}
+
+		props["secret"] = key.Secret()
+		props["uri"] = key.URL()
+		props["qrCode"] = "data:image/png;base64," + base64.StdEncoding.EncodeToString(buf.Bytes())
+	} else {
+		remaining, err := h.auth.CountRecoveryCodes(ctx, usr.ID)
+		if err != nil {
+			return fail(err, "unable to count recovery codes", h.Inertia, ctx)
+		}
+		props["remainingRecoveryCodes"] = remaining
+	}
+
+	return h.Inertia.Render(
+		ctx.Response().Writer,
+		ctx.Request(),
+		"Settings/TwoFactor",
+		props,
+	)
+}
+
+func (h *Profile) EnableTwoFactor(ctx echo.Context) error {
+	var input EnableTwoFactorForm
+
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	err := form.Submit(ctx, &input)
+
+	switch err.(type) {
+	case nil:
+	case validator.ValidationErrors:
+		msg.Warning(ctx, "Please enter the code from your authenticator app.")
+		h.Inertia.Back(ctx.Response().Writer, ctx.Request())
+		return nil
+	default:
+		return err
+	}
+
+	if usr.TotpSecret != "" {
+		msg.Info(ctx, "Two-factor authentication is already enabled.")
+		h.Inertia.Back(ctx.Response().Writer, ctx.Request())
+		return nil
+	}
+
+	codes, err := h.auth.EnableTwoFactor(ctx, usr, input.Code)
+
+	switch err.(type) {
+	case nil:
+	case services.InvalidTwoFactorCodeError:
+		msg.Danger(ctx, "The code you entered is invalid. Please try again.")
+		h.Inertia.Back(ctx.Response().Writer, ctx.Request())
+		return nil
+	default:
+		return fail(err, "unable to enable two-factor authentication", h.Inertia, ctx)
+	}
+
+	if err = flashRecoveryCodes(ctx, codes); err != nil {
+		return fail(err, "unable to store recovery codes", h.Inertia, ctx)
+	}
+
+	msg.Success(ctx, "Two-factor authentication has been enabled.")
+	h.Inertia.Redirect(ctx.Response().Writer, ctx.Request(), ctx.Echo().Reverse(routenames.ProfileTwoFactor))
+	return nil
+}
+
+func (h *Profile) DisableTwoFactor(ctx echo.Context) error {
+	usr, ok := h.confirmPassword(ctx)
+	if !ok {
+		return nil
+	}
+
+	if err := h.auth.DisableTwoFactor(ctx, usr.ID); err != nil {
+		return fail(err, "unable to disable two-factor authentication", h.Inertia, ctx)
+	}
+
+	msg.Success(ctx, "Two-factor authentication has been disabled.")
+	h.Inertia.Redirect(ctx.Response().Writer, ctx.Request(), ctx.Echo().Reverse(routenames.ProfileTwoFactor))
+	return nil
+}
+
+func (h *Profile) RegenerateRecoveryCodes(ctx echo.Context) error {
+	usr, ok := h.confirmPassword(ctx)
+	if !ok {
+		return nil
+	}
+
+	if usr.TotpSecret == "" {
+		msg.Warning(ctx, "Two-factor authentication is not enabled.")
+		h.Inertia.Back(ctx.Response().Writer, ctx.Request())
+		return nil
+	}
+
+	codes, err := h.auth.GenerateRecoveryCodes(ctx, usr.ID)
+	if err != nil {
+		return fail(err, "unable to generate recovery codes", h.Inertia, ctx)
+	}
+
+	if err = flashRecoveryCodes(ctx, codes); err != nil {
+		return fail(err, "unable to store recovery codes", h.Inertia, ctx)
+	}
+
+	msg.Success(ctx, "New recovery codes have been generated.")
+	h.Inertia.Redirect(ctx.Response().Writer, ctx.Request(), ctx.Echo().Reverse(routenames.ProfileTwoFactor))
+	return nil
+}
+
+func (h *Profile) SessionsPage(ctx echo.Context) error {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	sessions, err := h.auth.GetSessions(ctx, usr.ID)
+	if err != nil {
+		return fail(err, "unable to load sessions", h.Inertia, ctx)
+	}
+
+	sessionData := make([]map[string]any, len(sessions))
+	for i, s := range sessions {
+		sessionData[i] = map[string]any{
+			"id":         s.ID,
+			"userAgent":  s.UserAgent,
+			"ipAddress":  s.IPAddress,
+			"createdAt":  s.CreatedAt.Format(time.RFC3339),
+			"lastSeenAt": s.LastSeenAt.Format(time.RFC3339),
+			"current":    h.auth.IsCurrentSession(ctx, s),
+		}
+	}
+
+	return h.Inertia.Render(
+		ctx.Response().Writer,
+		ctx.Request(),
+		"Settings/Sessions",
+		inertia.Props{
+			"sessions": sessionData,
+		},
+	)
+}
+
+func (h *Profile) RevokeSession(ctx echo.Context) error {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+	uri := ctx.Echo().Reverse(routenames.ProfileSessions)
+
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		msg.Danger(ctx, "Invalid session ID.")
+		h.Inertia.Redirect(ctx.Response().Writer, ctx.Request(), uri)
+		return nil
+	}
+
+	sess, err := h.orm.Session.Get(ctx.Request().Context(), id)
+	switch {
+	case err == nil && sess.UserID != nil && *sess.UserID == usr.ID:
+	case err == nil, ent.IsNotFound(err):
+		msg.Danger(ctx, "The session could not be found.")
+		h.Inertia.Redirect(ctx.Response().Writer, ctx.Request(), uri)
+		return nil
+	default:
+		return fail(err, "unable to load session", h.Inertia, ctx)
+	}
+
+	current := h.auth.IsCurrentSession(ctx, sess)
+
+	if err = h.auth.RevokeSession(ctx, usr.ID, id); err != nil {
+		return fail(err, "unable to revoke session", h.Inertia, ctx)
+	}
+
+	if current {
+		return h.signedOut(ctx)
+	}
+
+	msg.Success(ctx, "The device has been signed out.")
+	h.Inertia.Redirect(ctx.Response().Writer, ctx.Request(), uri)
+	return nil
+}
+
+func (h *Profile) RevokeAllSessions(ctx echo.Context) error {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	if err := h.auth.RevokeAllSessions(ctx, usr.ID); err != nil {
+		return fail(err, "unable to revoke sessions", h.Inertia, ctx)
+	}
+
+	return h.signedOut(ctx)
+}
+
+func (h *Profile) PasskeysPage(ctx echo.Context) error {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	creds, err := h.passkey.GetCredentials(ctx, usr.ID)
+	if err != nil {
+		return fail(err, "unable to load passkeys", h.Inertia, ctx)
+	}
+
+	passkeys := make([]map[string]any, len(creds))
+	for i, cred := range creds {
+		passkeys[i] = map[string]any{
+			"id":         cred.ID,
+			"name":       cred.Name,
+			"createdAt":  cred.CreatedAt.Format(time.RFC3339),
+			"lastUsedAt": formatOptionalTime(cred.LastUsedAt),
+		}
+	}
+
+	return h.Inertia.Render(
+		ctx.Response().Writer,
+		ctx.Request(),
+		"Settings/Passkeys",
+		inertia.Props{
+			"passkeys": passkeys,
+		},
+	)
+}
+
+func (h *Profile) DeletePasskey(ctx echo.Context) error {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+	uri := ctx.Echo().Reverse(routenames.ProfilePasskeys)
+
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		msg.Danger(ctx, "Invalid passkey ID.")
+		h.Inertia.Redirect(ctx.Response().Writer, ctx.Request(), uri)
+		return nil
+	}
+
+	err = h.passkey.DeleteCredential(ctx, usr.ID, id)
+	switch {
+	case err == nil:
+	case ent.IsNotFound(err):
+		msg.Danger(ctx, "The passkey could not be found.")
+		h.Inertia.Redirect(ctx.Response().Writer, ctx.Request(), uri)
+		return nil
+	default:
+		return fail(err, "unable to delete passkey", h.Inertia, ctx)
+	}
+
+	msg.Success(ctx, "The passkey has been removed.")
+	h.Inertia.Redirect(ctx.Response().Writer, ctx.Request(), uri)
+	return nil
+}
+
+func (h *Profile) AccessTokensPage(ctx echo.Context) error {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	tokens, err := h.auth.GetAccessTokens(ctx, usr.ID)
+	if err != nil {
+		return fail(err, "unable to load access tokens", h.Inertia, ctx)
+	}
+
+	tokenData := make([]map[string]any, len(tokens))
+	for i, t := range tokens {
+		tokenData[i] = map[string]any{
+			"id":         t.ID,
+			"name":       t.Name,
+			"scopes":     t.Scopes,
+			"createdAt":  t.CreatedAt.Format(time.RFC3339),
+			"lastUsedAt": formatOptionalTime(t.LastUsedAt),
+			"expiresAt":  formatOptionalTime(t.ExpiresAt),
+		}
+	}
+
+	scopes := make([]map[string]any, 0, len(authz.Scopes))
+	for _, s := range []authz.Scope{authz.ScopeRead, authz.ScopeWrite} {
+		scopes = append(scopes, map[string]any{
+			"name":        s,
+			"description": authz.Scopes[s],
+		})
+	}
+
+	return h.Inertia.Render(
+		ctx.Response().Writer,
+		ctx.Request(),
+		"Settings/AccessTokens",
+		inertia.Props{
+			"tokens":   tokenData,
+			"scopes":   scopes,
+			"newToken": getAccessToken(ctx),
+		},
+	)
+}
+
+func (h *Profile) CreateAccessToken(ctx echo.Context) error {
+	var input CreateAccessTokenForm
+
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	err := form.Submit(ctx, &input)
+	switch err.(type) {
+	case nil:
+	case validator.ValidationErrors:
+		msg.Warning(ctx, "Please enter a name and select at least one scope.")
+		h.Inertia.Back(ctx.Response().Writer, ctx.Request())
+		return nil
+	default:
+		return err
+	}
+
+	scopes := make([]authz.Scope, len(input.Scopes))
+	for i, s := range input.Scopes {
+		scopes[i] = authz.Scope(s)
+	}
+
+	var expiresAt *time.Time
+	if input.ExpiresIn > 0 {
+		t := time.Now().AddDate(0, 0, input.ExpiresIn)
+		expiresAt = &t
+	}
+
+	token, _, err := h.auth.CreateAccessToken(ctx, usr.ID, input.Name, scopes, expiresAt)
+	if err != nil {
+		return fail(err, "unable to create access token", h.Inertia, ctx)
+	}
+
+	if err = flashAccessToken(ctx, token); err != nil {
+		return fail(err, "unable to store access token", h.Inertia, ctx)
+	}
+
+	msg.Success(ctx, "The access token has been created.")
+	h.Inertia.Redirect(ctx.Response().Writer, ctx.Request(), ctx.Echo().Reverse(routenames.ProfileAccessTokens))
+	return nil
+}
+
+func (h *Profile) RevokeAccessToken(ctx echo.Context) error {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+	uri := ctx.Echo().Reverse(routenames.ProfileAccessTokens)
+
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		msg.Danger(ctx, "Invalid access token ID.")
+		h.Inertia.Redirect(ctx.Response().Writer, ctx.Request(), uri)
+		return nil
+	}
+
+	err = h.auth.RevokeAccessToken(ctx, usr.ID, id)
+	switch {
+	case err == nil:
+	case ent.IsNotFound(err):
+		msg.Danger(ctx, "The access token could not be found.")
+		h.Inertia.Redirect(ctx.Response().Writer, ctx.Request(), uri)
+		return nil
+	default:
+		return fail(err, "unable to revoke access token", h.Inertia, ctx)
+	}
+
+	msg.Success(ctx, "The access token has been revoked.")
+	h.Inertia.Redirect(ctx.Response().Writer, ctx.Request(), uri)
+	return nil
+}
+
+// signedOut logs out the current request once its session has been revoked and redirects to the login page.
+func (h *Profile) signedOut(ctx echo.Context) error {
+	if err := h.auth.Logout(ctx); err != nil {
+		log.Ctx(ctx).Error("error during logout after revoking session", "error", err)
+	}
+
+	msg.Success(ctx, "You have been signed out.")
+	h.Inertia.Redirect(ctx.Response().Writer, ctx.Request(), ctx.Echo().Reverse(routenames.Login))
+	return nil
+}
+
+// confirmPassword submits a ConfirmPasswordForm and checks the password of the authenticated user. If the
+// password could not be confirmed, the user is redirected back and false is returned.
+func (h *Profile) confirmPassword(ctx echo.Context) (*ent.User, bool) {
+	var input ConfirmPasswordForm
+
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	if err := form.Submit(ctx, &input); err == nil {
+		if err = h.auth.CheckPassword(input.Password, usr.Password); err == nil {
+			return usr, true
+		}
+	}
+
+	msg.Danger(ctx, "The password you entered is incorrect.")
+	h.Inertia.Back(ctx.Response().Writer, ctx.Request())
+	return nil, false
+}
+
+// flashRecoveryCodes stores newly generated recovery codes in the session so they can be displayed to the user
+// exactly once, since only their hashes are stored.
+func flashRecoveryCodes(ctx echo.Context, codes []string) error {
+	sess, err := session.Get(ctx, recoveryCodesSessionName)
+	if err != nil {
+		return err
+	}
+	sess.AddFlash(codes)
+	return sess.Save(ctx.Request(), ctx.Response())
+}
+
+// getRecoveryCodes returns and removes the recovery codes stored in the session, if any.
+func getRecoveryCodes(ctx echo.Context) []string {
+	sess, err := session.Get(ctx, recoveryCodesSessionName)
+	if err != nil {
+		return nil
+	}
+
+	flashes := sess.Flashes()
+	if len(flashes) == 0 {
+		return nil
+	}
+
+	if err = sess.Save(ctx.Request(), ctx.Response()); err != nil {
+		log.Ctx(ctx).Error("unable to clear recovery codes from session", "error", err)
+	}
+
+	codes, _ := flashes[0].([]string)
+	return codes
+}
+
+// flashAccessToken stores a newly created access token in the session so it can be displayed to the user exactly
+// once, since only its hash is stored.
+func flashAccessToken(ctx echo.Context, token string) error {
+	sess, err := session.Get(ctx, accessTokenSessionName)
+	if err != nil {
+		return err
+	}
+	sess.AddFlash(token)
+	return sess.Save(ctx.Request(), ctx.Response())
+}
+
+// getAccessToken returns and removes the access token stored in the session, if any.
+func getAccessToken(ctx echo.Context) string {
+	sess, err := session.Get(ctx, accessTokenSessionName)
+	if err != nil {
+		return ""
+	}
+
+	flashes := sess.Flashes()
+	if len(flashes) == 0 {
+		return ""
+	}
+
+	if err = sess.Save(ctx.Request(), ctx.Response()); err != nil {
+		log.Ctx(ctx).Error("unable to clear access token from session", "error", err)
+	}
+
+	token, _ := flashes[0].(string)
+	return token
+}
+
+// formatOptionalTime formats a given time, if provided, for use in page props.
+func formatOptionalTime(t *time.Time) any {
+	if t == nil {
+		return nil
+	}
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/occult/pagode/ent/role"
+	"github.com/occult/pagode/pkg/authz"
+	"github.com/occult/pagode/pkg/routenames"
+	"github.com/occult/pagode/pkg/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfile__AccessToken(t *testing.T) {
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	ctx, _ := tests.NewContext(c.Web, "/")
+	token, created, err := c.Auth.CreateAccessToken(ctx, u.ID, "handler", []authz.Scope{authz.ScopeRead, authz.ScopeWrite}, nil)
+	require.NoError(t, err)
+
+	client := http.Client{
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	do := func(method, route string, params ...any) *http.Response {
+		req, err := http.NewRequest(method, srv.URL+c.Web.Reverse(route, params...), nil)
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		return resp
+	}
+
+	// Requests authenticated with the token should not require a session or a CSRF token
+	resp := do(http.MethodGet, routenames.ProfileEdit)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// Tokens, sessions and credentials cannot be managed with a token
+	resp = do(http.MethodGet, routenames.ProfileAccessTokens)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	resp = do(http.MethodPost, routenames.ProfileAccessTokenCreate)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	resp = do(http.MethodPost, routenames.ProfileAccessTokenRevoke, created.ID)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	resp = do(http.MethodPost, routenames.ProfileSessionsRevokeAll)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	resp = do(http.MethodPost, routenames.ProfileUpdatePassword)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	resp = do(http.MethodPost, routenames.ProfileDestroy)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	// Nor can payments or organizations be managed
+	resp = do(http.MethodPost, routenames.BillingCancel)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	resp = do(http.MethodPost, routenames.PlansSubscribe)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	resp = do(http.MethodPost, routenames.ProductsPurchase)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	resp = do(http.MethodPost, routenames.OrganizationCreate)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	resp = do(http.MethodPost, routenames.OrganizationSwitch)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	// Nor can the app be administered, even with the required permissions
+	admin, err := c.ORM.Role.Query().Where(role.Name(authz.AdminRole)).Only(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, c.ORM.User.UpdateOneID(u.ID).AddRoles(admin).Exec(context.Background()))
+	resp = do(http.MethodGet, routenames.AdminDashboard)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	// Revoked tokens are rejected
+	require.NoError(t, c.Auth.RevokeAccessToken(ctx, u.ID, created.ID))
+	resp = do(http.MethodGet, routenames.ProfileEdit)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
@@ -1,13 +1,14 @@
 package handlers
 
 import (
+	"context"
 	"net/http"
+	"strings"
 
-	"github.com/gorilla/sessions"
 	"github.com/labstack/echo/v4"
 	echomw "github.com/labstack/echo/v4/middleware"
 	"github.com/occult/pagode/config"
-	"github.com/occult/pagode/pkg/context"
+	appctx "github.com/occult/pagode/pkg/context"
 	"github.com/occult/pagode/pkg/middleware"
 	"github.com/occult/pagode/pkg/services"
 )
@@ -25,6 +26,11 @@ func BuildRouter(c *services.Container) error {
 	c.Web.Group("", middleware.CacheControl(c.Config.Cache.Expiration.StaticFile)).
 		Static(config.StaticPrefix, config.StaticDir)
 
+	// Cached pages refer to the assets of the build which rendered them, so they are cleared on start up.
+	if err := c.Cache.Flush().Tags(middleware.PageCacheTag).Execute(context.Background()); err != nil {
+		return err
+	}
+
 	// Non-static file route group.
 	g := c.Web.Group("")
 
@@ -33,11 +39,6 @@ func BuildRouter(c *services.Container) error {
 		g.Use(echomw.HTTPSRedirect())
 	}
 
-	// Create a cookie store for session data.
-	cookieStore := sessions.NewCookieStore([]byte(c.Config.App.EncryptionKey))
-	cookieStore.Options.HttpOnly = true
-	cookieStore.Options.SameSite = http.SameSiteStrictMode
-
 	g.Use(
 		echomw.RemoveTrailingSlashWithConfig(echomw.TrailingSlashConfig{
 			RedirectCode: http.StatusMovedPermanently,
@@ -52,15 +53,18 @@ func BuildRouter(c *services.Container) error {
 			Timeout: c.Config.App.Timeout,
 		}),
 		middleware.Config(c.Config),
-		middleware.Session(cookieStore),
+		middleware.Session(c.Session),
+		middleware.LoadAccessToken(c.Auth),
 		middleware.LoadAuthenticatedUser(c.Auth),
+		middleware.SetAuditActor(),
 		echomw.CSRFWithConfig(echomw.CSRFConfig{
+			Skipper:        csrfSkipper,
 			TokenLookup:    "header:X-XSRF-TOKEN", // where to look for token
 			CookieName:     "XSRF-TOKEN",          // this sets the cookie
 			CookiePath:     "/",                   // make it accessible app-wide
 			CookieHTTPOnly: false,                 // must be false so JS (Axios) can read it
 			CookieSameSite: http.SameSiteStrictMode,
-			ContextKey:     context.CSRFKey,
+			ContextKey:     appctx.CSRFKey,
 		}),
 		echo.WrapMiddleware(c.Inertia.Middleware),
 		middleware.InertiaProps(), // leave this as the last one
@@ -71,8 +75,9 @@ func BuildRouter(c *services.Container) error {
 	wsG.Use(
 		echomw.Recover(),
 		middleware.SetLogger(),
-		middleware.Session(cookieStore),
+		middleware.Session(c.Session),
 		middleware.LoadAuthenticatedUser(c.Auth),
+		middleware.SetAuditActor(),
 	)
 	c.WebSocketGroup = wsG
 
@@ -100,3 +105,13 @@ func BuildRouter(c *services.Container) error {
 
 	return nil
 }
+
+// csrfSkipper skips CSRF protection for requests which are authenticated by other means, such as
+// provider webhooks that are verified by their signature, or requests authenticated with an access token, which
+// browsers never send on their own.
+func csrfSkipper(ctx echo.Context) bool {
+	if ctx.Get(appctx.AccessTokenKey) != nil {
+		return true
+	}
+	return strings.HasPrefix(ctx.Request().URL.Path, "/webhooks/")
+}
@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/occult/pagode/pkg/log"
+	"github.com/occult/pagode/pkg/routenames"
+	"github.com/occult/pagode/pkg/services"
+)
+
+// webhookMaxBodySize limits the size of webhook payloads that will be read.
+const webhookMaxBodySize = 65536
+
+type Webhooks struct {
+	payment *services.PaymentClient
+}
+
+func init() {
+	Register(new(Webhooks))
+}
+
+func (h *Webhooks) Init(c *services.Container) error {
+	h.payment = c.Payment
+	return nil
+}
+
+func (h *Webhooks) Routes(g *echo.Group) {
+	// CSRF protection is skipped for this path in BuildRouter since requests are verified by their signature.
+	g.POST("/webhooks/:provider", h.Payment).Name = routenames.Webhook
+}
+
+// Payment receives signed webhook events from the payment provider and reconciles the local payment entities.
+func (h *Webhooks) Payment(ctx echo.Context) error {
+	if ctx.Param("provider") != h.payment.ProviderName() {
+		return echo.NewHTTPError(http.StatusNotFound)
+	}
+
+	payload, err := io.ReadAll(io.LimitReader(ctx.Request().Body, webhookMaxBodySize))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "unable to read webhook payload")
+	}
+
+	event, err := h.payment.ParseWebhookEvent(payload, ctx.Request().Header.Get(h.payment.WebhookSignatureHeader()))
+	if err != nil {
+		log.Ctx(ctx).Warn("rejected webhook", "error", err)
+
+		var invalid services.InvalidWebhookError
+		if errors.As(err, &invalid) {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid webhook signature")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	// Returning an error status causes the provider to retry the delivery later.
+	if err := h.payment.HandleWebhookEvent(ctx.Request().Context(), event); err != nil {
+		log.Ctx(ctx).Error("failed to process webhook",
+			"event_id", event.ID,
+			"event_type", event.Type,
+			"error", err,
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	log.Ctx(ctx).Info("processed webhook",
+		"event_id", event.ID,
+		"event_type", event.Type,
+	)
+
+	return ctx.NoContent(http.StatusOK)
+}
@@ -0,0 +1,35 @@
+package middleware
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/pkg/context"
+	"github.com/occult/pagode/pkg/services"
+)
+
+// SetAuditActor stores who is making the request in the request context, so that the changes made while handling
+// it are attributed to them in the audit log.
+// This must run after LoadAuthenticatedUser.
+func SetAuditActor() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			actor := services.AuditActor{
+				IPAddress: c.RealIP(),
+				RequestID: c.Response().Header().Get(echo.HeaderXRequestID),
+			}
+
+			if u, ok := c.Get(context.AuthenticatedUserKey).(*ent.User); ok {
+				actor.UserID = u.ID
+			}
+
+			if u, ok := c.Get(context.ImpersonatorKey).(*ent.User); ok {
+				actor.ImpersonatorID = u.ID
+			}
+
+			ctx := services.WithAuditActor(c.Request().Context(), actor)
+			c.SetRequest(c.Request().WithContext(ctx))
+
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"testing"
+
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/auditevent"
+	"github.com/occult/pagode/pkg/context"
+	"github.com/occult/pagode/pkg/services"
+	"github.com/occult/pagode/pkg/tests"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetAuditActor(t *testing.T) {
+	ctx, _ := tests.NewContext(c.Web, "/")
+	ctx.Set(context.AuthenticatedUserKey, usr)
+	ctx.Request().RemoteAddr = "1.2.3.4:1234"
+	ctx.Response().Header().Set(echo.HeaderXRequestID, "abc")
+	err := tests.ExecuteMiddleware(ctx, SetAuditActor())
+	require.NoError(t, err)
+
+	// Changes made with the context of the request are attributed to the authenticated user.
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	err = c.ORM.User.UpdateOneID(u.ID).SetName("Audited").Exec(ctx.Request().Context())
+	require.NoError(t, err)
+
+	e, err := c.ORM.AuditEvent.
+		Query().
+		Where(auditevent.EntityType(ent.TypeUser)).
+		Where(auditevent.EntityID(u.ID)).
+		Where(auditevent.Action(services.AuditActionUpdate)).
+		Only(ctx.Request().Context())
+	require.NoError(t, err)
+	require.NotNil(t, e.ActorID)
+	assert.Equal(t, usr.ID, *e.ActorID)
+	assert.Nil(t, e.ImpersonatorID)
+	assert.Equal(t, "1.2.3.4", e.IPAddress)
+	assert.Equal(t, "abc", e.RequestID)
+}
@@ -4,12 +4,16 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/membership"
+	"github.com/occult/pagode/ent/organization"
 	"github.com/occult/pagode/ent/paymentcustomer"
 	"github.com/occult/pagode/ent/paymentintent"
 	"github.com/occult/pagode/ent/subscription"
 	entuser "github.com/occult/pagode/ent/user"
+	"github.com/occult/pagode/pkg/authz"
 	"github.com/occult/pagode/pkg/context"
 	"github.com/occult/pagode/pkg/log"
 	"github.com/occult/pagode/pkg/msg"
@@ -37,6 +41,58 @@ func LoadAuthenticatedUser(authClient *services.AuthClient) echo.MiddlewareFunc
 				)
 			}
 
+			if u != nil {
+				admin, err := authClient.GetImpersonator(c)
+				if err != nil {
+					return echo.NewHTTPError(
+						http.StatusInternalServerError,
+						fmt.Sprintf("error querying for impersonator: %v", err),
+					)
+				}
+				if admin != nil {
+					c.Set(context.ImpersonatorKey, admin)
+				}
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// LoadAccessToken authenticates requests which provide an access token in an `Authorization: Bearer` header and
+// stores the token in context, so the user it belongs to is loaded as the authenticated user. Requests with an
+// invalid token are rejected, as are those which the token has not been granted the scope for.
+// This must run before LoadAuthenticatedUser.
+func LoadAccessToken(authClient *services.AuthClient) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			scheme, token, found := strings.Cut(c.Request().Header.Get(echo.HeaderAuthorization), " ")
+			if !found || !strings.EqualFold(scheme, "Bearer") {
+				return next(c)
+			}
+
+			t, err := authClient.AuthenticateAccessToken(c, strings.TrimSpace(token))
+			switch err.(type) {
+			case nil:
+			case services.InvalidAccessTokenError:
+				c.Response().Header().Set(echo.HeaderWWWAuthenticate, `Bearer error="invalid_token"`)
+				return echo.NewHTTPError(http.StatusUnauthorized)
+			default:
+				return echo.NewHTTPError(
+					http.StatusInternalServerError,
+					fmt.Sprintf("error authenticating access token: %v", err),
+				)
+			}
+
+			if scope := authz.RequiredScope(c.Request().Method); !authz.Allows(t, scope) {
+				c.Response().Header().Set(
+					echo.HeaderWWWAuthenticate,
+					fmt.Sprintf(`Bearer error="insufficient_scope", scope="%s"`, scope),
+				)
+				return echo.NewHTTPError(http.StatusForbidden)
+			}
+
+			c.Set(context.AccessTokenKey, t)
 			return next(c)
 		}
 	}
@@ -108,23 +164,56 @@ func RequireNoAuthentication(next echo.HandlerFunc) echo.HandlerFunc {
 	}
 }
 
-// RequireAdmin requires that the authenticated user be an admin in order to proceed.
-func RequireAdmin(next echo.HandlerFunc) echo.HandlerFunc {
+// RequireNoImpersonation prevents admins who are impersonating the authenticated user from proceeding, which
+// protects destructive actions such as deleting the account or making payments.
+func RequireNoImpersonation(next echo.HandlerFunc) echo.HandlerFunc {
 	return func(c echo.Context) error {
-		if u := c.Get(context.AuthenticatedUserKey); u != nil {
-			if user, ok := u.(*ent.User); ok {
-				if user.Admin {
-					return next(c)
-				}
+		if c.Get(context.ImpersonatorKey) != nil {
+			msg.Warning(c, "This action is not available while impersonating a user.")
+
+			back := c.Request().Referer()
+			if back == "" {
+				back = c.Echo().Reverse(routenames.Dashboard)
 			}
+			return c.Redirect(http.StatusSeeOther, back)
 		}
 
-		return echo.NewHTTPError(http.StatusUnauthorized)
+		return next(c)
+	}
+}
+
+// RequireSession prevents requests authenticated with an access token from proceeding, so a token cannot be used to
+// manage credentials, sessions or tokens, or to administer the app. Those require the user to be logged in.
+func RequireSession(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if c.Get(context.AccessTokenKey) != nil {
+			return echo.NewHTTPError(http.StatusForbidden, "This action is not available with an access token.")
+		}
+
+		return next(c)
+	}
+}
+
+// RequirePermission requires that the authenticated user has been granted a given permission in order to proceed.
+func RequirePermission(permission authz.Permission) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			u, ok := c.Get(context.AuthenticatedUserKey).(*ent.User)
+			if !ok {
+				return echo.NewHTTPError(http.StatusUnauthorized)
+			}
+
+			if !authz.Has(u, permission) {
+				return echo.NewHTTPError(http.StatusForbidden)
+			}
+
+			return next(c)
+		}
 	}
 }
 
-// RequirePaidUser requires that the authenticated user has either an active subscription
-// or a successful payment intent in order to proceed.
+// RequirePaidUser requires that the authenticated user, or an organization they are a member of, has either an
+// active subscription or a successful payment intent in order to proceed.
 func RequirePaidUser(db *ent.Client) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
@@ -139,12 +228,18 @@ func RequirePaidUser(db *ent.Client) echo.MiddlewareFunc {
 				return echo.NewHTTPError(http.StatusInternalServerError, "invalid user type")
 			}
 
+			// Payments made by the user, or by any organization they are a member of, count.
+			customer := paymentcustomer.Or(
+				paymentcustomer.HasUserWith(entuser.IDEQ(user.ID)),
+				paymentcustomer.HasOrganizationWith(
+					organization.HasMembershipsWith(membership.UserID(user.ID)),
+				),
+			)
+
 			// Check if user has an active subscription
 			hasActiveSubscription, err := db.Subscription.
 				Query().
-				Where(subscription.HasCustomerWith(
-					paymentcustomer.HasUserWith(entuser.IDEQ(user.ID)),
-				)).
+				Where(subscription.HasCustomerWith(customer)).
 				Where(subscription.StatusEQ(subscription.StatusActive)).
 				Exist(c.Request().Context())
 			
@@ -159,9 +254,7 @@ func RequirePaidUser(db *ent.Client) echo.MiddlewareFunc {
 			// Check if user has a successful payment intent (one-time purchase)
 			hasSuccessfulPayment, err := db.PaymentIntent.
 				Query().
-				Where(paymentintent.HasCustomerWith(
-					paymentcustomer.HasUserWith(entuser.IDEQ(user.ID)),
-				)).
+				Where(paymentintent.HasCustomerWith(customer)).
 				Where(paymentintent.StatusEQ(paymentintent.StatusSucceeded)).
 				Exist(c.Request().Context())
 			
@@ -7,9 +7,13 @@ import (
 	"testing"
 
 	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/permission"
+	"github.com/occult/pagode/pkg/authz"
 	"github.com/occult/pagode/pkg/context"
 	"github.com/occult/pagode/pkg/tests"
 
+	"github.com/labstack/echo/v4"
+
 	"github.com/stretchr/testify/require"
 
 	"github.com/stretchr/testify/assert"
@@ -36,6 +40,47 @@ func TestLoadAuthenticatedUser(t *testing.T) {
 	assert.Equal(t, usr.ID, ctxUsr.ID)
 }
 
+func TestLoadAccessToken(t *testing.T) {
+	ctx, _ := tests.NewContext(c.Web, "/")
+	token, _, err := c.Auth.CreateAccessToken(ctx, usr.ID, "middleware", []authz.Scope{authz.ScopeRead}, nil)
+	require.NoError(t, err)
+
+	newContext := func(method, authorization string) echo.Context {
+		ctx, _ := tests.NewContext(c.Web, "/")
+		ctx.Request().Method = method
+		ctx.Request().Header.Set(echo.HeaderAuthorization, authorization)
+		tests.InitSession(ctx)
+		return ctx
+	}
+
+	// No token
+	ctx = newContext(http.MethodGet, "")
+	err = tests.ExecuteMiddleware(ctx, LoadAccessToken(c.Auth))
+	require.NoError(t, err)
+	assert.Nil(t, ctx.Get(context.AccessTokenKey))
+
+	// Invalid token
+	ctx = newContext(http.MethodGet, "Bearer invalid")
+	err = tests.ExecuteMiddleware(ctx, LoadAccessToken(c.Auth))
+	tests.AssertHTTPErrorCode(t, err, http.StatusUnauthorized)
+
+	// Missing scope
+	ctx = newContext(http.MethodPost, "Bearer "+token)
+	err = tests.ExecuteMiddleware(ctx, LoadAccessToken(c.Auth))
+	tests.AssertHTTPErrorCode(t, err, http.StatusForbidden)
+
+	// Valid token, which should authenticate the user it belongs to
+	ctx = newContext(http.MethodGet, "Bearer "+token)
+	err = tests.ExecuteMiddleware(ctx, LoadAccessToken(c.Auth))
+	require.NoError(t, err)
+	assert.NotNil(t, ctx.Get(context.AccessTokenKey))
+
+	_ = tests.ExecuteMiddleware(ctx, LoadAuthenticatedUser(c.Auth))
+	ctxUsr, ok := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+	require.True(t, ok)
+	assert.Equal(t, usr.ID, ctxUsr.ID)
+}
+
 func TestRequireAuthentication(t *testing.T) {
 	ctx, _ := tests.NewContext(c.Web, "/")
 	tests.InitSession(ctx)
@@ -112,35 +157,85 @@ func TestLoadValidPasswordToken(t *testing.T) {
 	assert.Equal(t, pt.ID, ctxPt.ID)
 }
 
-func TestRequireAdmin(t *testing.T) {
+func TestRequirePermission(t *testing.T) {
 	ctx, _ := tests.NewContext(c.Web, "/")
 	tests.InitSession(ctx)
+	mw := RequirePermission(authz.ChatModerate)
 
 	// Not logged in
-	err := tests.ExecuteMiddleware(ctx, RequireAdmin)
+	err := tests.ExecuteMiddleware(ctx, mw)
 	tests.AssertHTTPErrorCode(t, err, http.StatusUnauthorized)
 
-	// Login as a non-admin
+	// Login as a user without any roles
 	err = c.Auth.Login(ctx, usr.ID)
 	require.NoError(t, err)
 	_ = tests.ExecuteMiddleware(ctx, LoadAuthenticatedUser(c.Auth))
 
-	// Logged in as a non-admin
-	err = tests.ExecuteMiddleware(ctx, RequireAdmin)
-	tests.AssertHTTPErrorCode(t, err, http.StatusUnauthorized)
+	// Logged in without the permission
+	err = tests.ExecuteMiddleware(ctx, mw)
+	tests.AssertHTTPErrorCode(t, err, http.StatusForbidden)
 
-	// Create an admin and login
-	adm, err := tests.CreateUser(c.ORM)
+	// Create a moderator and login
+	perm, err := c.ORM.Permission.
+		Query().
+		Where(permission.Name(string(authz.ChatModerate))).
+		Only(goctx.Background())
 	require.NoError(t, err)
-	err = c.ORM.User.Update().
-		SetAdmin(true).
+	moderator, err := c.ORM.Role.
+		Create().
+		SetName("moderator").
+		AddPermissions(perm).
+		Save(goctx.Background())
+	require.NoError(t, err)
+	mod, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	err = mod.Update().
+		AddRoles(moderator).
 		Exec(goctx.Background())
 	require.NoError(t, err)
-	err = c.Auth.Login(ctx, adm.ID)
+	err = c.Auth.Login(ctx, mod.ID)
 	require.NoError(t, err)
 	_ = tests.ExecuteMiddleware(ctx, LoadAuthenticatedUser(c.Auth))
 
-	// Logged in as an admin
-	err = tests.ExecuteMiddleware(ctx, RequireAdmin)
+	// Logged in with the permission
+	err = tests.ExecuteMiddleware(ctx, mw)
+	assert.Nil(t, err)
+
+	// Other permissions should still be required
+	err = tests.ExecuteMiddleware(ctx, RequirePermission(authz.UsersManage))
+	tests.AssertHTTPErrorCode(t, err, http.StatusForbidden)
+}
+
+func TestRequireSession(t *testing.T) {
+	ctx, _ := tests.NewContext(c.Web, "/")
+	ctx.Set(context.AuthenticatedUserKey, usr)
+	err := tests.ExecuteMiddleware(ctx, RequireSession)
+	assert.Nil(t, err)
+
+	// Authenticated with an access token
+	ctx, _ = tests.NewContext(c.Web, "/")
+	ctx.Set(context.AuthenticatedUserKey, usr)
+	ctx.Set(context.AccessTokenKey, &ent.AccessToken{UserID: usr.ID})
+	err = tests.ExecuteMiddleware(ctx, RequireSession)
+	tests.AssertHTTPErrorCode(t, err, http.StatusForbidden)
+}
+
+func TestRequireNoImpersonation(t *testing.T) {
+	ctx, _ := tests.NewContext(c.Web, "/")
+	tests.InitSession(ctx)
+
+	// Not impersonating
+	err := tests.ExecuteMiddleware(ctx, RequireNoImpersonation)
 	assert.Nil(t, err)
+	assert.NotEqual(t, http.StatusSeeOther, ctx.Response().Status)
+
+	// Impersonating - should redirect back
+	ctx, _ = tests.NewContext(c.Web, "/")
+	tests.InitSession(ctx)
+	ctx.Request().Header.Set("Referer", "/profile/info")
+	ctx.Set(context.ImpersonatorKey, usr)
+	err = tests.ExecuteMiddleware(ctx, RequireNoImpersonation)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusSeeOther, ctx.Response().Status)
+	assert.Equal(t, "/profile/info", ctx.Response().Header().Get(echo.HeaderLocation))
 }
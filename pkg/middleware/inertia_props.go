@@ -2,6 +2,8 @@ package middleware
 
 import (
 	"github.com/labstack/echo/v4"
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/pkg/authz"
 	"github.com/occult/pagode/pkg/context"
 	"github.com/occult/pagode/pkg/msg"
 	"github.com/romsar/gonertia/v2"
@@ -10,8 +12,19 @@ import (
 func InertiaProps() echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(ctx echo.Context) error {
-			// Get authenticated user
+			// Get authenticated user and their permissions
 			user := ctx.Get(context.AuthenticatedUserKey)
+			authUser, _ := user.(*ent.User)
+
+			// Get the admin impersonating the user, if any, so a banner can be shown
+			var impersonator map[string]any
+			if admin, ok := ctx.Get(context.ImpersonatorKey).(*ent.User); ok {
+				impersonator = map[string]any{
+					"id":    admin.ID,
+					"name":  admin.Name,
+					"email": admin.Email,
+				}
+			}
 
 			// Collect errors by type
 			flash := make(map[string][]string)
@@ -31,7 +44,9 @@ func InertiaProps() echo.MiddlewareFunc {
 			newCtx := gonertia.SetProps(ctx.Request().Context(), map[string]any{
 				"flash": flash,
 				"auth": map[string]any{
-					"user": user,
+					"user":         user,
+					"permissions":  authz.List(authUser),
+					"impersonator": impersonator,
 				},
 			})
 
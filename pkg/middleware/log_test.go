@@ -81,7 +81,7 @@ func TestLogRequest(t *testing.T) {
 		logger := slog.New(h).With("previous", "param")
 		log.Set(ctx, logger)
 		ctx.Request().Header.Set("Referer", "ref.com")
-		ctx.Request().Header.Set(echo.HeaderXRealIP, "21.12.12.21")
+		ctx.Request().RemoteAddr = "21.12.12.21:1234"
 
 		require.NoError(t, tests.ExecuteHandler(ctx, func(ctx echo.Context) error {
 			return ctx.String(statusCode, "hello")
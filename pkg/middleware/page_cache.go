@@ -0,0 +1,371 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/pkg/context"
+	"github.com/occult/pagode/pkg/log"
+	"github.com/occult/pagode/pkg/msg"
+	"github.com/occult/pagode/pkg/services"
+	"github.com/occult/pagode/pkg/session"
+	inertia "github.com/romsar/gonertia/v2"
+)
+
+// PageCacheTag is the cache tag of all cached pages, which can be flushed to clear them when content changes.
+const PageCacheTag = "pages"
+
+// pageCacheGroup is the cache group pages are stored in.
+const pageCacheGroup = "page"
+
+// pageCacheVersion is the version of the format of cachedPage.
+const pageCacheVersion = 1
+
+// pageCacheKeyHeaders are the request headers which change the response of Inertia, so they are part of the key.
+var pageCacheKeyHeaders = []string{
+	"X-Inertia",
+	"X-Inertia-Version",
+	"X-Inertia-Partial-Component",
+	"X-Inertia-Partial-Data",
+	"X-Inertia-Partial-Except",
+	"X-Inertia-Reset",
+}
+
+type (
+	// cachedPage is a response stored in the cache.
+	cachedPage struct {
+		Status int
+		// Header contains the headers set by the handler.
+		Header       http.Header
+		Body         []byte
+		ETag         string
+		LastModified time.Time
+		// Vary contains the values of the request headers the response varies by.
+		Vary map[string]string
+	}
+
+	// pageRecorder buffers a response so it can be cached before it is written.
+	pageRecorder struct {
+		http.ResponseWriter
+		status int
+		body   bytes.Buffer
+	}
+)
+
+// CachePage caches successful GET responses for a given duration, with the PageCacheTag and any additional tags, and
+// serves them to later requests with the same path, query, Inertia headers and authenticated user.
+// Responses are not cached if they set cookies, vary by all headers, or have a Cache-Control header which prevents
+// storing them, and their expiration is capped by its max age. Requests with a no-cache Cache-Control header are not
+// served from the cache, and those with a flash message or validation errors bypass it entirely, as those are only
+// shown once, as do those with session data other than the authenticated user, which pages may show. ETag and Last-Modified headers are added to cached responses, and conditional requests are answered
+// with 304 Not Modified.
+// This must run after InertiaProps, so it should be added to routes rather than groups.
+func CachePage(cache *services.CacheClient, expiration time.Duration, tags ...string) echo.MiddlewareFunc {
+	tags = append([]string{PageCacheTag}, tags...)
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			req := ctx.Request()
+			directives := cacheControlDirectives(req.Header)
+
+			if expiration <= 0 || req.Method != http.MethodGet || directives["no-store"] || hasOneTimeProps(ctx) ||
+				hasSessionData(ctx) {
+				return next(ctx)
+			}
+
+			key := pageCacheKey(ctx)
+
+			if !directives["no-cache"] {
+				page, err := services.FetchAs[cachedPage](req.Context(), cache.
+					Get().
+					Group(pageCacheGroup).
+					Key(key).
+					Version(pageCacheVersion),
+				)
+				switch {
+				case err == nil && page.matches(req):
+					return page.write(ctx)
+				case err != nil && !errors.Is(err, services.ErrCacheMiss):
+					log.Ctx(ctx).Error("unable to fetch cached page", "key", key, "error", err)
+				}
+			}
+
+			// Record the response, keeping track of the headers set by the handler.
+			res := ctx.Response()
+			before := res.Header().Clone()
+			rec := &pageRecorder{ResponseWriter: res.Writer}
+			res.Writer = rec
+
+			err := next(ctx)
+
+			res.Writer = rec.ResponseWriter
+			if rec.status == 0 {
+				return err
+			}
+
+			// Allow the response to be written again.
+			res.Committed = false
+			res.Size = 0
+
+			page := cachedPage{
+				Status:       rec.status,
+				Header:       make(http.Header),
+				Body:         rec.body.Bytes(),
+				LastModified: time.Now().UTC().Truncate(time.Second),
+			}
+			for name, values := range res.Header() {
+				if !slices.Equal(values, before[name]) {
+					page.Header[name] = values
+				}
+			}
+
+			ttl, ok := page.expiration(res.Header(), expiration)
+			if err != nil || !ok {
+				res.WriteHeader(page.Status)
+				_, _ = res.Write(page.Body)
+				return err
+			}
+
+			page.prepare(req, res.Header())
+
+			err = services.SaveAs(req.Context(), cache.
+				Set().
+				Group(pageCacheGroup).
+				Key(key).
+				Tags(tags...).
+				Expiration(ttl).
+				Version(pageCacheVersion),
+				page,
+			)
+			if err != nil {
+				log.Ctx(ctx).Error("unable to cache page", "key", key, "error", err)
+			}
+
+			return page.write(ctx)
+		}
+	}
+}
+
+// hasOneTimeProps returns true if the Inertia props of a request contain flash messages or validation errors.
+func hasOneTimeProps(ctx echo.Context) bool {
+	props := inertia.PropsFromContext(ctx.Request().Context())
+	if flash, ok := props["flash"].(map[string][]string); ok && len(flash) > 0 {
+		return true
+	}
+
+	return len(inertia.ValidationErrorsFromContext(ctx.Request().Context())) > 0
+}
+
+// hasSessionData returns true if the request has a session containing data, other than the authentication session of
+// an authenticated user, which the key covers.
+// Only the sessions pages can show are checked, and only if the request has their cookie, as each one is loaded from
+// the database.
+func hasSessionData(ctx echo.Context) bool {
+	_, authenticated := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	for _, name := range []string{services.AuthSessionName, msg.SessionName} {
+		if authenticated && name == services.AuthSessionName {
+			continue
+		}
+
+		if _, err := ctx.Cookie(name); err != nil {
+			continue
+		}
+
+		sess, err := session.Get(ctx, name)
+		if errors.Is(err, session.ErrStoreNotFound) {
+			return false
+		}
+		if err != nil || len(sess.Values) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// pageCacheKey returns the key the response to a request is cached under.
+func pageCacheKey(ctx echo.Context) string {
+	req := ctx.Request()
+	h := sha256.New()
+
+	_, _ = fmt.Fprintf(h, "%s\n", req.URL.Query().Encode())
+
+	for _, name := range pageCacheKeyHeaders {
+		values := strings.Split(req.Header.Get(name), ",")
+		for i := range values {
+			values[i] = strings.TrimSpace(values[i])
+		}
+		slices.Sort(values)
+		_, _ = fmt.Fprintf(h, "%s\n", strings.Join(values, ","))
+	}
+
+	// The auth props contain everything about the user which is shown on pages, so changes to them, such as to
+	// their name or permissions, lead to a different key.
+	if auth, ok := inertia.PropsFromContext(req.Context())["auth"]; ok {
+		_ = json.NewEncoder(h).Encode(auth)
+	} else if u, ok := ctx.Get(context.AuthenticatedUserKey).(*ent.User); ok {
+		_, _ = fmt.Fprintf(h, "user:%d", u.ID)
+	}
+
+	return fmt.Sprintf("%s:%s", req.URL.Path, hex.EncodeToString(h.Sum(nil)))
+}
+
+// cacheControlDirectives returns the directives of the Cache-Control headers, without their values.
+func cacheControlDirectives(header http.Header) map[string]bool {
+	directives := make(map[string]bool)
+	for _, v := range header.Values(echo.HeaderCacheControl) {
+		for _, d := range strings.Split(v, ",") {
+			name, _, _ := strings.Cut(strings.TrimSpace(d), "=")
+			directives[strings.ToLower(name)] = true
+		}
+	}
+	return directives
+}
+
+// expiration returns how long the page can be cached for, given the final headers of its response and the
+// expiration of the route, and whether it can be cached at all.
+func (p *cachedPage) expiration(header http.Header, expiration time.Duration) (time.Duration, bool) {
+	if p.Status != http.StatusOK || len(p.Body) == 0 || len(p.Header.Values(echo.HeaderSetCookie)) > 0 {
+		return 0, false
+	}
+
+	for _, v := range header.Values(echo.HeaderVary) {
+		if strings.Contains(v, "*") {
+			return 0, false
+		}
+	}
+
+	var maxAge, sharedMaxAge string
+	for _, v := range header.Values(echo.HeaderCacheControl) {
+		for _, d := range strings.Split(v, ",") {
+			name, value, _ := strings.Cut(strings.TrimSpace(d), "=")
+			switch strings.ToLower(name) {
+			case "no-store", "no-cache", "private":
+				return 0, false
+			case "max-age":
+				maxAge = value
+			case "s-maxage":
+				sharedMaxAge = value
+			}
+		}
+	}
+
+	if sharedMaxAge != "" {
+		maxAge = sharedMaxAge
+	}
+	if maxAge != "" {
+		seconds, err := strconv.Atoi(strings.Trim(maxAge, `"`))
+		if err != nil || seconds <= 0 {
+			return 0, false
+		}
+		expiration = min(expiration, time.Duration(seconds)*time.Second)
+	}
+
+	return expiration, true
+}
+
+// prepare sets the validators of the page and records the request headers it varies by.
+func (p *cachedPage) prepare(req *http.Request, header http.Header) {
+	if etag := p.Header.Get("ETag"); etag != "" {
+		p.ETag = etag
+	} else {
+		sum := sha256.Sum256(p.Body)
+		p.ETag = fmt.Sprintf(`"%s"`, hex.EncodeToString(sum[:16]))
+	}
+
+	if lm, err := http.ParseTime(p.Header.Get(echo.HeaderLastModified)); err == nil {
+		p.LastModified = lm
+	}
+
+	p.Vary = make(map[string]string)
+	for _, v := range header.Values(echo.HeaderVary) {
+		for _, name := range strings.Split(v, ",") {
+			name = http.CanonicalHeaderKey(strings.TrimSpace(name))
+
+			// The key covers the Inertia headers, and the body is cached before it is compressed.
+			if name == "" || name == echo.HeaderAcceptEncoding || slices.Contains(pageCacheKeyHeaders, name) {
+				continue
+			}
+			p.Vary[name] = req.Header.Get(name)
+		}
+	}
+}
+
+// matches returns true if a request has the same values as the request the page was cached for, for all headers
+// the page varies by.
+func (p *cachedPage) matches(req *http.Request) bool {
+	for name, value := range p.Vary {
+		if req.Header.Get(name) != value {
+			return false
+		}
+	}
+	return true
+}
+
+// write writes the page, or 304 Not Modified if the request is conditional and the page was not modified.
+func (p *cachedPage) write(ctx echo.Context) error {
+	header := ctx.Response().Header()
+	for name, values := range p.Header {
+		header[name] = values
+	}
+	header.Set("ETag", p.ETag)
+	header.Set(echo.HeaderLastModified, p.LastModified.UTC().Format(http.TimeFormat))
+
+	if p.notModified(ctx.Request()) {
+		header.Del(echo.HeaderContentType)
+		header.Del(echo.HeaderContentLength)
+		return ctx.NoContent(http.StatusNotModified)
+	}
+
+	ctx.Response().WriteHeader(p.Status)
+	_, err := ctx.Response().Write(p.Body)
+	return err
+}
+
+// notModified returns true if a conditional request has the current version of the page.
+func (p *cachedPage) notModified(req *http.Request) bool {
+	if inm := req.Header.Get("If-None-Match"); inm != "" {
+		for _, etag := range strings.Split(inm, ",") {
+			etag = strings.TrimSpace(etag)
+			if etag == "*" || strings.TrimPrefix(etag, "W/") == strings.TrimPrefix(p.ETag, "W/") {
+				return true
+			}
+		}
+		return false
+	}
+
+	ims, err := http.ParseTime(req.Header.Get(echo.HeaderIfModifiedSince))
+	return err == nil && !p.LastModified.Truncate(time.Second).After(ims)
+}
+
+// WriteHeader records the status of the response.
+func (r *pageRecorder) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+}
+
+// Write buffers the body of the response.
+func (r *pageRecorder) Write(b []byte) (int, error) {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+	return r.body.Write(b)
+}
+
+// Unwrap returns the original response writer.
+func (r *pageRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
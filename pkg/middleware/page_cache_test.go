@@ -0,0 +1,271 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	appctx "github.com/occult/pagode/pkg/context"
+	"github.com/occult/pagode/pkg/services"
+	"github.com/occult/pagode/pkg/session"
+	"github.com/occult/pagode/pkg/tests"
+	inertia "github.com/romsar/gonertia/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pageCacheTest renders pages through CachePage and counts how many times the handler runs.
+type pageCacheTest struct {
+	t       *testing.T
+	renders int
+	mw      echo.MiddlewareFunc
+	respond func(ctx echo.Context) error
+}
+
+func newPageCacheTest(t *testing.T) *pageCacheTest {
+	p := &pageCacheTest{
+		t:  t,
+		mw: CachePage(c.Cache, time.Hour, "test"),
+	}
+	p.respond = func(ctx echo.Context) error {
+		return ctx.HTML(http.StatusOK, fmt.Sprintf("<p>render %d</p>", p.renders))
+	}
+	return p
+}
+
+func (p *pageCacheTest) request(url string, prepare ...func(ctx echo.Context)) *httptest.ResponseRecorder {
+	ctx, rec := tests.NewContext(c.Web, url)
+	for _, fn := range prepare {
+		fn(ctx)
+	}
+
+	err := tests.ExecuteHandler(ctx, func(ctx echo.Context) error {
+		p.renders++
+		return p.respond(ctx)
+	}, p.mw)
+	require.NoError(p.t, err)
+
+	return rec
+}
+
+func withHeader(name, value string) func(ctx echo.Context) {
+	return func(ctx echo.Context) {
+		ctx.Request().Header.Set(name, value)
+	}
+}
+
+func TestCachePage(t *testing.T) {
+	p := newPageCacheTest(t)
+
+	rec := p.request("/page-cache")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "<p>render 1</p>", rec.Body.String())
+	assert.Equal(t, echo.MIMETextHTMLCharsetUTF8, rec.Header().Get(echo.HeaderContentType))
+	etag := rec.Header().Get("ETag")
+	assert.NotEmpty(t, etag)
+	lastModified := rec.Header().Get(echo.HeaderLastModified)
+	assert.NotEmpty(t, lastModified)
+
+	// The page is served from the cache.
+	rec = p.request("/page-cache")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "<p>render 1</p>", rec.Body.String())
+	assert.Equal(t, echo.MIMETextHTMLCharsetUTF8, rec.Header().Get(echo.HeaderContentType))
+	assert.Equal(t, etag, rec.Header().Get("ETag"))
+	assert.Equal(t, 1, p.renders)
+
+	// Conditional requests
+	rec = p.request("/page-cache", withHeader("If-None-Match", etag))
+	assert.Equal(t, http.StatusNotModified, rec.Code)
+	assert.Empty(t, rec.Body.String())
+	rec = p.request("/page-cache", withHeader("If-None-Match", `"other"`))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	rec = p.request("/page-cache", withHeader(echo.HeaderIfModifiedSince, lastModified))
+	assert.Equal(t, http.StatusNotModified, rec.Code)
+	assert.Equal(t, 1, p.renders)
+
+	// Other queries, Inertia requests and partial reloads are cached separately.
+	p.request("/page-cache?page=2")
+	assert.Equal(t, 2, p.renders)
+	p.request("/page-cache", withHeader("X-Inertia", "true"))
+	assert.Equal(t, 3, p.renders)
+	p.request("/page-cache", withHeader("X-Inertia", "true"), withHeader("X-Inertia-Partial-Data", "a,b"))
+	assert.Equal(t, 4, p.renders)
+	p.request("/page-cache", withHeader("X-Inertia", "true"), withHeader("X-Inertia-Partial-Data", "b, a"))
+	assert.Equal(t, 4, p.renders)
+
+	// Authenticated users are cached separately.
+	rec = p.request("/page-cache", func(ctx echo.Context) {
+		ctx.Set(appctx.AuthenticatedUserKey, usr)
+	})
+	assert.Equal(t, "<p>render 5</p>", rec.Body.String())
+
+	// Requests with a no-cache Cache-Control header are rendered again, and cached.
+	rec = p.request("/page-cache", withHeader(echo.HeaderCacheControl, "no-cache"))
+	assert.Equal(t, "<p>render 6</p>", rec.Body.String())
+	rec = p.request("/page-cache")
+	assert.Equal(t, "<p>render 6</p>", rec.Body.String())
+
+	// Flushing the tags clears the pages.
+	err := c.Cache.Flush().Tags("test").Execute(context.Background())
+	require.NoError(t, err)
+	rec = p.request("/page-cache")
+	assert.Equal(t, "<p>render 7</p>", rec.Body.String())
+	err = c.Cache.Flush().Tags(PageCacheTag).Execute(context.Background())
+	require.NoError(t, err)
+	rec = p.request("/page-cache")
+	assert.Equal(t, "<p>render 8</p>", rec.Body.String())
+}
+
+func TestCachePage_NotCached(t *testing.T) {
+	cases := map[string]func(ctx echo.Context) error{
+		"error-status": func(ctx echo.Context) error {
+			return ctx.String(http.StatusNotFound, "missing")
+		},
+		"cookie": func(ctx echo.Context) error {
+			ctx.SetCookie(&http.Cookie{Name: "a", Value: "b"})
+			return ctx.String(http.StatusOK, "cookie")
+		},
+		"no-store": func(ctx echo.Context) error {
+			ctx.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+			return ctx.String(http.StatusOK, "no-store")
+		},
+		"private": func(ctx echo.Context) error {
+			ctx.Response().Header().Set(echo.HeaderCacheControl, "private, max-age=60")
+			return ctx.String(http.StatusOK, "private")
+		},
+		"vary": func(ctx echo.Context) error {
+			ctx.Response().Header().Set(echo.HeaderVary, "*")
+			return ctx.String(http.StatusOK, "vary")
+		},
+	}
+
+	for name, respond := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := newPageCacheTest(t)
+			p.respond = respond
+			url := "/page-cache-not-cached/" + name
+
+			first := p.request(url)
+			second := p.request(url)
+			assert.Equal(t, 2, p.renders)
+			assert.Equal(t, first.Code, second.Code)
+			assert.Equal(t, first.Body.String(), second.Body.String())
+			assert.Empty(t, second.Header().Get("ETag"))
+		})
+	}
+
+	t.Run("handler error", func(t *testing.T) {
+		p := newPageCacheTest(t)
+		p.respond = func(ctx echo.Context) error {
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+
+		ctx, _ := tests.NewContext(c.Web, "/page-cache-not-cached/error")
+		err := tests.ExecuteHandler(ctx, p.respond, p.mw)
+		tests.AssertHTTPErrorCode(t, err, http.StatusForbidden)
+	})
+
+	t.Run("flash", func(t *testing.T) {
+		p := newPageCacheTest(t)
+		withFlash := func(ctx echo.Context) {
+			props := inertia.Props{"flash": map[string][]string{"success": {"Saved"}}}
+			ctx.SetRequest(ctx.Request().WithContext(inertia.SetProps(ctx.Request().Context(), props)))
+		}
+
+		p.request("/page-cache-not-cached/flash")
+		p.request("/page-cache-not-cached/flash", withFlash)
+		assert.Equal(t, 2, p.renders)
+
+		// The page cached without the flash message is still served.
+		p.request("/page-cache-not-cached/flash")
+		assert.Equal(t, 2, p.renders)
+	})
+}
+
+func TestCachePage_Sessions(t *testing.T) {
+	p := newPageCacheTest(t)
+	p.respond = func(ctx echo.Context) error {
+		sess, err := session.Get(ctx, services.AuthSessionName)
+		if err != nil {
+			return err
+		}
+		return ctx.HTML(http.StatusOK, fmt.Sprintf("<p>%v</p>", sess.Values["name"]))
+	}
+
+	// visitor creates an anonymous session, under a given session name, holding a given name and returns its cookie.
+	visitor := func(sessionName, name string) func(ctx echo.Context) {
+		ctx, rec := tests.NewContext(c.Web, "/")
+		session.Store(ctx, c.Session)
+		sess, err := session.Get(ctx, sessionName)
+		require.NoError(t, err)
+		sess.Values["name"] = name
+		require.NoError(t, sess.Save(ctx.Request(), ctx.Response()))
+		cookies := rec.Result().Cookies()
+		require.Len(t, cookies, 1)
+
+		return func(ctx echo.Context) {
+			session.Store(ctx, c.Session)
+			ctx.Request().AddCookie(cookies[0])
+		}
+	}
+	withStore := func(ctx echo.Context) {
+		session.Store(ctx, c.Session)
+	}
+
+	// Pages showing session data are not shared between sessions.
+	rec := p.request("/page-cache-sessions", visitor(services.AuthSessionName, "a"))
+	assert.Equal(t, "<p>a</p>", rec.Body.String())
+	rec = p.request("/page-cache-sessions", visitor(services.AuthSessionName, "b"))
+	assert.Equal(t, "<p>b</p>", rec.Body.String())
+	assert.Equal(t, 2, p.renders)
+
+	// Pages without session data are cached.
+	rec = p.request("/page-cache-sessions", withStore)
+	assert.Equal(t, "<p><nil></p>", rec.Body.String())
+	rec = p.request("/page-cache-sessions", withStore)
+	assert.Equal(t, "<p><nil></p>", rec.Body.String())
+	assert.Equal(t, 3, p.renders)
+
+	// The cached page is not served to sessions either.
+	rec = p.request("/page-cache-sessions", visitor(services.AuthSessionName, "c"))
+	assert.Equal(t, "<p>c</p>", rec.Body.String())
+	assert.Equal(t, 4, p.renders)
+
+	// Only the sessions pages can show are checked.
+	rec = p.request("/page-cache-sessions", visitor("other", "d"))
+	assert.Equal(t, "<p><nil></p>", rec.Body.String())
+	assert.Equal(t, 4, p.renders)
+}
+
+func TestCachePage_Headers(t *testing.T) {
+	p := newPageCacheTest(t)
+	p.respond = func(ctx echo.Context) error {
+		ctx.Response().Header().Set(echo.HeaderVary, "Accept-Language")
+		ctx.Response().Header().Set(echo.HeaderCacheControl, "public, max-age=1")
+		ctx.Response().Header().Set("X-Custom", "value")
+		return ctx.String(http.StatusOK, ctx.Request().Header.Get("Accept-Language"))
+	}
+
+	rec := p.request("/page-cache-headers", withHeader("Accept-Language", "en"))
+	assert.Equal(t, "en", rec.Body.String())
+
+	rec = p.request("/page-cache-headers", withHeader("Accept-Language", "en"))
+	assert.Equal(t, "en", rec.Body.String())
+	assert.Equal(t, "value", rec.Header().Get("X-Custom"))
+	assert.Equal(t, 1, p.renders)
+
+	// The response varies by language.
+	rec = p.request("/page-cache-headers", withHeader("Accept-Language", "fr"))
+	assert.Equal(t, "fr", rec.Body.String())
+	assert.Equal(t, 2, p.renders)
+
+	// The expiration is capped by the max age of the response.
+	time.Sleep(1100 * time.Millisecond)
+	p.request("/page-cache-headers", withHeader("Accept-Language", "fr"))
+	assert.Equal(t, 3, p.renders)
+}
@@ -0,0 +1,271 @@
+package middleware
+
+import (
+	"context"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/occult/pagode/config"
+	"github.com/occult/pagode/ent"
+	appctx "github.com/occult/pagode/pkg/context"
+	"github.com/occult/pagode/pkg/log"
+	"github.com/occult/pagode/pkg/services"
+)
+
+// Strategies used to rate limit requests, set by config.RateLimitRule.Strategy.
+const (
+	// RateLimitTokenBucket allows bursts of requests up to the limit, after which the limit is replenished evenly over
+	// the period.
+	RateLimitTokenBucket = "tokenBucket"
+
+	// RateLimitSlidingWindow allows requests up to the limit within any window as long as the period. The amount of
+	// requests within the window is approximated from the amounts within the current and previous fixed windows.
+	RateLimitSlidingWindow = "slidingWindow"
+)
+
+// Keys requests are counted by, set by config.RateLimitRule.Key.
+const (
+	// RateLimitByIP counts requests by IP address. Proxy headers are only used to determine it for requests from
+	// the trusted proxies set by config.HTTPConfig.TrustedProxies, so clients cannot forge another one.
+	RateLimitByIP = "ip"
+
+	// RateLimitByUser counts requests by authenticated user.
+	RateLimitByUser = "user"
+
+	// RateLimitByToken counts requests by the access token they are authenticated with, or else by user.
+	RateLimitByToken = "token"
+)
+
+// rateLimitCacheGroup is the cache group the state of rate limits is stored in.
+const rateLimitCacheGroup = "ratelimit"
+
+// rateLimitAttempts is how many times counting a request is attempted while the state of its limit keeps being
+// changed by other requests.
+const rateLimitAttempts = 10
+
+type (
+	// rateLimitResult is the outcome of counting a request against a rate limit.
+	rateLimitResult struct {
+		allowed   bool
+		remaining int
+		// reset is how long until the limit is fully replenished.
+		reset time.Duration
+		// retryAfter is how long until a request which was not allowed would be.
+		retryAfter time.Duration
+	}
+
+	// tokenBucketState is the state of a rate limit using the token bucket strategy.
+	tokenBucketState struct {
+		Tokens  float64
+		Updated time.Time
+	}
+
+	// slidingWindowState is the state of a rate limit using the sliding window strategy.
+	slidingWindowState struct {
+		Window   time.Time
+		Count    int
+		Previous int
+	}
+
+	// rateLimitStrategy counts a request made at a given time against the state of a rate limit, if any, and
+	// returns the new state.
+	rateLimitStrategy func(rule config.RateLimitRule, now time.Time, state any) (any, rateLimitResult)
+)
+
+func init() {
+	// Cache stores which keep values outside the app must be able to encode the state of rate limits.
+	gob.Register(tokenBucketState{})
+	gob.Register(slidingWindowState{})
+}
+
+// RateLimit limits how many requests can be made according to a given rule, responding with 429 Too Many Requests
+// once the limit is exceeded, and sets the RateLimit headers of the IETF draft on all responses.
+// Requests are counted under a given name, so routes using the same name share their limit. The state of each limit
+// is stored in the cache, so it works with any cache store. If the cache fails, requests are not limited.
+// This must run after LoadAuthenticatedUser and LoadAccessToken for requests to be counted by user or token.
+func RateLimit(cache *services.CacheClient, name string, rule config.RateLimitRule) echo.MiddlewareFunc {
+	if rule.Limit <= 0 {
+		return func(next echo.HandlerFunc) echo.HandlerFunc {
+			return next
+		}
+	}
+
+	var strategy rateLimitStrategy
+	switch rule.Strategy {
+	case RateLimitTokenBucket:
+		strategy = tokenBucket
+	case RateLimitSlidingWindow:
+		strategy = slidingWindow
+	default:
+		panic(fmt.Sprintf("invalid rate limit strategy for %s: %s", name, rule.Strategy))
+	}
+
+	switch rule.Key {
+	case RateLimitByIP, RateLimitByUser, RateLimitByToken:
+	default:
+		panic(fmt.Sprintf("invalid rate limit key for %s: %s", name, rule.Key))
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			key := fmt.Sprintf("%s:%s", name, rateLimitKey(c, rule.Key))
+
+			result, err := countRateLimit(c.Request().Context(), cache, key, rule, strategy)
+			if err != nil {
+				log.Ctx(c).Error("unable to count request against rate limit", "key", key, "error", err)
+				return next(c)
+			}
+
+			h := c.Response().Header()
+			h.Set("RateLimit-Limit", strconv.Itoa(rule.Limit))
+			h.Set("RateLimit-Remaining", strconv.Itoa(result.remaining))
+			h.Set("RateLimit-Reset", rateLimitSeconds(result.reset))
+			h.Set("RateLimit-Policy", fmt.Sprintf("%d;w=%s", rule.Limit, rateLimitSeconds(rule.Period)))
+
+			if !result.allowed {
+				h.Set(echo.HeaderRetryAfter, rateLimitSeconds(result.retryAfter))
+				return echo.NewHTTPError(http.StatusTooManyRequests, "Too many requests. Please try again later.")
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// countRateLimit counts a request against the state of a rate limit of a given key. The state is swapped rather than
+// set, so requests made at the same time, including those handled by other instances sharing the cache, are all
+// counted. If the state was changed by another request in the meantime, the request is counted again.
+func countRateLimit(
+	ctx context.Context,
+	cache *services.CacheClient,
+	key string,
+	rule config.RateLimitRule,
+	strategy rateLimitStrategy,
+) (rateLimitResult, error) {
+	for range rateLimitAttempts {
+		state, err := cache.
+			Get().
+			Group(rateLimitCacheGroup).
+			Key(key).
+			Fetch(ctx)
+		switch {
+		case errors.Is(err, services.ErrCacheMiss):
+			state = nil
+		case err != nil:
+			return rateLimitResult{}, err
+		}
+
+		next, result := strategy(rule, time.Now(), state)
+
+		// Both strategies start afresh once no requests have been made for two periods.
+		swapped, err := cache.
+			Set().
+			Group(rateLimitCacheGroup).
+			Key(key).
+			Data(next).
+			Expiration(2*rule.Period).
+			Swap(ctx, state)
+		if err != nil || swapped {
+			return result, err
+		}
+	}
+
+	// The limit is this contended only if it is being hammered, so the request is not allowed.
+	return rateLimitResult{retryAfter: time.Second}, nil
+}
+
+// rateLimitKey returns the key a request is counted by. The IP address comes from the IP extractor of the web
+// server, which only trusts proxy headers set by trusted proxies.
+func rateLimitKey(c echo.Context, key string) string {
+	switch key {
+	case RateLimitByToken:
+		if t, ok := c.Get(appctx.AccessTokenKey).(*ent.AccessToken); ok {
+			return fmt.Sprintf("token:%d", t.ID)
+		}
+		fallthrough
+	case RateLimitByUser:
+		if u, ok := c.Get(appctx.AuthenticatedUserKey).(*ent.User); ok {
+			return fmt.Sprintf("user:%d", u.ID)
+		}
+	}
+
+	return fmt.Sprintf("ip:%s", c.RealIP())
+}
+
+// rateLimitSeconds formats a duration as a whole amount of seconds, rounded up, for use in headers.
+func rateLimitSeconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
+
+// tokenBucket counts a request using the token bucket strategy.
+func tokenBucket(rule config.RateLimitRule, now time.Time, state any) (any, rateLimitResult) {
+	limit := float64(rule.Limit)
+	rate := limit / rule.Period.Seconds()
+
+	s, ok := state.(tokenBucketState)
+	if !ok {
+		s = tokenBucketState{Tokens: limit, Updated: now}
+	}
+
+	s.Tokens = min(limit, s.Tokens+now.Sub(s.Updated).Seconds()*rate)
+	s.Updated = now
+
+	var result rateLimitResult
+	if s.Tokens >= 1 {
+		s.Tokens--
+		result.allowed = true
+	} else {
+		result.retryAfter = time.Duration((1 - s.Tokens) / rate * float64(time.Second))
+	}
+
+	result.remaining = int(s.Tokens)
+	result.reset = time.Duration((limit - s.Tokens) / rate * float64(time.Second))
+
+	return s, result
+}
+
+// slidingWindow counts a request using the sliding window strategy.
+func slidingWindow(rule config.RateLimitRule, now time.Time, state any) (any, rateLimitResult) {
+	window := now.Truncate(rule.Period)
+
+	s, _ := state.(slidingWindowState)
+	switch {
+	case s.Window.Equal(window):
+	case s.Window.Equal(window.Add(-rule.Period)):
+		s = slidingWindowState{Window: window, Previous: s.Count}
+	default:
+		s = slidingWindowState{Window: window}
+	}
+
+	// The requests of the previous window are weighted by how much of it overlaps with the sliding window.
+	elapsed := now.Sub(window)
+	weight := 1 - elapsed.Seconds()/rule.Period.Seconds()
+	count := float64(s.Previous)*weight + float64(s.Count)
+
+	result := rateLimitResult{
+		reset: rule.Period - elapsed,
+	}
+
+	if count+1 <= float64(rule.Limit) {
+		s.Count++
+		count++
+		result.allowed = true
+	} else {
+		result.retryAfter = result.reset
+		if s.Count < rule.Limit && s.Previous > 0 {
+			// Wait until enough requests of the previous window have slid out of the window.
+			w := float64(rule.Limit-1-s.Count) / float64(s.Previous)
+			result.retryAfter = time.Duration((1-w)*float64(rule.Period)) - elapsed
+		}
+	}
+
+	result.remaining = max(0, rule.Limit-int(math.Ceil(count)))
+
+	return s, result
+}
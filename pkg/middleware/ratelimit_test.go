@@ -0,0 +1,163 @@
+package middleware
+
+import (
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/occult/pagode/config"
+	"github.com/occult/pagode/pkg/context"
+	"github.com/occult/pagode/pkg/tests"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimit(t *testing.T) {
+	rule := config.RateLimitRule{
+		Strategy: RateLimitSlidingWindow,
+		Key:      RateLimitByUser,
+		Limit:    2,
+		Period:   time.Hour,
+	}
+	mw := RateLimit(c.Cache, "test", rule)
+
+	request := func(ip string, authenticated bool) (echo.Context, error) {
+		ctx, _ := tests.NewContext(c.Web, "/")
+		ctx.Request().RemoteAddr = ip + ":1234"
+		if authenticated {
+			ctx.Set(context.AuthenticatedUserKey, usr)
+		}
+		return ctx, tests.ExecuteMiddleware(ctx, mw)
+	}
+
+	ctx, err := request("1.1.1.1", true)
+	require.NoError(t, err)
+	assert.Equal(t, "2", ctx.Response().Header().Get("RateLimit-Limit"))
+	assert.Equal(t, "1", ctx.Response().Header().Get("RateLimit-Remaining"))
+	assert.Equal(t, "2;w=3600", ctx.Response().Header().Get("RateLimit-Policy"))
+	assert.NotEmpty(t, ctx.Response().Header().Get("RateLimit-Reset"))
+
+	// Requests by the same user are counted together, regardless of their IP address.
+	ctx, err = request("2.2.2.2", true)
+	require.NoError(t, err)
+	assert.Equal(t, "0", ctx.Response().Header().Get("RateLimit-Remaining"))
+
+	ctx, err = request("3.3.3.3", true)
+	tests.AssertHTTPErrorCode(t, err, http.StatusTooManyRequests)
+	assert.NotEmpty(t, ctx.Response().Header().Get(echo.HeaderRetryAfter))
+
+	// Requests which are not authenticated are counted by IP address.
+	_, err = request("1.1.1.1", false)
+	require.NoError(t, err)
+
+	// Forged proxy headers do not count as another IP address.
+	for i, ip := range []string{"5.5.5.5", "6.6.6.6"} {
+		ctx, _ = tests.NewContext(c.Web, "/")
+		ctx.Request().RemoteAddr = "1.1.1.1:1234"
+		ctx.Request().Header.Set(echo.HeaderXForwardedFor, ip)
+		ctx.Request().Header.Set(echo.HeaderXRealIP, ip)
+		err = tests.ExecuteMiddleware(ctx, mw)
+		if i == 0 {
+			require.NoError(t, err)
+		} else {
+			tests.AssertHTTPErrorCode(t, err, http.StatusTooManyRequests)
+		}
+	}
+
+	// Routes are not limited without a limit.
+	ctx, _ = tests.NewContext(c.Web, "/")
+	require.NoError(t, tests.ExecuteMiddleware(ctx, RateLimit(c.Cache, "test", config.RateLimitRule{})))
+	assert.Empty(t, ctx.Response().Header().Get("RateLimit-Limit"))
+}
+
+func TestRateLimit_Concurrent(t *testing.T) {
+	rule := config.RateLimitRule{
+		Strategy: RateLimitTokenBucket,
+		Key:      RateLimitByIP,
+		Limit:    10,
+		Period:   time.Hour,
+	}
+	mw := RateLimit(c.Cache, "concurrent", rule)
+
+	// Requests made at the same time are all counted.
+	var allowed atomic.Int32
+	var wg sync.WaitGroup
+	for range 30 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx, _ := tests.NewContext(c.Web, "/")
+			ctx.Request().RemoteAddr = "4.4.4.4:1234"
+			if tests.ExecuteMiddleware(ctx, mw) == nil {
+				allowed.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(rule.Limit), allowed.Load())
+}
+
+func TestRateLimit_TokenBucket(t *testing.T) {
+	rule := config.RateLimitRule{Limit: 2, Period: time.Minute}
+	now := time.Now()
+
+	// Bursts are allowed up to the limit.
+	state, result := tokenBucket(rule, now, nil)
+	assert.True(t, result.allowed)
+	assert.Equal(t, 1, result.remaining)
+	assert.Equal(t, 30*time.Second, result.reset)
+
+	state, result = tokenBucket(rule, now, state)
+	assert.True(t, result.allowed)
+	assert.Equal(t, 0, result.remaining)
+
+	state, result = tokenBucket(rule, now, state)
+	assert.False(t, result.allowed)
+	assert.Equal(t, 30*time.Second, result.retryAfter)
+
+	// The limit is replenished evenly over the period.
+	state, result = tokenBucket(rule, now.Add(30*time.Second), state)
+	assert.True(t, result.allowed)
+	assert.Equal(t, 0, result.remaining)
+
+	_, result = tokenBucket(rule, now.Add(10*time.Minute), state)
+	assert.True(t, result.allowed)
+	assert.Equal(t, 1, result.remaining)
+}
+
+func TestRateLimit_SlidingWindow(t *testing.T) {
+	rule := config.RateLimitRule{Limit: 2, Period: time.Minute}
+	window := time.Now().Truncate(time.Minute)
+
+	state, result := slidingWindow(rule, window, nil)
+	assert.True(t, result.allowed)
+	assert.Equal(t, 1, result.remaining)
+	assert.Equal(t, time.Minute, result.reset)
+
+	state, result = slidingWindow(rule, window.Add(30*time.Second), state)
+	assert.True(t, result.allowed)
+	assert.Equal(t, 0, result.remaining)
+
+	state, result = slidingWindow(rule, window.Add(45*time.Second), state)
+	assert.False(t, result.allowed)
+	assert.Equal(t, 15*time.Second, result.retryAfter)
+
+	// Half of the previous window overlaps with the sliding window, so it counts as one request.
+	state, result = slidingWindow(rule, window.Add(90*time.Second), state)
+	assert.True(t, result.allowed)
+	assert.Equal(t, 0, result.remaining)
+
+	_, result = slidingWindow(rule, window.Add(95*time.Second), state)
+	assert.False(t, result.allowed)
+
+	// Windows older than the previous one are not counted.
+	_, result = slidingWindow(rule, window.Add(5*time.Minute), state)
+	assert.True(t, result.allowed)
+	assert.Equal(t, 1, result.remaining)
+}
@@ -25,8 +25,8 @@ const (
 )
 
 const (
-	// sessionName stores the name of the session which contains flash messages.
-	sessionName = "msg"
+	// SessionName stores the name of the session which contains flash messages.
+	SessionName = "msg"
 )
 
 // Success sets a success flash message.
@@ -80,7 +80,7 @@ func Get(ctx echo.Context, typ Type) []string {
 
 // getSession gets the flash message session.
 func getSession(ctx echo.Context) (*sessions.Session, error) {
-	sess, err := session.Get(ctx, sessionName)
+	sess, err := session.Get(ctx, SessionName)
 	if err != nil {
 		log.Ctx(ctx).Error("cannot load flash message session",
 			"error", err,
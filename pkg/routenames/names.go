@@ -5,54 +5,102 @@ import (
 )
 
 const (
-	Home                  = "home"
-	Welcome               = "welcome"
-	Dashboard             = "dashboard"
-	AdminDashboard        = "admin_dashboard"
-	AdminUserAdd          = "admin_user_add"
-	AdminUserEdit         = "admin_user_edit"
-	AdminUserDelete       = "admin_user_delete"
-	About                 = "about"
-	Contact               = "contact"
-	ContactSubmit         = "contact.submit"
-	Login                 = "login"
-	LoginSubmit           = "login.submit"
-	Register              = "register"
-	RegisterSubmit        = "register.submit"
-	ForgotPassword        = "forgot_password"
-	ForgotPasswordSubmit  = "forgot_password.submit"
-	Logout                = "logout"
-	VerifyEmail           = "verify_email"
-	ResetPassword         = "reset_password"
-	ResetPasswordSubmit   = "reset_password.submit"
-	Search                = "search"
-	Task                  = "task"
-	TaskSubmit            = "task.submit"
-	Cache                 = "cache"
-	CacheSubmit           = "cache.submit"
-	Files                 = "files"
-	FilesSubmit           = "files.submit"
-	AdminTasks            = "admin:tasks"
-	ProfileEdit           = "profile.edit"
-	ProfileUpdate         = "profile.update"
-	ProfileDestroy        = "profile.destroy"
-	ProfileAppearance     = "profile.appearance"
-	ProfilePassword       = "profile.password"
-	ProfileUpdatePassword = "profile.update_password"
-	Plans                 = "plans"
-	PlansSubscribe        = "plans.subscribe"
-	Products              = "products"
-	ProductsPurchase      = "products.purchase"
-	Premium               = "premium"
-	Billing               = "billing"
-	BillingCancel         = "billing.cancel"
-	ChatRooms             = "chat.rooms"
-	ChatRoomCreate        = "chat.rooms.create"
-	ChatRoom              = "chat.room"
-	ChatWebSocket         = "chat.websocket"
-	ChatBanUser           = "chat.ban"
-	ChatUnbanUser         = "chat.unban"
-	ChatDeleteRoom        = "chat.room.delete"
+	Home                          = "home"
+	Welcome                       = "welcome"
+	Dashboard                     = "dashboard"
+	AdminDashboard                = "admin_dashboard"
+	AdminUserAdd                  = "admin_user_add"
+	AdminUserEdit                 = "admin_user_edit"
+	AdminUserDelete               = "admin_user_delete"
+	AdminUserUnlock               = "admin_user_unlock"
+	AdminUserRoles                = "admin_user_roles"
+	AdminUserImpersonate          = "admin_user_impersonate"
+	ImpersonationStop             = "impersonation.stop"
+	About                         = "about"
+	Contact                       = "contact"
+	ContactSubmit                 = "contact.submit"
+	Login                         = "login"
+	LoginSubmit                   = "login.submit"
+	LoginLink                     = "login_link"
+	LoginLinkSubmit               = "login_link.submit"
+	LoginLinkConfirm              = "login_link.confirm"
+	LoginLinkConfirmSubmit        = "login_link.confirm.submit"
+	TwoFactorChallenge            = "two_factor_challenge"
+	TwoFactorChallengeSubmit      = "two_factor_challenge.submit"
+	OAuthRedirect                 = "oauth.redirect"
+	OAuthCallback                 = "oauth.callback"
+	Register                      = "register"
+	RegisterSubmit                = "register.submit"
+	ForgotPassword                = "forgot_password"
+	ForgotPasswordSubmit          = "forgot_password.submit"
+	Logout                        = "logout"
+	VerifyEmail                   = "verify_email"
+	ConfirmEmailChange            = "confirm_email_change"
+	ResetPassword                 = "reset_password"
+	ResetPasswordSubmit           = "reset_password.submit"
+	Search                        = "search"
+	Task                          = "task"
+	TaskSubmit                    = "task.submit"
+	Cache                         = "cache"
+	CacheSubmit                   = "cache.submit"
+	Files                         = "files"
+	FilesSubmit                   = "files.submit"
+	AdminTasks                    = "admin:tasks"
+	AdminPaymentEvents            = "admin:payment_events"
+	AdminPaymentEventReplay       = "admin:payment_events.replay"
+	AdminPaymentEventReplayFailed = "admin:payment_events.replay_failed"
+	AdminAuditLog                 = "admin:audit_log"
+	AdminCache                    = "admin:cache"
+	AdminCacheFlushPages          = "admin:cache.flush_pages"
+	ProfileEdit                   = "profile.edit"
+	ProfileUpdate                 = "profile.update"
+	ProfileEmailChangeCancel      = "profile.email_change.cancel"
+	ProfileDestroy                = "profile.destroy"
+	ProfileDataExport             = "profile.data_export"
+	ProfileDataExportDownload     = "profile.data_export.download"
+	ProfileAppearance             = "profile.appearance"
+	ProfilePassword               = "profile.password"
+	ProfileUpdatePassword         = "profile.update_password"
+	ProfileTwoFactor              = "profile.two_factor"
+	ProfileTwoFactorEnable        = "profile.two_factor.enable"
+	ProfileTwoFactorDisable       = "profile.two_factor.disable"
+	ProfileTwoFactorRecoveryCodes = "profile.two_factor.recovery_codes"
+	ProfileSessions               = "profile.sessions"
+	ProfileSessionRevoke          = "profile.sessions.revoke"
+	ProfileSessionsRevokeAll      = "profile.sessions.revoke_all"
+	ProfilePasskeys               = "profile.passkeys"
+	ProfilePasskeyDelete          = "profile.passkeys.delete"
+	PasskeyRegisterOptions        = "passkeys.register.options"
+	PasskeyRegisterSubmit         = "passkeys.register.submit"
+	LoginPasskeyOptions           = "login.passkey.options"
+	LoginPasskeySubmit            = "login.passkey.submit"
+	ProfileAccessTokens           = "profile.tokens"
+	ProfileAccessTokenCreate      = "profile.tokens.create"
+	ProfileAccessTokenRevoke      = "profile.tokens.revoke"
+	Organizations                 = "organizations"
+	OrganizationCreate            = "organizations.create"
+	OrganizationSwitch            = "organizations.switch"
+	Organization                  = "organizations.show"
+	OrganizationInvite            = "organizations.invite"
+	OrganizationInvitationRevoke  = "organizations.invitations.revoke"
+	OrganizationMemberRemove      = "organizations.members.remove"
+	Invitation                    = "invitation"
+	InvitationAccept              = "invitation.accept"
+	Plans                         = "plans"
+	PlansSubscribe                = "plans.subscribe"
+	Products                      = "products"
+	ProductsPurchase              = "products.purchase"
+	Premium                       = "premium"
+	Billing                       = "billing"
+	BillingCancel                 = "billing.cancel"
+	ChatRooms                     = "chat.rooms"
+	ChatRoomCreate                = "chat.rooms.create"
+	ChatRoom                      = "chat.room"
+	ChatWebSocket                 = "chat.websocket"
+	ChatBanUser                   = "chat.ban"
+	ChatUnbanUser                 = "chat.unban"
+	ChatDeleteRoom                = "chat.room.delete"
+	Webhook                       = "webhook"
 )
 
 func AdminEntityList(entityTypeName string) string {
@@ -0,0 +1,331 @@
+package services
+
+import (
+	"context"
+	"reflect"
+	"slices"
+
+	entgo "entgo.io/ent"
+	"entgo.io/ent/entc/gen"
+	"github.com/labstack/echo/v4"
+	"github.com/occult/pagode/config"
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/accesstoken"
+	"github.com/occult/pagode/ent/auditevent"
+	"github.com/occult/pagode/ent/credential"
+	"github.com/occult/pagode/ent/identity"
+	"github.com/occult/pagode/ent/user"
+	"github.com/occult/pagode/pkg/log"
+)
+
+// Actions recorded in the audit log.
+const (
+	// AuditActionCreate is recorded when an entity is created
+	AuditActionCreate = "create"
+
+	// AuditActionUpdate is recorded when an entity is updated
+	AuditActionUpdate = "update"
+
+	// AuditActionDelete is recorded when an entity is deleted
+	AuditActionDelete = "delete"
+
+	// AuditActionImpersonationStart is recorded when an admin starts impersonating a user
+	AuditActionImpersonationStart = "impersonation.start"
+
+	// AuditActionImpersonationStop is recorded when an admin stops impersonating a user
+	AuditActionImpersonationStop = "impersonation.stop"
+)
+
+// auditRedacted replaces the values of sensitive fields in the changes recorded in the audit log
+const auditRedacted = "[redacted]"
+
+// auditActivityFields stores the fields of each entity type which only track activity, such as when an entity was
+// last used. They are left out of the changes recorded for updates, so using an entity does not flood the audit log.
+var auditActivityFields = map[string][]string{
+	ent.TypeAccessToken: {accesstoken.FieldLastUsedAt},
+	ent.TypeCredential:  {credential.FieldSignCount, credential.FieldLastUsedAt},
+	ent.TypeIdentity:    {identity.FieldLastLoginAt},
+	ent.TypeUser:        {user.FieldTotpLastStep},
+}
+
+type auditContextKey string
+
+// auditActorKey is the key used to store the actor in the context of mutations
+const auditActorKey = auditContextKey("audit_actor")
+
+// AuditActor stores who is performing the changes made with a context, which is recorded along with them
+type AuditActor struct {
+	// UserID is the ID of the authenticated user, if any
+	UserID int
+
+	// ImpersonatorID is the ID of the admin impersonating the authenticated user, if any
+	ImpersonatorID int
+
+	IPAddress string
+	RequestID string
+}
+
+// WithAuditActor returns a copy of a given context with which changes are recorded as performed by a given actor
+func WithAuditActor(ctx context.Context, actor AuditActor) context.Context {
+	return context.WithValue(ctx, auditActorKey, actor)
+}
+
+// AuditFilter stores the criteria used to filter the audit log, where zero values match all events
+type AuditFilter struct {
+	Action     string
+	EntityType string
+	EntityID   int
+	ActorID    int
+}
+
+// AuditClient records sensitive actions and changes to entities, along with who performed them, in the audit log
+type AuditClient struct {
+	orm *ent.Client
+
+	// entities stores the entity types whose mutations are recorded, along with their sensitive fields
+	entities map[string][]string
+}
+
+// NewAuditClient creates a new AuditClient which records the mutations of the entity types in configuration.
+// The entity graph provides which fields are sensitive, so their values are never recorded.
+func NewAuditClient(cfg *config.Config, orm *ent.Client, graph *gen.Graph) *AuditClient {
+	c := &AuditClient{
+		orm:      orm,
+		entities: make(map[string][]string),
+	}
+
+	for _, n := range graph.Nodes {
+		if n.Name == ent.TypeAuditEvent || !slices.Contains(cfg.Audit.Entities, n.Name) {
+			continue
+		}
+
+		sensitive := make([]string, 0)
+		for _, f := range n.Fields {
+			if f.Sensitive() {
+				sensitive = append(sensitive, f.Name)
+			}
+		}
+		c.entities[n.Name] = sensitive
+	}
+
+	return c
+}
+
+// Record records that the user of a given ID performed an action on the entity of a given type and ID
+func (c *AuditClient) Record(ctx echo.Context, action string, actorID int, entityType string, entityID int) error {
+	return c.orm.AuditEvent.
+		Create().
+		SetAction(action).
+		SetActorID(actorID).
+		SetEntityType(entityType).
+		SetEntityID(entityID).
+		SetIPAddress(ctx.RealIP()).
+		SetRequestID(ctx.Response().Header().Get(echo.HeaderXRequestID)).
+		Exec(ctx.Request().Context())
+}
+
+// GetEvents returns a page of the events in the audit log which match a given filter, most recent first, along
+// with the total amount of matching events
+func (c *AuditClient) GetEvents(ctx echo.Context, filter AuditFilter, limit, offset int) ([]*ent.AuditEvent, int, error) {
+	q := c.orm.AuditEvent.Query()
+
+	if filter.Action != "" {
+		q.Where(auditevent.Action(filter.Action))
+	}
+	if filter.EntityType != "" {
+		q.Where(auditevent.EntityType(filter.EntityType))
+	}
+	if filter.EntityID != 0 {
+		q.Where(auditevent.EntityID(filter.EntityID))
+	}
+	if filter.ActorID != 0 {
+		q.Where(auditevent.Or(
+			auditevent.ActorID(filter.ActorID),
+			auditevent.ImpersonatorID(filter.ActorID),
+		))
+	}
+
+	total, err := q.Clone().Count(ctx.Request().Context())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	events, err := q.
+		Order(ent.Desc(auditevent.FieldID)).
+		Limit(limit).
+		Offset(offset).
+		All(ctx.Request().Context())
+
+	return events, total, err
+}
+
+// GetEntityTypes returns the entity types whose mutations are recorded in the audit log
+func (c *AuditClient) GetEntityTypes() []string {
+	types := make([]string, 0, len(c.entities))
+	for t := range c.entities {
+		types = append(types, t)
+	}
+	slices.Sort(types)
+	return types
+}
+
+// Hook returns an ent hook which records the creation, changes and deletion of the entity types being audited,
+// attributed to the actor stored in the context of the mutation, if any. Events are recorded with the client of
+// the mutation, so they are part of the same transaction.
+func (c *AuditClient) Hook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			sensitive, ok := c.entities[m.Type()]
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+
+			var action string
+			var ids []int
+			var changes map[string]any
+			var err error
+
+			// Determine which entities are affected and how before they are changed.
+			switch {
+			case m.Op().Is(entgo.OpCreate):
+				action = AuditActionCreate
+				changes = auditChanges(ctx, m, sensitive, false)
+			case m.Op().Is(entgo.OpUpdateOne):
+				action = AuditActionUpdate
+				changes = auditChanges(ctx, m, sensitive, true)
+			case m.Op().Is(entgo.OpUpdate):
+				action = AuditActionUpdate
+				changes = auditChanges(ctx, m, sensitive, false)
+			default:
+				action = AuditActionDelete
+			}
+
+			if action == AuditActionUpdate {
+				for _, name := range auditActivityFields[m.Type()] {
+					delete(changes, name)
+				}
+			}
+
+			if action != AuditActionCreate {
+				if ids, err = auditMutationIDs(ctx, m); err != nil {
+					return nil, err
+				}
+			}
+
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+
+			if im, ok := m.(interface{ ID() (int, bool) }); ok && action == AuditActionCreate {
+				if id, ok := im.ID(); ok {
+					ids = []int{id}
+				}
+			}
+
+			// Updates which did not change any field are not worth recording.
+			if action == AuditActionUpdate && len(changes) == 0 {
+				return v, nil
+			}
+
+			for _, id := range ids {
+				if err := c.record(ctx, m, action, id, changes); err != nil {
+					// The mutation has already been made, so it is not reported as failed.
+					log.Default().Error("unable to record audit event",
+						"action", action,
+						"entity_type", m.Type(),
+						"entity_id", id,
+						"error", err,
+					)
+				}
+			}
+
+			return v, nil
+		})
+	}
+}
+
+// record records that a mutation performed an action on the entity of a given ID.
+func (c *AuditClient) record(ctx context.Context, m ent.Mutation, action string, id int, changes map[string]any) error {
+	client := c.orm
+	if mc, ok := m.(interface{ Client() *ent.Client }); ok {
+		client = mc.Client()
+	}
+
+	create := client.AuditEvent.
+		Create().
+		SetAction(action).
+		SetEntityType(m.Type()).
+		SetEntityID(id)
+
+	if len(changes) > 0 {
+		create.SetChanges(changes)
+	}
+
+	if actor, ok := ctx.Value(auditActorKey).(AuditActor); ok {
+		if actor.UserID != 0 {
+			create.SetActorID(actor.UserID)
+		}
+		if actor.ImpersonatorID != 0 {
+			create.SetImpersonatorID(actor.ImpersonatorID)
+		}
+		create.
+			SetIPAddress(actor.IPAddress).
+			SetRequestID(actor.RequestID)
+	}
+
+	return create.Exec(ctx)
+}
+
+// auditMutationIDs returns the IDs of the entities a mutation will update or delete.
+func auditMutationIDs(ctx context.Context, m ent.Mutation) ([]int, error) {
+	im, ok := m.(interface {
+		IDs(context.Context) ([]int, error)
+	})
+	if !ok {
+		return nil, nil
+	}
+	return im.IDs(ctx)
+}
+
+// auditChanges returns the fields a mutation sets or clears, keyed by field name, with their new values and,
+// if requested, their old values. Fields whose value does not change are omitted and the values of sensitive
+// fields are redacted.
+func auditChanges(ctx context.Context, m ent.Mutation, sensitive []string, withOld bool) map[string]any {
+	changes := make(map[string]any)
+
+	change := func(name string, value ent.Value, cleared bool) {
+		entry := map[string]any{"new": value}
+
+		if withOld {
+			old, err := m.OldField(ctx, name)
+			if err == nil {
+				if !cleared && reflect.DeepEqual(old, value) {
+					return
+				}
+				entry["old"] = old
+			}
+		}
+
+		if slices.Contains(sensitive, name) {
+			for k, v := range entry {
+				if v != nil {
+					entry[k] = auditRedacted
+				}
+			}
+		}
+
+		changes[name] = entry
+	}
+
+	for _, name := range m.Fields() {
+		value, _ := m.Field(name)
+		change(name, value, false)
+	}
+
+	for _, name := range m.ClearedFields() {
+		change(name, nil, true)
+	}
+
+	return changes
+}
@@ -0,0 +1,172 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/auditevent"
+	"github.com/occult/pagode/pkg/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditClient_Record(t *testing.T) {
+	err := c.Audit.Record(ctx, AuditActionImpersonationStart, usr.ID, "User", 123)
+	require.NoError(t, err)
+
+	event, err := c.ORM.AuditEvent.
+		Query().
+		Where(auditevent.Action(AuditActionImpersonationStart)).
+		Where(auditevent.EntityID(123)).
+		Only(ctx.Request().Context())
+	require.NoError(t, err)
+	require.NotNil(t, event.ActorID)
+	assert.Equal(t, usr.ID, *event.ActorID)
+	assert.Equal(t, "User", event.EntityType)
+	assert.NotZero(t, event.CreatedAt)
+}
+
+func TestAuditClient_Hook(t *testing.T) {
+	actor := AuditActor{
+		UserID:         usr.ID,
+		ImpersonatorID: 456,
+		IPAddress:      "1.2.3.4",
+		RequestID:      "abc",
+	}
+	actx := WithAuditActor(context.Background(), actor)
+
+	events := func(u *ent.User, action string) []*ent.AuditEvent {
+		all, err := c.ORM.AuditEvent.
+			Query().
+			Where(auditevent.EntityType(ent.TypeUser)).
+			Where(auditevent.EntityID(u.ID)).
+			Where(auditevent.Action(action)).
+			All(context.Background())
+		require.NoError(t, err)
+		return all
+	}
+
+	u, err := c.ORM.User.
+		Create().
+		SetName("Audited").
+		SetEmail("audited@localhost.localhost").
+		SetPassword("password").
+		Save(actx)
+	require.NoError(t, err)
+
+	t.Run("create", func(t *testing.T) {
+		created := events(u, AuditActionCreate)
+		require.Len(t, created, 1)
+		e := created[0]
+		require.NotNil(t, e.ActorID)
+		assert.Equal(t, usr.ID, *e.ActorID)
+		require.NotNil(t, e.ImpersonatorID)
+		assert.Equal(t, 456, *e.ImpersonatorID)
+		assert.Equal(t, "1.2.3.4", e.IPAddress)
+		assert.Equal(t, "abc", e.RequestID)
+		assert.Equal(t, map[string]any{"new": "Audited"}, e.Changes["name"])
+		assert.Equal(t, map[string]any{"new": auditRedacted}, e.Changes["password"])
+	})
+
+	t.Run("update", func(t *testing.T) {
+		err := u.Update().
+			SetName("Renamed").
+			SetPassword("password2").
+			Exec(actx)
+		require.NoError(t, err)
+
+		updated := events(u, AuditActionUpdate)
+		require.Len(t, updated, 1)
+		assert.Equal(t, map[string]any{"old": "Audited", "new": "Renamed"}, updated[0].Changes["name"])
+		assert.Equal(t, map[string]any{"old": auditRedacted, "new": auditRedacted}, updated[0].Changes["password"])
+		assert.NotContains(t, updated[0].Changes, "email")
+
+		// Updates which change nothing are not recorded.
+		err = c.ORM.User.UpdateOneID(u.ID).SetName("Renamed").Exec(actx)
+		require.NoError(t, err)
+		assert.Len(t, events(u, AuditActionUpdate), 1)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		err := c.ORM.User.DeleteOneID(u.ID).Exec(context.Background())
+		require.NoError(t, err)
+
+		deleted := events(u, AuditActionDelete)
+		require.Len(t, deleted, 1)
+		assert.Nil(t, deleted[0].ActorID)
+		assert.Empty(t, deleted[0].Changes)
+	})
+
+	t.Run("not audited", func(t *testing.T) {
+		assert.NotContains(t, c.Audit.GetEntityTypes(), ent.TypeAuditEvent)
+		assert.Contains(t, c.Audit.GetEntityTypes(), ent.TypeUser)
+	})
+}
+
+func TestAuditClient_Hook_Activity(t *testing.T) {
+	token, at, err := c.Auth.CreateAccessToken(ctx, usr.ID, "Audited", nil, nil)
+	require.NoError(t, err)
+
+	updates := func() []*ent.AuditEvent {
+		all, err := c.ORM.AuditEvent.
+			Query().
+			Where(auditevent.EntityType(ent.TypeAccessToken)).
+			Where(auditevent.EntityID(at.ID)).
+			Where(auditevent.Action(AuditActionUpdate)).
+			All(context.Background())
+		require.NoError(t, err)
+		return all
+	}
+
+	// Using the token only records when it was last used, which is not recorded.
+	at, err = c.Auth.AuthenticateAccessToken(ctx, token)
+	require.NoError(t, err)
+	require.NotNil(t, at.LastUsedAt)
+	assert.Empty(t, updates())
+
+	// Other changes are recorded, without the activity.
+	err = at.Update().
+		SetName("Renamed").
+		SetLastUsedAt(time.Now().Add(time.Hour)).
+		Exec(context.Background())
+	require.NoError(t, err)
+	updated := updates()
+	require.Len(t, updated, 1)
+	assert.Contains(t, updated[0].Changes, "name")
+	assert.NotContains(t, updated[0].Changes, "last_used_at")
+}
+
+func TestAuditClient_GetEvents(t *testing.T) {
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	actx := WithAuditActor(context.Background(), AuditActor{UserID: u.ID})
+	err = c.ORM.User.UpdateOneID(u.ID).SetName("Filtered").Exec(actx)
+	require.NoError(t, err)
+
+	events, total, err := c.Audit.GetEvents(ctx, AuditFilter{ActorID: u.ID}, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	require.Len(t, events, 1)
+	assert.Equal(t, AuditActionUpdate, events[0].Action)
+
+	_, total, err = c.Audit.GetEvents(ctx, AuditFilter{
+		EntityType: ent.TypeUser,
+		EntityID:   u.ID,
+	}, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+
+	events, total, err = c.Audit.GetEvents(ctx, AuditFilter{
+		Action:     AuditActionCreate,
+		EntityType: ent.TypeUser,
+		EntityID:   u.ID,
+	}, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	require.Len(t, events, 1)
+	assert.Nil(t, events[0].ActorID)
+}
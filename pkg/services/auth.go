@@ -8,9 +8,11 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/gorilla/sessions"
 	"github.com/occult/pagode/config"
 	"github.com/occult/pagode/ent"
 	"github.com/occult/pagode/ent/passwordtoken"
+	entsession "github.com/occult/pagode/ent/session"
 	"github.com/occult/pagode/ent/user"
 	"github.com/occult/pagode/pkg/context"
 	"github.com/occult/pagode/pkg/session"
@@ -20,8 +22,8 @@ import (
 )
 
 const (
-	// authSessionName stores the name of the session which contains authentication data
-	authSessionName = "ua"
+	// AuthSessionName stores the name of the session which contains authentication data
+	AuthSessionName = "ua"
 
 	// authSessionKeyUserID stores the key used to store the user ID in the session
 	authSessionKeyUserID = "user_id"
@@ -60,30 +62,70 @@ func NewAuthClient(cfg *config.Config, orm *ent.Client) *AuthClient {
 	}
 }
 
-// Login logs in a user of a given ID
+// Login logs in a user of a given ID, restoring their account if it has been deleted but not yet purged
 func (c *AuthClient) Login(ctx echo.Context, userID int) error {
-	sess, err := session.Get(ctx, authSessionName)
+	// Logging in during the grace period restores a deleted account.
+	if _, err := c.RestoreAccount(ctx, userID); err != nil {
+		return err
+	}
+
+	sess, err := session.Get(ctx, AuthSessionName)
 	if err != nil {
 		return err
 	}
 	sess.Values[authSessionKeyUserID] = userID
 	sess.Values[authSessionKeyAuthenticated] = true
+	delete(sess.Values, authSessionKeyPendingUserID)
+	delete(sess.Values, authSessionKeyPendingAt)
+	delete(sess.Values, authSessionKeyImpersonatorID)
+	if err = c.renewSession(ctx, sess); err != nil {
+		return err
+	}
 	return sess.Save(ctx.Request(), ctx.Response())
 }
 
-// Logout logs the requesting user out
+// renewSession deletes the stored authentication session so that saving it issues a new token. This must be done
+// whenever the user of a session changes, otherwise a session token planted before the change could be used to act
+// as that user.
+func (c *AuthClient) renewSession(ctx echo.Context, sess *sessions.Session) error {
+	if sess.ID == "" {
+		return nil
+	}
+
+	_, err := c.orm.Session.
+		Delete().
+		Where(entsession.Token(hashSessionToken(sess.ID))).
+		Exec(ctx.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	sess.ID = ""
+	sess.IsNew = true
+	return nil
+}
+
+// Logout logs the requesting user out and deletes their authentication session, so its token can no longer be used
 func (c *AuthClient) Logout(ctx echo.Context) error {
-	sess, err := session.Get(ctx, authSessionName)
+	sess, err := session.Get(ctx, AuthSessionName)
 	if err != nil {
 		return err
 	}
-	sess.Values[authSessionKeyAuthenticated] = false
+	clear(sess.Values)
+	if err = c.renewSession(ctx, sess); err != nil {
+		return err
+	}
 	return sess.Save(ctx.Request(), ctx.Response())
 }
 
-// GetAuthenticatedUserID returns the authenticated user's ID, if the user is logged in
+// GetAuthenticatedUserID returns the authenticated user's ID, if the user is logged in or the request was
+// authenticated with an access token
 func (c *AuthClient) GetAuthenticatedUserID(ctx echo.Context) (int, error) {
-	sess, err := session.Get(ctx, authSessionName)
+	if t, ok := ctx.Get(context.AccessTokenKey).(*ent.AccessToken); ok {
+		return t.UserID, nil
+	}
+
+	sess, err := session.Get(ctx, AuthSessionName)
 	if err != nil {
 		return 0, err
 	}
@@ -105,6 +147,9 @@ func (c *AuthClient) GetAuthenticatedUser(ctx echo.Context) (*ent.User, error) {
 	if userID, err := c.GetAuthenticatedUserID(ctx); err == nil {
 		return c.orm.User.Query().
 			Where(user.ID(userID)).
+			WithRoles(func(q *ent.RoleQuery) {
+				q.WithPermissions()
+			}).
 			Only(ctx.Request().Context())
 	}
 
@@ -203,6 +248,17 @@ func (c *AuthClient) GenerateEmailVerificationToken(email string) (string, error
 // ValidateEmailVerificationToken validates an email verification token and returns the associated email address if
 // the token is valid and has not expired
 func (c *AuthClient) ValidateEmailVerificationToken(token string) (string, error) {
+	claims, err := c.parseEmailToken(token)
+	if err != nil {
+		return "", err
+	}
+
+	return claims["email"].(string), nil
+}
+
+// parseEmailToken parses a JWT sent by email and returns its claims if the token is valid, has not expired and
+// contains an email address
+func (c *AuthClient) parseEmailToken(token string) (jwt.MapClaims, error) {
 	t, err := jwt.Parse(token, func(t *jwt.Token) (any, error) {
 		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
@@ -211,12 +267,14 @@ func (c *AuthClient) ValidateEmailVerificationToken(token string) (string, error
 		return []byte(c.config.App.EncryptionKey), nil
 	})
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	if claims, ok := t.Claims.(jwt.MapClaims); ok && t.Valid {
-		return claims["email"].(string), nil
+		if _, ok := claims["email"].(string); ok {
+			return claims, nil
+		}
 	}
 
-	return "", errors.New("invalid or expired token")
+	return nil, errors.New("invalid or expired token")
 }
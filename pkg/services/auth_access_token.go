@@ -0,0 +1,137 @@
+package services
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/accesstoken"
+	"github.com/occult/pagode/pkg/authz"
+)
+
+const (
+	// accessTokenPrefix stores the prefix of all access tokens, which makes them easy to recognize, for example
+	// by secret scanners
+	accessTokenPrefix = "pgd_"
+
+	// accessTokenLastUsedInterval stores how often the last time an access token was used is recorded
+	accessTokenLastUsedInterval = time.Minute
+)
+
+// InvalidAccessTokenError is an error returned when an invalid, expired or revoked access token is provided
+type InvalidAccessTokenError struct{}
+
+// Error implements the error interface.
+func (e InvalidAccessTokenError) Error() string {
+	return "invalid access token"
+}
+
+// CreateAccessToken creates a named access token for a given user, granted the given scopes, which expires at a
+// given time, if provided.
+// The returned token is to be shown to the user once, while only a hash of it is stored.
+func (c *AuthClient) CreateAccessToken(
+	ctx echo.Context,
+	userID int,
+	name string,
+	scopes []authz.Scope,
+	expiresAt *time.Time,
+) (string, *ent.AccessToken, error) {
+	token := accessTokenPrefix + rand.Text()
+
+	names := make([]string, len(scopes))
+	for i, s := range scopes {
+		names[i] = string(s)
+	}
+
+	t, err := c.orm.AccessToken.
+		Create().
+		SetName(name).
+		SetToken(hashAccessToken(token)).
+		SetScopes(names).
+		SetUserID(userID).
+		SetNillableExpiresAt(expiresAt).
+		Save(ctx.Request().Context())
+	if err != nil {
+		return "", nil, err
+	}
+
+	return token, t, nil
+}
+
+// GetAccessTokens returns the access tokens of a given user
+func (c *AuthClient) GetAccessTokens(ctx echo.Context, userID int) ([]*ent.AccessToken, error) {
+	return c.orm.AccessToken.
+		Query().
+		Where(accesstoken.UserID(userID)).
+		Order(ent.Desc(accesstoken.FieldCreatedAt)).
+		All(ctx.Request().Context())
+}
+
+// RevokeAccessToken deletes an access token of a given user
+func (c *AuthClient) RevokeAccessToken(ctx echo.Context, userID, tokenID int) error {
+	deleted, err := c.orm.AccessToken.
+		Delete().
+		Where(accesstoken.ID(tokenID)).
+		Where(accesstoken.UserID(userID)).
+		Exec(ctx.Request().Context())
+	if err == nil && deleted == 0 {
+		return &ent.NotFoundError{}
+	}
+	return err
+}
+
+// RevokeAllAccessTokens deletes all access tokens of a given user
+func (c *AuthClient) RevokeAllAccessTokens(ctx echo.Context, userID int) error {
+	_, err := c.orm.AccessToken.
+		Delete().
+		Where(accesstoken.UserID(userID)).
+		Exec(ctx.Request().Context())
+	return err
+}
+
+// AuthenticateAccessToken returns the valid access token matching a given token and records that it was used.
+func (c *AuthClient) AuthenticateAccessToken(ctx echo.Context, token string) (*ent.AccessToken, error) {
+	if !strings.HasPrefix(token, accessTokenPrefix) {
+		return nil, InvalidAccessTokenError{}
+	}
+
+	now := time.Now()
+
+	t, err := c.orm.AccessToken.
+		Query().
+		Where(accesstoken.Token(hashAccessToken(token))).
+		Where(accesstoken.Or(
+			accesstoken.ExpiresAtIsNil(),
+			accesstoken.ExpiresAtGT(now),
+		)).
+		Only(ctx.Request().Context())
+	switch {
+	case err == nil:
+	case ent.IsNotFound(err):
+		return nil, InvalidAccessTokenError{}
+	default:
+		return nil, err
+	}
+
+	// Avoid a write on every request made with the token.
+	if t.LastUsedAt == nil || now.Sub(*t.LastUsedAt) >= accessTokenLastUsedInterval {
+		t, err = t.Update().
+			SetLastUsedAt(now).
+			Save(ctx.Request().Context())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return t, nil
+}
+
+// hashAccessToken hashes an access token for storage
+func hashAccessToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,80 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/pkg/authz"
+	"github.com/occult/pagode/pkg/context"
+	"github.com/occult/pagode/pkg/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthClient_AccessTokens(t *testing.T) {
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	token, created, err := c.Auth.CreateAccessToken(ctx, u.ID, "test", []authz.Scope{authz.ScopeRead}, nil)
+	require.NoError(t, err)
+	assert.NotEqual(t, token, created.Token)
+	assert.Nil(t, created.LastUsedAt)
+
+	tokens, err := c.Auth.GetAccessTokens(ctx, u.ID)
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	assert.Equal(t, []string{string(authz.ScopeRead)}, tokens[0].Scopes)
+
+	// Authenticating should record that the token was used
+	got, err := c.Auth.AuthenticateAccessToken(ctx, token)
+	require.NoError(t, err)
+	assert.Equal(t, u.ID, got.UserID)
+	require.NotNil(t, got.LastUsedAt)
+
+	_, err = c.Auth.AuthenticateAccessToken(ctx, token+"x")
+	assert.Equal(t, InvalidAccessTokenError{}, err)
+	_, err = c.Auth.AuthenticateAccessToken(ctx, "invalid")
+	assert.Equal(t, InvalidAccessTokenError{}, err)
+
+	// Tokens of other users cannot be revoked
+	err = c.Auth.RevokeAccessToken(ctx, usr.ID, created.ID)
+	assert.True(t, ent.IsNotFound(err))
+
+	require.NoError(t, c.Auth.RevokeAccessToken(ctx, u.ID, created.ID))
+	_, err = c.Auth.AuthenticateAccessToken(ctx, token)
+	assert.Equal(t, InvalidAccessTokenError{}, err)
+
+	// Revoking all tokens
+	token, _, err = c.Auth.CreateAccessToken(ctx, u.ID, "test", []authz.Scope{authz.ScopeRead}, nil)
+	require.NoError(t, err)
+	require.NoError(t, c.Auth.RevokeAllAccessTokens(ctx, u.ID))
+	_, err = c.Auth.AuthenticateAccessToken(ctx, token)
+	assert.Equal(t, InvalidAccessTokenError{}, err)
+}
+
+func TestAuthClient_AccessTokenExpiration(t *testing.T) {
+	expired := time.Now().Add(-time.Minute)
+	token, _, err := c.Auth.CreateAccessToken(ctx, usr.ID, "expired", []authz.Scope{authz.ScopeRead}, &expired)
+	require.NoError(t, err)
+
+	_, err = c.Auth.AuthenticateAccessToken(ctx, token)
+	assert.Equal(t, InvalidAccessTokenError{}, err)
+}
+
+func TestAuthClient_GetAuthenticatedUser_AccessToken(t *testing.T) {
+	ctx, _ := tests.NewContext(c.Web, "/")
+	tests.InitSession(ctx)
+
+	token, _, err := c.Auth.CreateAccessToken(ctx, usr.ID, "user", []authz.Scope{authz.ScopeRead}, nil)
+	require.NoError(t, err)
+
+	got, err := c.Auth.AuthenticateAccessToken(ctx, token)
+	require.NoError(t, err)
+	ctx.Set(context.AccessTokenKey, got)
+
+	u, err := c.Auth.GetAuthenticatedUser(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, usr.ID, u.ID)
+}
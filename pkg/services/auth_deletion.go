@@ -0,0 +1,89 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/chatmessage"
+	"github.com/occult/pagode/ent/user"
+)
+
+// deletedSenderName replaces the sender name of the chat messages of purged accounts
+const deletedSenderName = "Deleted user"
+
+// DeleteAccount marks the account of a given user as deleted and signs them out of all devices and access tokens.
+// The account can be restored by logging in until it is purged once the grace period has passed, at the time
+// returned.
+func (c *AuthClient) DeleteAccount(ctx echo.Context, u *ent.User) (time.Time, error) {
+	now := time.Now()
+
+	err := c.orm.User.
+		UpdateOne(u).
+		SetDeletedAt(now).
+		Exec(ctx.Request().Context())
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if err = c.RevokeAllAccessTokens(ctx, u.ID); err != nil {
+		return time.Time{}, err
+	}
+
+	if err = c.RevokeAllSessions(ctx, u.ID); err != nil {
+		return time.Time{}, err
+	}
+
+	if err = c.Logout(ctx); err != nil {
+		return time.Time{}, err
+	}
+
+	return now.Add(c.config.App.AccountDeletion.GracePeriod), nil
+}
+
+// RestoreAccount restores the account of the user of a given ID if it has been deleted but not yet purged, and
+// returns whether it was.
+func (c *AuthClient) RestoreAccount(ctx echo.Context, userID int) (bool, error) {
+	restored, err := c.orm.User.
+		Update().
+		Where(
+			user.ID(userID),
+			user.DeletedAtNotNil(),
+		).
+		ClearDeletedAt().
+		Save(ctx.Request().Context())
+
+	return restored > 0, err
+}
+
+// IsAccountPurgeDue returns true if a given user has deleted their account and the grace period during which it can
+// be restored has passed.
+func (c *AuthClient) IsAccountPurgeDue(u *ent.User) bool {
+	return u.DeletedAt != nil && time.Since(*u.DeletedAt) >= c.config.App.AccountDeletion.GracePeriod
+}
+
+// PurgeAccount permanently deletes the account of a given user. The chat messages they sent are kept, but no longer
+// attributed to them.
+func (c *AuthClient) PurgeAccount(ctx context.Context, u *ent.User) error {
+	tx, err := c.orm.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = tx.ChatMessage.
+		Update().
+		Where(chatmessage.HasSenderWith(user.ID(u.ID))).
+		SetSenderName(deletedSenderName).
+		ClearSender().
+		Exec(ctx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+
+	if err = tx.User.DeleteOneID(u.ID).Exec(ctx); err != nil {
+		return rollback(tx, err)
+	}
+
+	return tx.Commit()
+}
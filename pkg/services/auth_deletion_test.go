@@ -0,0 +1,94 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/accesstoken"
+	"github.com/occult/pagode/pkg/authz"
+	"github.com/occult/pagode/pkg/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthClient_DeleteAccount(t *testing.T) {
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	ctx1 := newSessionContext()
+	require.NoError(t, c.Auth.Login(ctx1, u.ID))
+	ctx2 := newSessionContext()
+	require.NoError(t, c.Auth.Login(ctx2, u.ID))
+	_, _, err = c.Auth.CreateAccessToken(ctx1, u.ID, "deletion", []authz.Scope{authz.ScopeRead}, nil)
+	require.NoError(t, err)
+
+	purgeAt, err := c.Auth.DeleteAccount(ctx1, u)
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(c.Config.App.AccountDeletion.GracePeriod), purgeAt, time.Minute)
+
+	u, err = c.ORM.User.Get(context.Background(), u.ID)
+	require.NoError(t, err)
+	require.NotNil(t, u.DeletedAt)
+	assert.False(t, c.Auth.IsAccountPurgeDue(u))
+
+	// The user is signed out everywhere.
+	_, err = c.Auth.GetAuthenticatedUser(ctx1)
+	assert.Equal(t, NotAuthenticatedError{}, err)
+	sessions, err := c.Auth.GetSessions(ctx1, u.ID)
+	require.NoError(t, err)
+	assert.Empty(t, sessions)
+	tokens, err := c.ORM.AccessToken.Query().Where(accesstoken.UserID(u.ID)).Count(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, tokens)
+
+	// Logging in restores the account.
+	require.NoError(t, c.Auth.Login(ctx1, u.ID))
+	u, err = c.ORM.User.Get(context.Background(), u.ID)
+	require.NoError(t, err)
+	assert.Nil(t, u.DeletedAt)
+
+	restored, err := c.Auth.RestoreAccount(ctx1, u.ID)
+	require.NoError(t, err)
+	assert.False(t, restored)
+}
+
+func TestAuthClient_PurgeAccount(t *testing.T) {
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	room, err := c.ORM.ChatRoom.
+		Create().
+		SetName("Purged room").
+		Save(context.Background())
+	require.NoError(t, err)
+
+	message, err := c.ORM.ChatMessage.
+		Create().
+		SetRoom(room).
+		SetSender(u).
+		SetSenderName("Purged").
+		SetBody("Hello").
+		Save(context.Background())
+	require.NoError(t, err)
+
+	deletedAt := time.Now().Add(-c.Config.App.AccountDeletion.GracePeriod)
+	u, err = u.Update().SetDeletedAt(deletedAt).Save(context.Background())
+	require.NoError(t, err)
+	assert.True(t, c.Auth.IsAccountPurgeDue(u))
+
+	require.NoError(t, c.Auth.PurgeAccount(context.Background(), u))
+
+	_, err = c.ORM.User.Get(context.Background(), u.ID)
+	assert.True(t, ent.IsNotFound(err))
+
+	// Messages are kept without being attributed to the user.
+	message, err = c.ORM.ChatMessage.Get(context.Background(), message.ID)
+	require.NoError(t, err)
+	assert.Equal(t, deletedSenderName, message.SenderName)
+	exists, err := message.QuerySender().Exist(context.Background())
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
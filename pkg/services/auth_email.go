@@ -0,0 +1,109 @@
+package services
+
+import (
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/user"
+)
+
+// EmailTakenError is an error returned when an email address already belongs to another user
+type EmailTakenError struct{}
+
+// Error implements the error interface.
+func (e EmailTakenError) Error() string {
+	return "email address already in use"
+}
+
+// InvalidEmailChangeTokenError is an error returned when an email change token is invalid, has expired or no
+// longer matches the pending email address of the user
+type InvalidEmailChangeTokenError struct{}
+
+// Error implements the error interface.
+func (e InvalidEmailChangeTokenError) Error() string {
+	return "invalid email change token"
+}
+
+// RequestEmailChange stores a new email address for a given user which only replaces their current one once it
+// has been confirmed, so the address of the user always remains verified. Any previous pending change is replaced.
+func (c *AuthClient) RequestEmailChange(ctx echo.Context, u *ent.User, email string) (*ent.User, error) {
+	email = strings.ToLower(email)
+
+	taken, err := c.orm.User.
+		Query().
+		Where(user.Email(email)).
+		Where(user.IDNEQ(u.ID)).
+		Exist(ctx.Request().Context())
+	switch {
+	case err != nil:
+		return nil, err
+	case taken:
+		return nil, EmailTakenError{}
+	}
+
+	return u.Update().
+		SetPendingEmail(email).
+		Save(ctx.Request().Context())
+}
+
+// CancelEmailChange discards the pending email address of a given user
+func (c *AuthClient) CancelEmailChange(ctx echo.Context, u *ent.User) (*ent.User, error) {
+	return u.Update().
+		ClearPendingEmail().
+		Save(ctx.Request().Context())
+}
+
+// GenerateEmailChangeToken generates a token to confirm the pending email address of a given user using JWT which
+// is set to expire based on the same duration as email verification tokens
+func (c *AuthClient) GenerateEmailChangeToken(u *ent.User) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"email": u.PendingEmail,
+		"user":  u.ID,
+		"exp":   time.Now().Add(c.config.App.EmailVerificationTokenExpiration).Unix(),
+	})
+
+	return token.SignedString([]byte(c.config.App.EncryptionKey))
+}
+
+// ConfirmEmailChange validates an email change token and, if it matches the pending email address of the user it
+// was generated for, replaces their email address with it. The new address is verified since the token could only
+// have been received there.
+func (c *AuthClient) ConfirmEmailChange(ctx echo.Context, token string) (*ent.User, error) {
+	claims, err := c.parseEmailToken(token)
+	if err != nil {
+		return nil, InvalidEmailChangeTokenError{}
+	}
+
+	// Verification tokens are not bound to a user, so they cannot be used to change an email address.
+	userID, ok := claims["user"].(float64)
+	if !ok {
+		return nil, InvalidEmailChangeTokenError{}
+	}
+
+	u, err := c.orm.User.Get(ctx.Request().Context(), int(userID))
+	switch {
+	case ent.IsNotFound(err):
+		return nil, InvalidEmailChangeTokenError{}
+	case err != nil:
+		return nil, err
+	}
+
+	email := claims["email"].(string)
+	if u.PendingEmail == "" || u.PendingEmail != email {
+		return nil, InvalidEmailChangeTokenError{}
+	}
+
+	u, err = u.Update().
+		SetEmail(email).
+		ClearPendingEmail().
+		SetVerified(true).
+		Save(ctx.Request().Context())
+	if ent.IsConstraintError(err) {
+		return nil, EmailTakenError{}
+	}
+
+	return u, err
+}
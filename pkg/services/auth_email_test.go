@@ -0,0 +1,78 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/occult/pagode/pkg/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthClient_EmailChange(t *testing.T) {
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	oldEmail := u.Email
+
+	u, err = c.Auth.RequestEmailChange(ctx, u, "New.Address@Localhost.Localhost")
+	require.NoError(t, err)
+	assert.Equal(t, "new.address@localhost.localhost", u.PendingEmail)
+
+	// The email address only changes once confirmed
+	u, err = c.ORM.User.Get(ctx.Request().Context(), u.ID)
+	require.NoError(t, err)
+	assert.Equal(t, oldEmail, u.Email)
+
+	token, err := c.Auth.GenerateEmailChangeToken(u)
+	require.NoError(t, err)
+
+	u, err = c.Auth.ConfirmEmailChange(ctx, token)
+	require.NoError(t, err)
+	assert.Equal(t, "new.address@localhost.localhost", u.Email)
+	assert.Empty(t, u.PendingEmail)
+	assert.True(t, u.Verified)
+
+	// Tokens can only be used while the email address is pending
+	_, err = c.Auth.ConfirmEmailChange(ctx, token)
+	assert.Equal(t, InvalidEmailChangeTokenError{}, err)
+
+	t.Run("taken", func(t *testing.T) {
+		_, err := c.Auth.RequestEmailChange(ctx, u, usr.Email)
+		assert.Equal(t, EmailTakenError{}, err)
+	})
+
+	t.Run("replaced", func(t *testing.T) {
+		u, err := c.Auth.RequestEmailChange(ctx, u, "first@localhost.localhost")
+		require.NoError(t, err)
+		token, err := c.Auth.GenerateEmailChangeToken(u)
+		require.NoError(t, err)
+
+		_, err = c.Auth.RequestEmailChange(ctx, u, "second@localhost.localhost")
+		require.NoError(t, err)
+		_, err = c.Auth.ConfirmEmailChange(ctx, token)
+		assert.Equal(t, InvalidEmailChangeTokenError{}, err)
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		u, err := c.Auth.RequestEmailChange(ctx, u, "cancelled@localhost.localhost")
+		require.NoError(t, err)
+		token, err := c.Auth.GenerateEmailChangeToken(u)
+		require.NoError(t, err)
+
+		u, err = c.Auth.CancelEmailChange(ctx, u)
+		require.NoError(t, err)
+		assert.Empty(t, u.PendingEmail)
+		_, err = c.Auth.ConfirmEmailChange(ctx, token)
+		assert.Equal(t, InvalidEmailChangeTokenError{}, err)
+	})
+
+	t.Run("verification token", func(t *testing.T) {
+		u, err := c.Auth.RequestEmailChange(ctx, u, "verification@localhost.localhost")
+		require.NoError(t, err)
+		token, err := c.Auth.GenerateEmailVerificationToken(u.PendingEmail)
+		require.NoError(t, err)
+
+		_, err = c.Auth.ConfirmEmailChange(ctx, token)
+		assert.Equal(t, InvalidEmailChangeTokenError{}, err)
+	})
+}
@@ -0,0 +1,116 @@
+package services
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/role"
+	"github.com/occult/pagode/ent/user"
+	"github.com/occult/pagode/pkg/authz"
+	"github.com/occult/pagode/pkg/context"
+	"github.com/occult/pagode/pkg/session"
+)
+
+// authSessionKeyImpersonatorID stores the key used to store the ID of the admin who is impersonating the
+// authenticated user
+const authSessionKeyImpersonatorID = "impersonator_id"
+
+// InvalidImpersonationError is an error returned when a user cannot be impersonated
+type InvalidImpersonationError struct {
+	Reason string
+}
+
+// Error implements the error interface.
+func (e InvalidImpersonationError) Error() string {
+	return "invalid impersonation: " + e.Reason
+}
+
+// StartImpersonation logs the requesting admin in as a given user, while remembering the admin so that the
+// impersonation can be stopped. Admins cannot impersonate themselves or other admins, and cannot impersonate
+// another user while already impersonating one.
+func (c *AuthClient) StartImpersonation(ctx echo.Context, admin *ent.User, userID int) error {
+	if admin.ID == userID {
+		return InvalidImpersonationError{Reason: "cannot impersonate yourself"}
+	}
+
+	if c.IsImpersonating(ctx) {
+		return InvalidImpersonationError{Reason: "already impersonating a user"}
+	}
+
+	isAdmin, err := c.orm.User.
+		Query().
+		Where(user.ID(userID)).
+		Where(user.HasRolesWith(role.Name(authz.AdminRole))).
+		Exist(ctx.Request().Context())
+	switch {
+	case err != nil:
+		return err
+	case isAdmin:
+		return InvalidImpersonationError{Reason: "cannot impersonate an admin"}
+	}
+
+	sess, err := session.Get(ctx, AuthSessionName)
+	if err != nil {
+		return err
+	}
+	sess.Values[authSessionKeyUserID] = userID
+	sess.Values[authSessionKeyImpersonatorID] = admin.ID
+	if err = c.renewSession(ctx, sess); err != nil {
+		return err
+	}
+	return sess.Save(ctx.Request(), ctx.Response())
+}
+
+// StopImpersonation logs the admin who is impersonating the authenticated user back in as themselves and returns
+// the ID of the user who was impersonated
+func (c *AuthClient) StopImpersonation(ctx echo.Context) (int, error) {
+	sess, err := session.Get(ctx, AuthSessionName)
+	if err != nil {
+		return 0, err
+	}
+
+	adminID, ok := sess.Values[authSessionKeyImpersonatorID].(int)
+	if !ok {
+		return 0, InvalidImpersonationError{Reason: "not impersonating a user"}
+	}
+	userID, _ := sess.Values[authSessionKeyUserID].(int)
+
+	sess.Values[authSessionKeyUserID] = adminID
+	delete(sess.Values, authSessionKeyImpersonatorID)
+	if err = c.renewSession(ctx, sess); err != nil {
+		return 0, err
+	}
+	return userID, sess.Save(ctx.Request(), ctx.Response())
+}
+
+// IsImpersonating determines if the authenticated user is being impersonated by an admin
+func (c *AuthClient) IsImpersonating(ctx echo.Context) bool {
+	_, ok := c.GetImpersonatorID(ctx)
+	return ok
+}
+
+// GetImpersonatorID returns the ID of the admin who is impersonating the authenticated user, if any
+func (c *AuthClient) GetImpersonatorID(ctx echo.Context) (int, bool) {
+	// Requests authenticated with an access token never impersonate anyone.
+	if ctx.Get(context.AccessTokenKey) != nil {
+		return 0, false
+	}
+
+	sess, err := session.Get(ctx, AuthSessionName)
+	if err != nil || sess.Values[authSessionKeyAuthenticated] != true {
+		return 0, false
+	}
+
+	adminID, ok := sess.Values[authSessionKeyImpersonatorID].(int)
+	return adminID, ok
+}
+
+// GetImpersonator returns the admin who is impersonating the authenticated user, or nil if the user is not being
+// impersonated
+func (c *AuthClient) GetImpersonator(ctx echo.Context) (*ent.User, error) {
+	adminID, ok := c.GetImpersonatorID(ctx)
+	if !ok {
+		return nil, nil
+	}
+
+	return c.orm.User.Get(ctx.Request().Context(), adminID)
+}
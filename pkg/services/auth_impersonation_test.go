@@ -0,0 +1,81 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/occult/pagode/ent/role"
+	"github.com/occult/pagode/pkg/authz"
+	"github.com/occult/pagode/pkg/session"
+	"github.com/occult/pagode/pkg/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthClient_Impersonation(t *testing.T) {
+	ctx, _ := tests.NewContext(c.Web, "/")
+	tests.InitSession(ctx)
+
+	admin, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	adminRole, err := c.ORM.Role.Query().Where(role.Name(authz.AdminRole)).Only(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, c.Auth.SetUserRoles(ctx, admin.ID, adminRole.ID))
+
+	target, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	require.NoError(t, c.Auth.Login(ctx, admin.ID))
+	assert.False(t, c.Auth.IsImpersonating(ctx))
+
+	// Admins cannot be impersonated, including the admin themselves
+	err = c.Auth.StartImpersonation(ctx, admin, admin.ID)
+	assert.IsType(t, InvalidImpersonationError{}, err)
+	other, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	require.NoError(t, c.Auth.SetUserRoles(ctx, other.ID, adminRole.ID))
+	err = c.Auth.StartImpersonation(ctx, admin, other.ID)
+	assert.IsType(t, InvalidImpersonationError{}, err)
+
+	require.NoError(t, c.Auth.StartImpersonation(ctx, admin, target.ID))
+
+	uid, err := c.Auth.GetAuthenticatedUserID(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, target.ID, uid)
+
+	impersonator, err := c.Auth.GetImpersonator(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, impersonator)
+	assert.Equal(t, admin.ID, impersonator.ID)
+
+	// The session still belongs to the admin
+	sess, err := session.Get(ctx, AuthSessionName)
+	require.NoError(t, err)
+	sessUserID, ok := sessionUserID(sess)
+	require.True(t, ok)
+	assert.Equal(t, admin.ID, sessUserID)
+
+	// Impersonations cannot be nested
+	err = c.Auth.StartImpersonation(ctx, admin, other.ID)
+	assert.IsType(t, InvalidImpersonationError{}, err)
+
+	userID, err := c.Auth.StopImpersonation(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, target.ID, userID)
+	assert.False(t, c.Auth.IsImpersonating(ctx))
+
+	uid, err = c.Auth.GetAuthenticatedUserID(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, admin.ID, uid)
+
+	_, err = c.Auth.StopImpersonation(ctx)
+	assert.IsType(t, InvalidImpersonationError{}, err)
+
+	t.Run("logout", func(t *testing.T) {
+		require.NoError(t, c.Auth.StartImpersonation(ctx, admin, target.ID))
+		require.NoError(t, c.Auth.Logout(ctx))
+		require.NoError(t, c.Auth.Login(ctx, target.ID))
+		assert.False(t, c.Auth.IsImpersonating(ctx))
+	})
+}
@@ -0,0 +1,158 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/loginattempt"
+	"github.com/occult/pagode/ent/predicate"
+)
+
+// LoginLockedError is an error returned when logging in is blocked after too many failed attempts
+type LoginLockedError struct {
+	Until time.Time
+}
+
+// Error implements the error interface.
+func (e LoginLockedError) Error() string {
+	return fmt.Sprintf("login locked until %s", e.Until.Format(time.RFC3339))
+}
+
+// CheckLoginAllowed returns a LoginLockedError if logging in with a given email address to a given user, which is nil
+// if no user matched it, is blocked either because the account is locked or because there have been too many failed
+// attempts with the email address or from the IP address of the request.
+// Failed attempts with an email address count the same whether or not a user matched it, so lockouts do not reveal
+// which email addresses are registered.
+func (c *AuthClient) CheckLoginAllowed(ctx echo.Context, email string, u *ent.User) error {
+	now := time.Now()
+
+	if u != nil && u.LockedUntil != nil && u.LockedUntil.After(now) {
+		return LoginLockedError{Until: *u.LockedUntil}
+	}
+
+	cfg := c.config.Auth.Lockout
+	limits := []struct {
+		where predicate.LoginAttempt
+		max   int
+	}{
+		{where: loginattempt.Email(strings.ToLower(email)), max: cfg.MaxAttempts},
+		{where: loginattempt.IPAddress(ctx.RealIP()), max: cfg.MaxAttemptsPerIP},
+	}
+
+	for _, limit := range limits {
+		query := c.orm.LoginAttempt.
+			Query().
+			Where(limit.where).
+			Where(loginattempt.CreatedAtGT(now.Add(-cfg.Window)))
+
+		attempts, err := query.Clone().Count(ctx.Request().Context())
+		if err != nil {
+			return err
+		}
+		if attempts < limit.max {
+			continue
+		}
+
+		last, err := query.
+			Order(ent.Desc(loginattempt.FieldCreatedAt)).
+			First(ctx.Request().Context())
+		if err != nil {
+			return err
+		}
+
+		until := last.CreatedAt.Add(c.lockoutDuration(attempts - limit.max))
+		if until.After(now) {
+			return LoginLockedError{Until: until}
+		}
+	}
+
+	return nil
+}
+
+// RecordFailedLogin records a failed attempt to log in with a given email address, and the user it belongs to, if
+// any. If this attempt caused the user to be locked out for the first time within the window, the user is
+// returned with the updated lockout so they can be notified.
+func (c *AuthClient) RecordFailedLogin(ctx echo.Context, email string, u *ent.User) (*ent.User, error) {
+	cfg := c.config.Auth.Lockout
+	now := time.Now()
+
+	// Remove attempts which no longer count towards any limit.
+	_, err := c.orm.LoginAttempt.
+		Delete().
+		Where(loginattempt.CreatedAtLTE(now.Add(-cfg.Window))).
+		Exec(ctx.Request().Context())
+	if err != nil {
+		return nil, err
+	}
+
+	create := c.orm.LoginAttempt.
+		Create().
+		SetEmail(email).
+		SetIPAddress(ctx.RealIP())
+	if u != nil {
+		create.SetUserID(u.ID)
+	}
+	if err = create.Exec(ctx.Request().Context()); err != nil {
+		return nil, err
+	}
+
+	if u == nil {
+		return nil, nil
+	}
+
+	failures, err := c.orm.LoginAttempt.
+		Query().
+		Where(loginattempt.UserID(u.ID)).
+		Count(ctx.Request().Context())
+	if err != nil || failures < cfg.MaxAttempts {
+		return nil, err
+	}
+
+	u, err = u.Update().
+		SetLockedUntil(now.Add(c.lockoutDuration(failures - cfg.MaxAttempts))).
+		Save(ctx.Request().Context())
+	if err != nil || failures > cfg.MaxAttempts {
+		return nil, err
+	}
+
+	return u, nil
+}
+
+// ClearFailedLogins clears the failed login attempts of a given user, once they have logged in successfully.
+func (c *AuthClient) ClearFailedLogins(ctx echo.Context, userID int) error {
+	_, err := c.orm.LoginAttempt.
+		Delete().
+		Where(loginattempt.UserID(userID)).
+		Exec(ctx.Request().Context())
+	return err
+}
+
+// UnlockUser unlocks the account of a given user and clears their failed login attempts.
+func (c *AuthClient) UnlockUser(ctx echo.Context, userID int) error {
+	err := c.orm.User.
+		UpdateOneID(userID).
+		ClearLockedUntil().
+		Exec(ctx.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	return c.ClearFailedLogins(ctx, userID)
+}
+
+// lockoutDuration returns how long logins are blocked after a given amount of failed attempts beyond the limit,
+// doubling with each one.
+func (c *AuthClient) lockoutDuration(excess int) time.Duration {
+	cfg := c.config.Auth.Lockout
+	d := cfg.Duration
+	for range excess {
+		if d >= cfg.MaxDuration {
+			break
+		}
+		d *= 2
+	}
+	return min(d, cfg.MaxDuration)
+}
@@ -0,0 +1,134 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/occult/pagode/pkg/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthClient_Lockout(t *testing.T) {
+	cfg := *c.Config
+	cfg.Auth.Lockout.MaxAttempts = 2
+	cfg.Auth.Lockout.MaxAttemptsPerIP = 5
+	cfg.Auth.Lockout.Window = time.Hour
+	cfg.Auth.Lockout.Duration = time.Minute
+	cfg.Auth.Lockout.MaxDuration = 3 * time.Minute
+	auth := NewAuthClient(&cfg, c.ORM)
+
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	ctx, _ := tests.NewContext(c.Web, "/")
+	ctx.Request().RemoteAddr = "10.0.0.1:1234"
+
+	require.NoError(t, auth.CheckLoginAllowed(ctx, u.Email, u))
+
+	// The user should not be locked until the limit is reached
+	locked, err := auth.RecordFailedLogin(ctx, u.Email, u)
+	require.NoError(t, err)
+	assert.Nil(t, locked)
+	require.NoError(t, auth.CheckLoginAllowed(ctx, u.Email, u))
+
+	// Reaching the limit should lock the user and return them so they can be notified
+	locked, err = auth.RecordFailedLogin(ctx, u.Email, u)
+	require.NoError(t, err)
+	require.NotNil(t, locked)
+	require.NotNil(t, locked.LockedUntil)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), *locked.LockedUntil, 5*time.Second)
+
+	err = auth.CheckLoginAllowed(ctx, locked.Email, locked)
+	assert.Equal(t, LoginLockedError{Until: *locked.LockedUntil}, err)
+
+	// Further failures should double the lockout, up to the maximum, without notifying the user again
+	locked, err = auth.RecordFailedLogin(ctx, u.Email, locked)
+	require.NoError(t, err)
+	assert.Nil(t, locked)
+	u, err = c.ORM.User.Get(ctx.Request().Context(), u.ID)
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(2*time.Minute), *u.LockedUntil, 5*time.Second)
+
+	for range 2 {
+		_, err = auth.RecordFailedLogin(ctx, u.Email, u)
+		require.NoError(t, err)
+	}
+	u, err = c.ORM.User.Get(ctx.Request().Context(), u.ID)
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(3*time.Minute), *u.LockedUntil, 5*time.Second)
+
+	// Unlocking should allow the user to log in again and reset the failed attempts
+	require.NoError(t, auth.UnlockUser(ctx, u.ID))
+	u, err = c.ORM.User.Get(ctx.Request().Context(), u.ID)
+	require.NoError(t, err)
+	assert.Nil(t, u.LockedUntil)
+	require.NoError(t, auth.CheckLoginAllowed(ctx, u.Email, u))
+	count, err := u.QueryLoginAttempts().Count(ctx.Request().Context())
+	require.NoError(t, err)
+	assert.Zero(t, count)
+
+	// All failed attempts from the IP address should count towards its limit, even for unknown users
+	for range 5 {
+		_, err = auth.RecordFailedLogin(ctx, "unknown@localhost.localhost", nil)
+		require.NoError(t, err)
+	}
+	err = auth.CheckLoginAllowed(ctx, "unknown@localhost.localhost", nil)
+	assert.IsType(t, LoginLockedError{}, err)
+	err = auth.CheckLoginAllowed(ctx, u.Email, u)
+	assert.IsType(t, LoginLockedError{}, err)
+
+	// Other IP addresses should not be affected
+	ctx.Request().RemoteAddr = "10.0.0.2:1234"
+	assert.NoError(t, auth.CheckLoginAllowed(ctx, u.Email, u))
+}
+
+func TestAuthClient_Lockout_UnknownEmail(t *testing.T) {
+	cfg := *c.Config
+	cfg.Auth.Lockout.MaxAttempts = 2
+	cfg.Auth.Lockout.MaxAttemptsPerIP = 100
+	cfg.Auth.Lockout.Window = time.Hour
+	cfg.Auth.Lockout.Duration = time.Minute
+	cfg.Auth.Lockout.MaxDuration = time.Hour
+	auth := NewAuthClient(&cfg, c.ORM)
+
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	unknown := "unknown-lockout@localhost.localhost"
+
+	// Failed attempts from several IP addresses should lock out an unknown email address just like a registered one
+	for i := range 2 {
+		ctx, _ := tests.NewContext(c.Web, "/")
+		ctx.Request().RemoteAddr = fmt.Sprintf("10.0.1.%d:1234", i)
+		_, err = auth.RecordFailedLogin(ctx, u.Email, u)
+		require.NoError(t, err)
+		_, err = auth.RecordFailedLogin(ctx, unknown, nil)
+		require.NoError(t, err)
+	}
+
+	ctx, _ := tests.NewContext(c.Web, "/")
+	ctx.Request().RemoteAddr = "10.0.1.100:1234"
+	u, err = c.ORM.User.Get(ctx.Request().Context(), u.ID)
+	require.NoError(t, err)
+
+	var registered, unregistered LoginLockedError
+	require.ErrorAs(t, auth.CheckLoginAllowed(ctx, u.Email, u), &registered)
+	require.ErrorAs(t, auth.CheckLoginAllowed(ctx, strings.ToUpper(unknown), nil), &unregistered)
+	assert.WithinDuration(t, registered.Until, unregistered.Until, 5*time.Second)
+}
+
+func TestAuthClient_ClearFailedLogins(t *testing.T) {
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	_, err = c.Auth.RecordFailedLogin(ctx, u.Email, u)
+	require.NoError(t, err)
+
+	require.NoError(t, c.Auth.ClearFailedLogins(ctx, u.ID))
+	count, err := u.QueryLoginAttempts().Count(ctx.Request().Context())
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
@@ -0,0 +1,114 @@
+package services
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/occult/pagode/ent/logintoken"
+)
+
+// loginTokenLength stores the length of the random part of a login token
+const loginTokenLength = 32
+
+// InvalidLoginTokenError is an error returned when an invalid, expired or already used login token is provided
+type InvalidLoginTokenError struct{}
+
+// Error implements the error interface.
+func (e InvalidLoginTokenError) Error() string {
+	return "invalid login token"
+}
+
+// GenerateLoginToken generates a single-use token which allows a given user to sign in without their password,
+// for example by sending it in a link by email.
+// The token is a JWT which expires based on the duration stored in configuration and contains the ID of a login
+// token entity as well as a random secret. As with password tokens, only a hash of the secret is stored.
+func (c *AuthClient) GenerateLoginToken(ctx echo.Context, userID int) (string, error) {
+	secret, err := c.RandomToken(loginTokenLength)
+	if err != nil {
+		return "", err
+	}
+
+	lt, err := c.orm.LoginToken.
+		Create().
+		SetToken(secret).
+		SetUserID(userID).
+		Save(ctx.Request().Context())
+	if err != nil {
+		return "", err
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub": strconv.Itoa(userID),
+		"jti": strconv.Itoa(lt.ID),
+		"tok": secret,
+		"exp": lt.CreatedAt.Add(c.config.App.LoginTokenExpiration).Unix(),
+	})
+
+	return token.SignedString([]byte(c.config.App.EncryptionKey))
+}
+
+// UseLoginToken validates a login token and returns the ID of the user it belongs to.
+// The token is deleted so it cannot be used again.
+func (c *AuthClient) UseLoginToken(ctx echo.Context, token string) (int, error) {
+	t, err := jwt.Parse(token, func(t *jwt.Token) (any, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
+		}
+
+		return []byte(c.config.App.EncryptionKey), nil
+	})
+	if err != nil || !t.Valid {
+		return 0, InvalidLoginTokenError{}
+	}
+
+	claims, ok := t.Claims.(jwt.MapClaims)
+	if !ok {
+		return 0, InvalidLoginTokenError{}
+	}
+
+	sub, _ := claims["sub"].(string)
+	jti, _ := claims["jti"].(string)
+	secret, _ := claims["tok"].(string)
+
+	userID, err := strconv.Atoi(sub)
+	if err != nil {
+		return 0, InvalidLoginTokenError{}
+	}
+
+	tokenID, err := strconv.Atoi(jti)
+	if err != nil {
+		return 0, InvalidLoginTokenError{}
+	}
+
+	// Ensure expired tokens are never used, even if the expiration was shortened since the token was generated.
+	lt, err := c.orm.LoginToken.
+		Query().
+		Where(logintoken.ID(tokenID)).
+		Where(logintoken.UserID(userID)).
+		Where(logintoken.CreatedAtGTE(time.Now().Add(-c.config.App.LoginTokenExpiration))).
+		Only(ctx.Request().Context())
+	if err != nil {
+		return 0, InvalidLoginTokenError{}
+	}
+
+	if err = c.CheckPassword(secret, lt.Token); err != nil {
+		return 0, InvalidLoginTokenError{}
+	}
+
+	// Only the request which deletes the token may use it.
+	deleted, err := c.orm.LoginToken.
+		Delete().
+		Where(logintoken.ID(lt.ID)).
+		Exec(ctx.Request().Context())
+	if err != nil {
+		return 0, err
+	}
+	if deleted == 0 {
+		return 0, InvalidLoginTokenError{}
+	}
+
+	return userID, nil
+}
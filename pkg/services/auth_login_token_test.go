@@ -0,0 +1,53 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/occult/pagode/ent/logintoken"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthClient_LoginToken(t *testing.T) {
+	token, err := c.Auth.GenerateLoginToken(ctx, usr.ID)
+	require.NoError(t, err)
+
+	// Only a hash of the token should be stored
+	lt, err := c.ORM.LoginToken.
+		Query().
+		Where(logintoken.UserID(usr.ID)).
+		Only(ctx.Request().Context())
+	require.NoError(t, err)
+	assert.NotContains(t, token, lt.Token)
+
+	userID, err := c.Auth.UseLoginToken(ctx, token)
+	require.NoError(t, err)
+	assert.Equal(t, usr.ID, userID)
+
+	// Tokens can only be used once
+	_, err = c.Auth.UseLoginToken(ctx, token)
+	assert.Equal(t, InvalidLoginTokenError{}, err)
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := c.Auth.UseLoginToken(ctx, "faketoken")
+		assert.Equal(t, InvalidLoginTokenError{}, err)
+
+		token, err := c.Auth.GenerateLoginToken(ctx, usr.ID)
+		require.NoError(t, err)
+		_, err = c.Auth.UseLoginToken(ctx, token+"a")
+		assert.Equal(t, InvalidLoginTokenError{}, err)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		token, err := c.Auth.GenerateLoginToken(ctx, usr.ID)
+		require.NoError(t, err)
+
+		// Tokens generated before the expiration was shortened should expire as well
+		cfg := *c.Config
+		cfg.App.LoginTokenExpiration = time.Nanosecond
+		_, err = NewAuthClient(&cfg, c.ORM).UseLoginToken(ctx, token)
+		assert.Equal(t, InvalidLoginTokenError{}, err)
+	})
+}
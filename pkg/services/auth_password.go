@@ -0,0 +1,265 @@
+package services
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// bcryptMaxLength stores the amount of bytes after which bcrypt ignores the rest of a password
+const bcryptMaxLength = 72
+
+// breachedHashPrefixLength stores the length of the hash prefixes breached passwords are looked up by
+const breachedHashPrefixLength = 5
+
+// PasswordPolicyError is an error returned when a password does not comply with the password policy
+type PasswordPolicyError struct {
+	// Reasons stores a message for each requirement the password does not meet
+	Reasons []string
+}
+
+// Error implements the error interface.
+func (e PasswordPolicyError) Error() string {
+	return "password does not comply with the policy: " + strings.Join(e.Reasons, " ")
+}
+
+// ValidatePassword checks that a password chosen by the user with a given name and email address complies with
+// the password policy, returning a PasswordPolicyError if it does not. The name and email address may be empty if
+// they are not known.
+func (c *AuthClient) ValidatePassword(password, name, email string) error {
+	var reasons []string
+	cfg := c.config.Auth.Password
+
+	if utf8.RuneCountInString(password) < cfg.MinLength {
+		reasons = append(reasons, fmt.Sprintf("Passwords must be at least %d characters long.", cfg.MinLength))
+	}
+
+	if len(password) > c.passwordMaxLength() {
+		reasons = append(reasons, fmt.Sprintf("Passwords cannot be longer than %d characters.", c.passwordMaxLength()))
+	}
+
+	if passwordCharacterClasses(password) < cfg.MinCharacterClasses {
+		reasons = append(reasons, fmt.Sprintf(
+			"Passwords must contain at least %d of lowercase letters, uppercase letters, digits and symbols.",
+			cfg.MinCharacterClasses,
+		))
+	}
+
+	for _, identity := range []string{name, email, strings.Split(email, "@")[0]} {
+		if identity != "" && strings.EqualFold(password, identity) {
+			reasons = append(reasons, "Passwords cannot be your name or email address.")
+			break
+		}
+	}
+
+	if len(reasons) > 0 {
+		return PasswordPolicyError{Reasons: reasons}
+	}
+
+	breached, err := c.IsPasswordBreached(password)
+	switch {
+	case err != nil:
+		return err
+	case breached:
+		return PasswordPolicyError{Reasons: []string{
+			"This password has appeared in a data breach. Please choose a different one.",
+		}}
+	}
+
+	return nil
+}
+
+// IsPasswordBreached checks if a password appears in the list of breached passwords, if one is configured.
+// Like the Pwned Passwords range API, only the hashes sharing the prefix of the hash of the password are read
+// from the list, which is searched without loading it in memory.
+func (c *AuthClient) IsPasswordBreached(password string) (bool, error) {
+	if c.config.Auth.Password.BreachedList == "" {
+		return false, nil
+	}
+
+	f, err := os.Open(c.config.Auth.Password.BreachedList)
+	if err != nil {
+		return false, fmt.Errorf("unable to open breached password list: %w", err)
+	}
+	defer f.Close()
+
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+
+	suffixes, err := breachedHashSuffixes(f, hash[:breachedHashPrefixLength])
+	if err != nil {
+		return false, fmt.Errorf("unable to read breached password list: %w", err)
+	}
+
+	for _, suffix := range suffixes {
+		if suffix == hash[breachedHashPrefixLength:] {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// RandomPassword generates a random password which complies with the password policy, apart from the breached
+// password check.
+func (c *AuthClient) RandomPassword() (string, error) {
+	classes := []string{
+		"abcdefghijklmnopqrstuvwxyz",
+		"ABCDEFGHIJKLMNOPQRSTUVWXYZ",
+		"0123456789",
+		"!@#$%^&*-_=+?",
+	}
+	length := min(max(c.config.Auth.Password.MinLength, 16), c.passwordMaxLength())
+
+	// Include a character of each class so the policy is met regardless of how many classes are required.
+	password := make([]byte, length)
+	for i := range password {
+		class := classes[i%len(classes)]
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(class))))
+		if err != nil {
+			return "", err
+		}
+		password[i] = class[n.Int64()]
+	}
+
+	// Shuffle the characters so the classes do not appear in a predictable order.
+	for i := len(password) - 1; i > 0; i-- {
+		j, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return "", err
+		}
+		password[i], password[j.Int64()] = password[j.Int64()], password[i]
+	}
+
+	return string(password), nil
+}
+
+// passwordMaxLength returns the maximum length of passwords in bytes.
+func (c *AuthClient) passwordMaxLength() int {
+	if m := c.config.Auth.Password.MaxLength; m > 0 && m < bcryptMaxLength {
+		return m
+	}
+	return bcryptMaxLength
+}
+
+// passwordCharacterClasses returns how many of lowercase letters, uppercase letters, digits and symbols a
+// password contains.
+func passwordCharacterClasses(password string) int {
+	var lower, upper, digit, symbol int
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = 1
+		case unicode.IsUpper(r):
+			upper = 1
+		case unicode.IsDigit(r):
+			digit = 1
+		default:
+			symbol = 1
+		}
+	}
+	return lower + upper + digit + symbol
+}
+
+// breachedHashSuffixes returns the suffixes of the hashes with a given prefix in a list of hashes sorted in
+// ascending order, one per line and optionally followed by ":count". The first line with the prefix is found using
+// a binary search over the byte offsets of the list.
+func breachedHashSuffixes(list io.ReaderAt, prefix string) ([]string, error) {
+	size, err := readerSize(list)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find the smallest offset at which the next line starts with a hash not lower than the prefix.
+	var searchErr error
+	offset := sort.Search(int(size)+1, func(i int) bool {
+		start, line, err := breachedListLineAt(list, int64(i), size)
+		if err != nil {
+			searchErr = err
+			return true
+		}
+		return start >= size || strings.ToUpper(line) >= prefix
+	})
+	if searchErr != nil {
+		return nil, searchErr
+	}
+
+	start, _, err := breachedListLineAt(list, int64(offset), size)
+	if err != nil {
+		return nil, err
+	}
+
+	var suffixes []string
+	scanner := bufio.NewScanner(io.NewSectionReader(list, start, size-start))
+	for scanner.Scan() {
+		hash, _, _ := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		hash = strings.ToUpper(hash)
+		if !strings.HasPrefix(hash, prefix) {
+			break
+		}
+		suffixes = append(suffixes, hash[len(prefix):])
+	}
+
+	return suffixes, scanner.Err()
+}
+
+// breachedListLineAt returns the offset and beginning of the first line of a list which starts at or after a
+// given offset.
+func breachedListLineAt(list io.ReaderAt, offset, size int64) (int64, string, error) {
+	buf := make([]byte, 128)
+
+	// Skip the rest of the line the offset falls within, unless it is the start of a line.
+	if offset > 0 {
+		for {
+			n, err := list.ReadAt(buf, offset-1)
+			if err != nil && err != io.EOF {
+				return 0, "", err
+			}
+			if i := bytes.IndexByte(buf[:n], '\n'); i >= 0 {
+				offset += int64(i)
+				break
+			}
+			offset += int64(n)
+			if offset > size {
+				return size, "", nil
+			}
+		}
+	}
+
+	if offset >= size {
+		return size, "", nil
+	}
+
+	n, err := list.ReadAt(buf, offset)
+	if err != nil && err != io.EOF {
+		return 0, "", err
+	}
+	line, _, _ := bytes.Cut(buf[:n], []byte("\n"))
+	return offset, strings.TrimSpace(string(line)), nil
+}
+
+// readerSize returns the size of a list of breached password hashes.
+func readerSize(r io.ReaderAt) (int64, error) {
+	switch r := r.(type) {
+	case *os.File:
+		info, err := r.Stat()
+		if err != nil {
+			return 0, err
+		}
+		return info.Size(), nil
+	case interface{ Size() int64 }:
+		return r.Size(), nil
+	default:
+		return 0, fmt.Errorf("unable to determine the size of %T", r)
+	}
+}
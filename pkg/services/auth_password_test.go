@@ -0,0 +1,92 @@
+package services
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthClient_ValidatePassword(t *testing.T) {
+	cfg := *c.Config
+	cfg.Auth.Password.MinLength = 8
+	cfg.Auth.Password.MaxLength = 100
+	cfg.Auth.Password.MinCharacterClasses = 3
+	cfg.Auth.Password.BreachedList = ""
+	client := NewAuthClient(&cfg, c.ORM)
+
+	tests := map[string]struct {
+		password string
+		valid    bool
+	}{
+		"valid":            {password: "Correct-horse", valid: true},
+		"too short":        {password: "Ab1!"},
+		"too long":         {password: strings.Repeat("Ab1!", 19)},
+		"too few classes":  {password: "correcthorse1"},
+		"name":             {password: "Jane Doe 1"},
+		"email":            {password: "Jane1@localhost.localhost"},
+		"email local part": {password: "JANE1"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := client.ValidatePassword(test.password, "jane doe 1", "jane1@localhost.localhost")
+			if test.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.IsType(t, PasswordPolicyError{}, err)
+			}
+		})
+	}
+
+	t.Run("random", func(t *testing.T) {
+		pw, err := client.RandomPassword()
+		require.NoError(t, err)
+		assert.NoError(t, client.ValidatePassword(pw, "", ""))
+	})
+}
+
+func TestAuthClient_IsPasswordBreached(t *testing.T) {
+	hash := func(pw string) string {
+		sum := sha1.Sum([]byte(pw))
+		return strings.ToUpper(hex.EncodeToString(sum[:]))
+	}
+
+	lines := []string{hash("Password1!") + ":120", hash("Summer2024!") + ":3"}
+	for i := range 500 {
+		lines = append(lines, hash(strings.Repeat("x", i))+":1")
+	}
+	sort.Strings(lines)
+
+	path := filepath.Join(t.TempDir(), "breached.txt")
+	require.NoError(t, os.WriteFile(path, []byte(strings.Join(lines, "\r\n")+"\r\n"), 0o600))
+
+	cfg := *c.Config
+	cfg.Auth.Password.BreachedList = path
+	client := NewAuthClient(&cfg, c.ORM)
+
+	for _, pw := range []string{"Password1!", "Summer2024!", "", strings.Repeat("x", 499)} {
+		breached, err := client.IsPasswordBreached(pw)
+		require.NoError(t, err)
+		assert.True(t, breached, pw)
+	}
+
+	breached, err := client.IsPasswordBreached("Correct-horse")
+	require.NoError(t, err)
+	assert.False(t, breached)
+
+	err = client.ValidatePassword("Password1!", "", "")
+	assert.IsType(t, PasswordPolicyError{}, err)
+
+	t.Run("missing list", func(t *testing.T) {
+		cfg.Auth.Password.BreachedList = filepath.Join(t.TempDir(), "missing.txt")
+		_, err := NewAuthClient(&cfg, c.ORM).IsPasswordBreached("Password1!")
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,135 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+
+	"entgo.io/ent/dialect"
+	"github.com/labstack/echo/v4"
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/permission"
+	"github.com/occult/pagode/ent/role"
+	"github.com/occult/pagode/pkg/authz"
+)
+
+// SyncPermissions ensures that all permissions defined in the authz package exist, and that the built-in admin
+// role exists and is granted all of them.
+func (c *AuthClient) SyncPermissions(ctx context.Context) error {
+	ids := make([]int, 0, len(authz.Permissions))
+	for name, description := range authz.Permissions {
+		p, err := c.orm.Permission.
+			Query().
+			Where(permission.Name(string(name))).
+			Only(ctx)
+
+		switch err.(type) {
+		case nil:
+			err = p.Update().
+				SetDescription(description).
+				Exec(ctx)
+		case *ent.NotFoundError:
+			p, err = c.orm.Permission.
+				Create().
+				SetName(string(name)).
+				SetDescription(description).
+				Save(ctx)
+		}
+		if err != nil {
+			return err
+		}
+
+		ids = append(ids, p.ID)
+	}
+
+	admin, err := c.orm.Role.
+		Query().
+		Where(role.Name(authz.AdminRole)).
+		Only(ctx)
+
+	switch err.(type) {
+	case nil:
+	case *ent.NotFoundError:
+		admin, err = c.orm.Role.
+			Create().
+			SetName(authz.AdminRole).
+			SetDescription("Grants all permissions").
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+	default:
+		return err
+	}
+
+	return admin.Update().
+		ClearPermissions().
+		AddPermissionIDs(ids...).
+		Exec(ctx)
+}
+
+// MigrateAdminFlag assigns the built-in admin role to every user whose admin flag, which roles replaced, is set in a
+// given database, and then drops the flag. Schema migrations never drop columns, so the flag remains in databases
+// created before roles existed until this runs. It does nothing once the flag is gone, so it can run on every start,
+// and must run after SyncPermissions created the admin role.
+// The statements are specific to SQLite, the database of the given driver, so other databases are left as they are and
+// have to be migrated by hand.
+func (c *AuthClient) MigrateAdminFlag(ctx context.Context, driver string, db *sql.DB) error {
+	if driver != dialect.SQLite {
+		return nil
+	}
+
+	var exists bool
+	err := db.QueryRowContext(ctx,
+		"SELECT COUNT(*) > 0 FROM pragma_table_info('users') WHERE name = 'admin'",
+	).Scan(&exists)
+	if err != nil || !exists {
+		return err
+	}
+
+	adminID, err := c.orm.Role.
+		Query().
+		Where(role.Name(authz.AdminRole)).
+		OnlyID(ctx)
+	if err != nil {
+		return err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"INSERT OR IGNORE INTO user_roles (user_id, role_id) SELECT id, ? FROM users WHERE admin",
+		adminID,
+	)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if _, err = tx.ExecContext(ctx, "ALTER TABLE users DROP COLUMN admin"); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetRoles returns all roles along with their permissions.
+func (c *AuthClient) GetRoles(ctx echo.Context) ([]*ent.Role, error) {
+	return c.orm.Role.
+		Query().
+		WithPermissions().
+		Order(ent.Asc(role.FieldName)).
+		All(ctx.Request().Context())
+}
+
+// SetUserRoles replaces the roles assigned to a given user.
+func (c *AuthClient) SetUserRoles(ctx echo.Context, userID int, roleIDs ...int) error {
+	return c.orm.User.
+		UpdateOneID(userID).
+		ClearRoles().
+		AddRoleIDs(roleIDs...).
+		Exec(ctx.Request().Context())
+}
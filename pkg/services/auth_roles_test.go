@@ -0,0 +1,93 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"github.com/occult/pagode/ent/role"
+	"github.com/occult/pagode/ent/user"
+	"github.com/occult/pagode/pkg/authz"
+	"github.com/occult/pagode/pkg/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthClient_SyncPermissions(t *testing.T) {
+	// Syncing again should not create any duplicates
+	require.NoError(t, c.Auth.SyncPermissions(context.Background()))
+
+	count, err := c.ORM.Permission.Query().Count(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, len(authz.Permissions), count)
+
+	admin, err := c.ORM.Role.
+		Query().
+		Where(role.Name(authz.AdminRole)).
+		WithPermissions().
+		Only(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, admin.Edges.Permissions, len(authz.Permissions))
+}
+
+func TestAuthClient_SetUserRoles(t *testing.T) {
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	roles, err := c.Auth.GetRoles(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, roles)
+
+	require.NoError(t, c.Auth.SetUserRoles(ctx, u.ID, roles[0].ID))
+	ids, err := u.QueryRoles().IDs(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []int{roles[0].ID}, ids)
+
+	require.NoError(t, c.Auth.SetUserRoles(ctx, u.ID))
+	count, err := u.QueryRoles().Count(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
+
+func TestAuthClient_MigrateAdminFlag(t *testing.T) {
+	ctx := context.Background()
+
+	// Databases created before roles existed still have the admin flag.
+	_, err := c.Database.Exec("ALTER TABLE users ADD COLUMN admin BOOLEAN NOT NULL DEFAULT false")
+	require.NoError(t, err)
+
+	admin, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	_, err = c.Database.Exec("UPDATE users SET admin = true WHERE id = ?", admin.ID)
+	require.NoError(t, err)
+
+	// Other databases are left as they are.
+	require.NoError(t, c.Auth.MigrateAdminFlag(ctx, dialect.Postgres, c.Database))
+	_, err = c.Database.Exec("SELECT admin FROM users")
+	require.NoError(t, err)
+
+	require.NoError(t, c.Auth.MigrateAdminFlag(ctx, c.Config.Database.Driver, c.Database))
+
+	isAdmin := func(userID int) bool {
+		exists, err := c.ORM.User.
+			Query().
+			Where(user.ID(userID)).
+			Where(user.HasRolesWith(role.Name(authz.AdminRole))).
+			Exist(ctx)
+		require.NoError(t, err)
+		return exists
+	}
+	assert.True(t, isAdmin(admin.ID))
+	assert.False(t, isAdmin(u.ID))
+
+	// The flag is dropped, so the admin role is not assigned again once it has been removed.
+	_, err = c.Database.Exec("SELECT admin FROM users")
+	assert.Error(t, err)
+
+	require.NoError(t, c.ORM.User.UpdateOneID(admin.ID).ClearRoles().Exec(ctx))
+	require.NoError(t, c.Auth.MigrateAdminFlag(ctx, c.Config.Database.Driver, c.Database))
+	assert.False(t, isAdmin(admin.ID))
+}
@@ -0,0 +1,77 @@
+package services
+
+import (
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/occult/pagode/ent"
+	entsession "github.com/occult/pagode/ent/session"
+	"github.com/occult/pagode/pkg/session"
+)
+
+// GetSessions returns all active sessions of a given user, most recently seen first
+func (c *AuthClient) GetSessions(ctx echo.Context, userID int) ([]*ent.Session, error) {
+	return c.orm.Session.
+		Query().
+		Where(entsession.UserID(userID)).
+		Where(entsession.ExpiresAtGT(time.Now())).
+		Order(ent.Desc(entsession.FieldLastSeenAt)).
+		All(ctx.Request().Context())
+}
+
+// IsCurrentSession determines if a given session is the session of the current request
+func (c *AuthClient) IsCurrentSession(ctx echo.Context, s *ent.Session) bool {
+	token := c.currentSessionToken(ctx)
+	return token != "" && s.Token == token
+}
+
+// RevokeSession revokes a session of a given user, which logs out the device the session belongs to
+func (c *AuthClient) RevokeSession(ctx echo.Context, userID, sessionID int) error {
+	deleted, err := c.orm.Session.
+		Delete().
+		Where(entsession.ID(sessionID)).
+		Where(entsession.UserID(userID)).
+		Exec(ctx.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	if deleted == 0 {
+		return &ent.NotFoundError{}
+	}
+
+	return nil
+}
+
+// RevokeOtherSessions revokes all sessions of a given user except for the session of the current request
+func (c *AuthClient) RevokeOtherSessions(ctx echo.Context, userID int) error {
+	q := c.orm.Session.
+		Delete().
+		Where(entsession.UserID(userID))
+
+	if token := c.currentSessionToken(ctx); token != "" {
+		q.Where(entsession.TokenNEQ(token))
+	}
+
+	_, err := q.Exec(ctx.Request().Context())
+	return err
+}
+
+// RevokeAllSessions revokes all sessions of a given user, including the session of the current request
+func (c *AuthClient) RevokeAllSessions(ctx echo.Context, userID int) error {
+	_, err := c.orm.Session.
+		Delete().
+		Where(entsession.UserID(userID)).
+		Exec(ctx.Request().Context())
+	return err
+}
+
+// currentSessionToken returns the hashed token of the authentication session of the current request, if it is
+// stored in the database
+func (c *AuthClient) currentSessionToken(ctx echo.Context) string {
+	sess, err := session.Get(ctx, AuthSessionName)
+	if err != nil || sess.ID == "" {
+		return ""
+	}
+	return hashSessionToken(sess.ID)
+}
@@ -0,0 +1,60 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	entsession "github.com/occult/pagode/ent/session"
+	"github.com/occult/pagode/pkg/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthClient_Sessions(t *testing.T) {
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	// Log in from two devices
+	ctx1 := newSessionContext()
+	require.NoError(t, c.Auth.Login(ctx1, u.ID))
+	ctx2 := newSessionContext()
+	require.NoError(t, c.Auth.Login(ctx2, u.ID))
+
+	sessions, err := c.Auth.GetSessions(ctx1, u.ID)
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	assert.NotEqual(t, c.Auth.IsCurrentSession(ctx1, sessions[0]), c.Auth.IsCurrentSession(ctx1, sessions[1]))
+
+	// Sessions of other users cannot be revoked
+	err = c.Auth.RevokeSession(ctx1, usr.ID, sessions[0].ID)
+	assert.Error(t, err)
+
+	// Logging out should delete the session, so its token can no longer be used
+	cookie := sessionCookie(t, ctx2, AuthSessionName)
+	require.NoError(t, c.Auth.Logout(ctx2))
+	sessions, err = c.Auth.GetSessions(ctx1, u.ID)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.True(t, c.Auth.IsCurrentSession(ctx1, sessions[0]))
+	exists, err := c.ORM.Session.
+		Query().
+		Where(entsession.Token(hashSessionToken(cookie.Value))).
+		Exist(context.Background())
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	// Revoking other sessions should keep the current session
+	ctx3 := newSessionContext()
+	require.NoError(t, c.Auth.Login(ctx3, u.ID))
+	require.NoError(t, c.Auth.RevokeOtherSessions(ctx1, u.ID))
+	sessions, err = c.Auth.GetSessions(ctx1, u.ID)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.True(t, c.Auth.IsCurrentSession(ctx1, sessions[0]))
+
+	require.NoError(t, c.Auth.RevokeAllSessions(ctx1, u.ID))
+	sessions, err = c.Auth.GetSessions(ctx1, u.ID)
+	require.NoError(t, err)
+	assert.Empty(t, sessions)
+}
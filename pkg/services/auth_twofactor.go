@@ -0,0 +1,457 @@
+package services
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/recoverycode"
+	"github.com/occult/pagode/ent/user"
+	"github.com/occult/pagode/pkg/session"
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+)
+
+const (
+	// authSessionKeyPendingUserID stores the key used to store the ID of the user who provided a valid password
+	// but still has to provide their second authentication factor
+	authSessionKeyPendingUserID = "pending_user_id"
+
+	// authSessionKeyPendingAt stores the key used to store when the second authentication factor was requested
+	authSessionKeyPendingAt = "pending_at"
+
+	// authSessionKeyTOTPEnrollment stores the key used to store the TOTP key URI a user is enrolling with
+	authSessionKeyTOTPEnrollment = "totp_enrollment"
+
+	// twoFactorPendingExpiration is how long a user has to provide their second authentication factor
+	twoFactorPendingExpiration = 10 * time.Minute
+
+	// twoFactorRememberCookieName stores the name of the cookie used to remember devices which can skip the
+	// second authentication factor
+	twoFactorRememberCookieName = "tfa_remember"
+
+	// recoveryCodeLength stores the length of each half of a generated recovery code
+	recoveryCodeLength = 5
+
+	// totpPeriod stores how long each TOTP code is valid for
+	totpPeriod = 30 * time.Second
+
+	// totpSecretPrefix prefixes TOTP secrets which are stored encrypted, as opposed to those stored before they were
+	totpSecretPrefix = "enc:"
+)
+
+// InvalidTwoFactorCodeError is an error returned when an invalid TOTP or recovery code is provided
+type InvalidTwoFactorCodeError struct{}
+
+// Error implements the error interface.
+func (e InvalidTwoFactorCodeError) Error() string {
+	return "invalid two-factor authentication code"
+}
+
+// GetTOTPEnrollmentKey returns the TOTP key a given user is enrolling in two-factor authentication with.
+// The key is generated and stored in the session until enrollment is confirmed, so the user can reload the page
+// after scanning the QR code. The key provides the secret, the otpauth URI and the QR code image.
+func (c *AuthClient) GetTOTPEnrollmentKey(ctx echo.Context, usr *ent.User) (*otp.Key, error) {
+	sess, err := session.Get(ctx, AuthSessionName)
+	if err != nil {
+		return nil, err
+	}
+
+	if uri, ok := sess.Values[authSessionKeyTOTPEnrollment].(string); ok {
+		if key, err := otp.NewKeyFromURL(uri); err == nil && key.AccountName() == usr.Email {
+			return key, nil
+		}
+	}
+
+	key, err := totp.Generate(totp.GenerateOpts{
+		Issuer:      c.config.App.Name,
+		AccountName: usr.Email,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sess.Values[authSessionKeyTOTPEnrollment] = key.URL()
+	return key, sess.Save(ctx.Request(), ctx.Response())
+}
+
+// ValidateTOTP checks if a given code is currently valid for the TOTP secret of a given user, and records its time
+// step, so neither it nor any earlier code can be used again
+func (c *AuthClient) ValidateTOTP(ctx context.Context, usr *ent.User, code string) (bool, error) {
+	secret, err := c.decryptTOTPSecret(usr.TotpSecret)
+	if err != nil {
+		return false, err
+	}
+
+	step, ok := validateTOTP(code, secret)
+	if !ok || step <= usr.TotpLastStep {
+		return false, nil
+	}
+
+	// Only record the step if it is still later than the last one, in case the code was used concurrently.
+	update := c.orm.User.
+		Update().
+		Where(user.ID(usr.ID), user.TotpLastStepLT(step)).
+		SetTotpLastStep(step)
+
+	// Encrypt secrets which were stored before they were encrypted.
+	if !strings.HasPrefix(usr.TotpSecret, totpSecretPrefix) {
+		encrypted, err := c.encryptTOTPSecret(secret)
+		if err != nil {
+			return false, err
+		}
+		update.SetTotpSecret(encrypted)
+	}
+
+	n, err := update.Save(ctx)
+	return n > 0, err
+}
+
+// validateTOTP checks if a given code is valid for a given TOTP secret at the current time step, or at the one
+// before or after it to allow for clock skew, and returns the time step it is valid for
+func validateTOTP(code, secret string) (int64, bool) {
+	code = strings.ReplaceAll(strings.TrimSpace(code), " ", "")
+	if secret == "" {
+		return 0, false
+	}
+
+	now := time.Now()
+	step := now.Unix() / int64(totpPeriod.Seconds())
+	for skew := int64(-1); skew <= 1; skew++ {
+		valid, err := totp.ValidateCustom(code, secret, now.Add(time.Duration(skew)*totpPeriod), totp.ValidateOpts{
+			Period:    uint(totpPeriod.Seconds()),
+			Digits:    otp.DigitsSix,
+			Algorithm: otp.AlgorithmSHA1,
+		})
+		if err == nil && valid {
+			return step + skew, true
+		}
+	}
+
+	return 0, false
+}
+
+// EnableTwoFactor confirms the enrollment of a given user in two-factor authentication using a code generated
+// from their enrollment key, stores the TOTP secret and returns a new set of recovery codes
+func (c *AuthClient) EnableTwoFactor(ctx echo.Context, usr *ent.User, code string) ([]string, error) {
+	key, err := c.GetTOTPEnrollmentKey(ctx, usr)
+	if err != nil {
+		return nil, err
+	}
+
+	step, ok := validateTOTP(code, key.Secret())
+	if !ok {
+		return nil, InvalidTwoFactorCodeError{}
+	}
+
+	secret, err := c.encryptTOTPSecret(key.Secret())
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.orm.User.
+		UpdateOneID(usr.ID).
+		SetTotpSecret(secret).
+		SetTotpLastStep(step).
+		Exec(ctx.Request().Context())
+	if err != nil {
+		return nil, err
+	}
+
+	sess, err := session.Get(ctx, AuthSessionName)
+	if err != nil {
+		return nil, err
+	}
+	delete(sess.Values, authSessionKeyTOTPEnrollment)
+	if err = sess.Save(ctx.Request(), ctx.Response()); err != nil {
+		return nil, err
+	}
+
+	return c.GenerateRecoveryCodes(ctx, usr.ID)
+}
+
+// DisableTwoFactor removes the TOTP secret and all recovery codes for a given user
+func (c *AuthClient) DisableTwoFactor(ctx echo.Context, userID int) error {
+	tx, err := c.orm.Tx(ctx.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.RecoveryCode.
+		Delete().
+		Where(recoverycode.UserID(userID)).
+		Exec(ctx.Request().Context())
+	if err != nil {
+		return rollback(tx, err)
+	}
+
+	err = tx.User.
+		UpdateOneID(userID).
+		ClearTotpSecret().
+		SetTotpLastStep(0).
+		Exec(ctx.Request().Context())
+	if err != nil {
+		return rollback(tx, err)
+	}
+
+	return tx.Commit()
+}
+
+// GenerateRecoveryCodes replaces all recovery codes for a given user with a new set.
+// As with password tokens, only a hash of each code is stored so the codes are only returned here.
+func (c *AuthClient) GenerateRecoveryCodes(ctx echo.Context, userID int) ([]string, error) {
+	codes := make([]string, c.config.App.TwoFactor.RecoveryCodes)
+	for i := range codes {
+		first, err := c.RandomToken(recoveryCodeLength)
+		if err != nil {
+			return nil, err
+		}
+		second, err := c.RandomToken(recoveryCodeLength)
+		if err != nil {
+			return nil, err
+		}
+		codes[i] = fmt.Sprintf("%s-%s", first, second)
+	}
+
+	tx, err := c.orm.Tx(ctx.Request().Context())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.RecoveryCode.
+		Delete().
+		Where(recoverycode.UserID(userID)).
+		Exec(ctx.Request().Context())
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	builders := make([]*ent.RecoveryCodeCreate, len(codes))
+	for i, code := range codes {
+		builders[i] = tx.RecoveryCode.
+			Create().
+			SetCode(code).
+			SetUserID(userID)
+	}
+
+	if err = tx.RecoveryCode.CreateBulk(builders...).Exec(ctx.Request().Context()); err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	return codes, tx.Commit()
+}
+
+// CountRecoveryCodes returns the amount of unused recovery codes a given user has remaining
+func (c *AuthClient) CountRecoveryCodes(ctx echo.Context, userID int) (int, error) {
+	return c.orm.RecoveryCode.
+		Query().
+		Where(recoverycode.UserID(userID)).
+		Where(recoverycode.UsedAtIsNil()).
+		Count(ctx.Request().Context())
+}
+
+// UseRecoveryCode checks a recovery code for a given user and, if it is valid, marks it as used so it cannot be
+// used again
+func (c *AuthClient) UseRecoveryCode(ctx echo.Context, userID int, code string) error {
+	codes, err := c.orm.RecoveryCode.
+		Query().
+		Where(recoverycode.UserID(userID)).
+		Where(recoverycode.UsedAtIsNil()).
+		All(ctx.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	code = strings.ToLower(strings.TrimSpace(code))
+	for _, rc := range codes {
+		if err := c.CheckPassword(code, rc.Code); err != nil {
+			continue
+		}
+
+		// Only mark the code as used if it has not been used in the meantime.
+		updated, err := c.orm.RecoveryCode.
+			Update().
+			Where(recoverycode.ID(rc.ID)).
+			Where(recoverycode.UsedAtIsNil()).
+			SetUsedAt(time.Now()).
+			Save(ctx.Request().Context())
+		if err != nil {
+			return err
+		}
+		if updated == 1 {
+			return nil
+		}
+	}
+
+	return InvalidTwoFactorCodeError{}
+}
+
+// SetPendingTwoFactorUserID stores the ID of a user who provided a valid password and still has to provide their
+// second authentication factor before they can be logged in
+func (c *AuthClient) SetPendingTwoFactorUserID(ctx echo.Context, userID int) error {
+	sess, err := session.Get(ctx, AuthSessionName)
+	if err != nil {
+		return err
+	}
+	sess.Values[authSessionKeyPendingUserID] = userID
+	sess.Values[authSessionKeyPendingAt] = time.Now().Unix()
+	return sess.Save(ctx.Request(), ctx.Response())
+}
+
+// GetPendingTwoFactorUserID returns the ID of the user who still has to provide their second authentication factor
+func (c *AuthClient) GetPendingTwoFactorUserID(ctx echo.Context) (int, error) {
+	sess, err := session.Get(ctx, AuthSessionName)
+	if err != nil {
+		return 0, err
+	}
+
+	userID, ok := sess.Values[authSessionKeyPendingUserID].(int)
+	if !ok {
+		return 0, NotAuthenticatedError{}
+	}
+
+	pendingAt, ok := sess.Values[authSessionKeyPendingAt].(int64)
+	if !ok || time.Since(time.Unix(pendingAt, 0)) > twoFactorPendingExpiration {
+		return 0, NotAuthenticatedError{}
+	}
+
+	return userID, nil
+}
+
+// RememberTwoFactorDevice sets a signed cookie which allows the requesting device to skip the second
+// authentication factor for a given user until it expires
+func (c *AuthClient) RememberTwoFactorDevice(ctx echo.Context, usr *ent.User) error {
+	expiration := c.config.App.TwoFactor.RememberDeviceExpiration
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub": strconv.Itoa(usr.ID),
+		"sec": twoFactorSecretFingerprint(usr.TotpSecret),
+		"exp": time.Now().Add(expiration).Unix(),
+	})
+
+	signed, err := token.SignedString([]byte(c.config.App.EncryptionKey))
+	if err != nil {
+		return err
+	}
+
+	ctx.SetCookie(&http.Cookie{
+		Name:     twoFactorRememberCookieName,
+		Value:    signed,
+		Path:     "/",
+		MaxAge:   int(expiration.Seconds()),
+		HttpOnly: true,
+		Secure:   c.config.HTTP.TLS.Enabled,
+		SameSite: http.SameSiteStrictMode,
+	})
+
+	return nil
+}
+
+// IsTwoFactorDeviceRemembered determines if the requesting device has a valid cookie allowing it to skip the
+// second authentication factor for a given user.
+// Cookies are no longer valid once the user's TOTP secret changes.
+func (c *AuthClient) IsTwoFactorDeviceRemembered(ctx echo.Context, usr *ent.User) bool {
+	cookie, err := ctx.Cookie(twoFactorRememberCookieName)
+	if err != nil {
+		return false
+	}
+
+	t, err := jwt.Parse(cookie.Value, func(t *jwt.Token) (any, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
+		}
+
+		return []byte(c.config.App.EncryptionKey), nil
+	})
+	if err != nil || !t.Valid {
+		return false
+	}
+
+	claims, ok := t.Claims.(jwt.MapClaims)
+	if !ok {
+		return false
+	}
+
+	return claims["sub"] == strconv.Itoa(usr.ID) &&
+		claims["sec"] == twoFactorSecretFingerprint(usr.TotpSecret)
+}
+
+// encryptTOTPSecret encrypts a TOTP secret with the encryption key, to be stored
+func (c *AuthClient) encryptTOTPSecret(secret string) (string, error) {
+	gcm, err := c.totpSecretCipher()
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	sealed := gcm.Seal(nonce, nonce, []byte(secret), nil)
+	return totpSecretPrefix + base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+// decryptTOTPSecret decrypts a stored TOTP secret, which is returned as is if it was stored before secrets were
+// encrypted
+func (c *AuthClient) decryptTOTPSecret(stored string) (string, error) {
+	encoded, ok := strings.CutPrefix(stored, totpSecretPrefix)
+	if !ok {
+		return stored, nil
+	}
+
+	sealed, err := base64.RawStdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", err
+	}
+
+	gcm, err := c.totpSecretCipher()
+	if err != nil {
+		return "", err
+	}
+
+	if len(sealed) < gcm.NonceSize() {
+		return "", errors.New("invalid encrypted TOTP secret")
+	}
+
+	secret, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], nil)
+	return string(secret), err
+}
+
+// totpSecretCipher returns the AES-GCM cipher TOTP secrets are encrypted with, keyed by the encryption key
+func (c *AuthClient) totpSecretCipher() (cipher.AEAD, error) {
+	key := sha256.Sum256([]byte(c.config.App.EncryptionKey))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// twoFactorSecretFingerprint provides a short, non-reversible fingerprint of a TOTP secret
+func twoFactorSecretFingerprint(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:8])
+}
+
+// rollback rolls back a given transaction and returns the error which caused it
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		return fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+	}
+	return err
+}
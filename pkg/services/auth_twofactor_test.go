@@ -0,0 +1,172 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/occult/pagode/pkg/tests"
+	"github.com/pquerna/otp/totp"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthClient_EnableTwoFactor(t *testing.T) {
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	// The enrollment key should be reused until enrollment is confirmed
+	key, err := c.Auth.GetTOTPEnrollmentKey(ctx, u)
+	require.NoError(t, err)
+	key2, err := c.Auth.GetTOTPEnrollmentKey(ctx, u)
+	require.NoError(t, err)
+	assert.Equal(t, key.Secret(), key2.Secret())
+	assert.Equal(t, u.Email, key.AccountName())
+
+	// An invalid code should not enable two-factor authentication
+	_, err = c.Auth.EnableTwoFactor(ctx, u, "000000x")
+	assert.True(t, errors.Is(err, InvalidTwoFactorCodeError{}))
+
+	code, err := totp.GenerateCode(key.Secret(), time.Now())
+	require.NoError(t, err)
+	codes, err := c.Auth.EnableTwoFactor(ctx, u, code)
+	require.NoError(t, err)
+	assert.Len(t, codes, c.Config.App.TwoFactor.RecoveryCodes)
+
+	u, err = c.ORM.User.Get(context.Background(), u.ID)
+	require.NoError(t, err)
+	// The secret should be stored encrypted
+	assert.NotContains(t, u.TotpSecret, key.Secret())
+	secret, err := c.Auth.decryptTOTPSecret(u.TotpSecret)
+	require.NoError(t, err)
+	assert.Equal(t, key.Secret(), secret)
+
+	// Codes should only be accepted once, and not once a later code has been
+	valid, err := c.Auth.ValidateTOTP(context.Background(), u, code)
+	require.NoError(t, err)
+	assert.False(t, valid)
+	next, err := totp.GenerateCode(key.Secret(), time.Now().Add(totpPeriod))
+	require.NoError(t, err)
+	valid, err = c.Auth.ValidateTOTP(context.Background(), u, next)
+	require.NoError(t, err)
+	assert.True(t, valid)
+	u, err = c.ORM.User.Get(context.Background(), u.ID)
+	require.NoError(t, err)
+	valid, err = c.Auth.ValidateTOTP(context.Background(), u, next)
+	require.NoError(t, err)
+	assert.False(t, valid)
+	previous, err := totp.GenerateCode(key.Secret(), time.Now().Add(-totpPeriod))
+	require.NoError(t, err)
+	valid, err = c.Auth.ValidateTOTP(context.Background(), u, previous)
+	require.NoError(t, err)
+	assert.False(t, valid)
+
+	// A new enrollment key should be generated once enrollment is confirmed
+	key2, err = c.Auth.GetTOTPEnrollmentKey(ctx, u)
+	require.NoError(t, err)
+	assert.NotEqual(t, key.Secret(), key2.Secret())
+
+	// Disabling should remove the secret and the recovery codes
+	err = c.Auth.DisableTwoFactor(ctx, u.ID)
+	require.NoError(t, err)
+	u, err = c.ORM.User.Get(context.Background(), u.ID)
+	require.NoError(t, err)
+	assert.Empty(t, u.TotpSecret)
+	assert.Zero(t, u.TotpLastStep)
+	count, err := c.Auth.CountRecoveryCodes(ctx, u.ID)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
+
+func TestAuthClient_ValidateTOTP_Unencrypted(t *testing.T) {
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	u, err = u.Update().SetTotpSecret("JBSWY3DPEHPK3PXP").Save(context.Background())
+	require.NoError(t, err)
+
+	// Secrets stored before they were encrypted should still be valid, and be encrypted once used
+	code, err := totp.GenerateCode("JBSWY3DPEHPK3PXP", time.Now())
+	require.NoError(t, err)
+	valid, err := c.Auth.ValidateTOTP(context.Background(), u, code)
+	require.NoError(t, err)
+	assert.True(t, valid)
+
+	u, err = c.ORM.User.Get(context.Background(), u.ID)
+	require.NoError(t, err)
+	assert.NotEqual(t, "JBSWY3DPEHPK3PXP", u.TotpSecret)
+	secret, err := c.Auth.decryptTOTPSecret(u.TotpSecret)
+	require.NoError(t, err)
+	assert.Equal(t, "JBSWY3DPEHPK3PXP", secret)
+}
+
+func TestAuthClient_UseRecoveryCode(t *testing.T) {
+	codes, err := c.Auth.GenerateRecoveryCodes(ctx, usr.ID)
+	require.NoError(t, err)
+	require.Len(t, codes, c.Config.App.TwoFactor.RecoveryCodes)
+
+	err = c.Auth.UseRecoveryCode(ctx, usr.ID, "fakecode")
+	assert.True(t, errors.Is(err, InvalidTwoFactorCodeError{}))
+
+	// Codes can only be used once
+	err = c.Auth.UseRecoveryCode(ctx, usr.ID, codes[0])
+	require.NoError(t, err)
+	err = c.Auth.UseRecoveryCode(ctx, usr.ID, codes[0])
+	assert.True(t, errors.Is(err, InvalidTwoFactorCodeError{}))
+
+	count, err := c.Auth.CountRecoveryCodes(ctx, usr.ID)
+	require.NoError(t, err)
+	assert.Equal(t, len(codes)-1, count)
+
+	// Regenerating should invalidate all previous codes
+	_, err = c.Auth.GenerateRecoveryCodes(ctx, usr.ID)
+	require.NoError(t, err)
+	err = c.Auth.UseRecoveryCode(ctx, usr.ID, codes[1])
+	assert.True(t, errors.Is(err, InvalidTwoFactorCodeError{}))
+}
+
+func TestAuthClient_PendingTwoFactorUserID(t *testing.T) {
+	err := c.Auth.SetPendingTwoFactorUserID(ctx, usr.ID)
+	require.NoError(t, err)
+
+	uid, err := c.Auth.GetPendingTwoFactorUserID(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, usr.ID, uid)
+
+	// Logging in should clear the pending user
+	err = c.Auth.Login(ctx, usr.ID)
+	require.NoError(t, err)
+	_, err = c.Auth.GetPendingTwoFactorUserID(ctx)
+	assert.True(t, errors.Is(err, NotAuthenticatedError{}))
+
+	err = c.Auth.Logout(ctx)
+	require.NoError(t, err)
+}
+
+func TestAuthClient_RememberTwoFactorDevice(t *testing.T) {
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	u, err = u.Update().SetTotpSecret("JBSWY3DPEHPK3PXP").Save(context.Background())
+	require.NoError(t, err)
+
+	ctx1, rec := tests.NewContext(c.Web, "/")
+	assert.False(t, c.Auth.IsTwoFactorDeviceRemembered(ctx1, u))
+	err = c.Auth.RememberTwoFactorDevice(ctx1, u)
+	require.NoError(t, err)
+
+	cookies := rec.Result().Cookies()
+	require.Len(t, cookies, 1)
+
+	ctx2, _ := tests.NewContext(c.Web, "/")
+	ctx2.Request().AddCookie(cookies[0])
+	assert.True(t, c.Auth.IsTwoFactorDeviceRemembered(ctx2, u))
+
+	// The cookie should not apply to other users
+	assert.False(t, c.Auth.IsTwoFactorDeviceRemembered(ctx2, usr))
+
+	// The cookie should no longer be valid once the secret changes
+	u, err = u.Update().SetTotpSecret("KRSXG5CTMVRXEZLU").Save(context.Background())
+	require.NoError(t, err)
+	assert.False(t, c.Auth.IsTwoFactorDeviceRemembered(ctx2, u))
+}
@@ -4,10 +4,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"hash/fnv"
+	"reflect"
+	"slices"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/maypok86/otter"
+	"golang.org/x/sync/singleflight"
 )
 
 // ErrCacheMiss indicates that the requested key does not exist in the cache
@@ -22,6 +27,10 @@ type (
 		// set attempts to set an entry in the cache
 		set(context.Context, *CacheSetOp) error
 
+		// swap attempts to set an entry in the cache if the data of its key is still the given data, or if the key
+		// does not exist if the given data is nil, and reports whether the entry was set
+		swap(ctx context.Context, op *CacheSetOp, old any) (bool, error)
+
 		// flush removes a given key and/or tags from the cache
 		flush(context.Context, *CacheFlushOp) error
 
@@ -29,10 +38,56 @@ type (
 		close()
 	}
 
+	// cacheStatsStore is implemented by cache stores which can report statistics about their entries
+	cacheStatsStore interface {
+		// stats returns the statistics of the entries in the cache storage
+		stats(context.Context) (CacheStats, error)
+	}
+
+	// CacheStats contains statistics about the cache
+	CacheStats struct {
+		// Hits is the amount of fetches which found data, since the client was created
+		Hits uint64
+
+		// Misses is the amount of fetches which found no data, since the client was created
+		Misses uint64
+
+		// StoreStats indicates that the store reports the statistics below, which are otherwise empty
+		StoreStats bool
+
+		// Entries is the amount of entries in the cache
+		Entries int
+
+		// Capacity is the maximum amount of entries in the cache, or zero if it is unbounded
+		Capacity int
+
+		// Evictions is the amount of entries removed to make room for others
+		Evictions uint64
+
+		// Expirations is the amount of expired entries which were removed
+		Expirations uint64
+
+		// Tags is the amount of distinct tags of the entries in the cache
+		Tags int
+
+		// TaggedEntries is the amount of entries in the cache with at least one tag
+		TaggedEntries int
+	}
+
 	// CacheClient is the client that allows you to interact with the cache
 	CacheClient struct {
 		// store holds the Cache storage
 		store CacheStore
+
+		// codec encodes the data of typed operations
+		codec CacheCodec
+
+		// loads deduplicates concurrent loads of values to remember
+		loads singleflight.Group
+
+		// hits and misses count the results of fetches
+		hits   atomic.Uint64
+		misses atomic.Uint64
 	}
 
 	// CacheSetOp handles chaining a set operation
@@ -43,13 +98,15 @@ type (
 		data       any
 		expiration time.Duration
 		tags       []string
+		version    int
 	}
 
 	// CacheGetOp handles chaining a get operation
 	CacheGetOp struct {
-		client *CacheClient
-		key    string
-		group  string
+		client  *CacheClient
+		key     string
+		group   string
+		version int
 	}
 
 	// CacheFlushOp handles chaining a flush operation
@@ -62,28 +119,47 @@ type (
 
 	// inMemoryCacheStore is a cache store implementation in memory
 	inMemoryCacheStore struct {
-		store    *otter.CacheWithVariableTTL[string, any]
-		tagIndex *tagIndex
+		store       *otter.CacheWithVariableTTL[string, *inMemoryCacheEntry]
+		tagIndex    *tagIndex
+		evictions   atomic.Uint64
+		expirations atomic.Uint64
+
+		// now returns the current time, which entries expire against
+		now func() time.Time
+
+		// locks serialize sets and swaps of the keys hashed to each of them, so a swap cannot be interleaved with
+		// another write of its key.
+		locks [64]sync.Mutex
+	}
+
+	// inMemoryCacheEntry is an entry of the in-memory cache store, which carries its tags so they can be removed from
+	// the tag index along with it.
+	inMemoryCacheEntry struct {
+		value     any
+		tags      []string
+		expiresAt time.Time
 	}
 
 	// tagIndex maintains an index to support cache tags for in-memory cache stores.
-	// There is a performance and memory impact to using cache tags since set and get operations using tags will require
-	// locking, and we need to keep track of this index in order to keep everything in sync.
+	// There is a performance and memory impact to using cache tags since set and flush operations using tags will
+	// require locking, and we need to keep track of this index in order to keep everything in sync.
 	// If using something like Redis for caching, you can leverage sets to store the index.
-	// Cache tags can be useful and convenient, so you should decide if your app benefits enough from this.
-	// As it stands here, there is no limiting how much memory this will consume and it will track all keys
-	// and tags added and removed from the cache. You could store these in the cache itself but allowing these to
-	// be evicted poses challenges.
+	// The index only holds entries which are in the cache, since they are removed from it whenever the cache deletes
+	// them, whether they are replaced, flushed, expired or evicted. Its footprint is therefore bounded by the capacity
+	// of the cache and the amount of tags per entry. Deletions are notified asynchronously and possibly out of order,
+	// so entries are removed by identity rather than by key, to never remove the tags of a newer entry with the same key.
 	tagIndex struct {
 		sync.Mutex
-		tags map[string]map[string]struct{} // tag->keys
-		keys map[string]map[string]struct{} // key->tags
+		tags map[string]map[string]*inMemoryCacheEntry // tag->key->entry
 	}
 )
 
 // NewCacheClient creates a new cache client
-func NewCacheClient(store CacheStore) *CacheClient {
-	return &CacheClient{store: store}
+func NewCacheClient(store CacheStore, codec CacheCodec) *CacheClient {
+	return &CacheClient{
+		store: store,
+		codec: codec,
+	}
 }
 
 // Close closes the connection to the cache
@@ -91,6 +167,24 @@ func (c *CacheClient) Close() {
 	c.store.close()
 }
 
+// Stats returns statistics about the cache, including those about its entries if the store reports them
+func (c *CacheClient) Stats(ctx context.Context) (CacheStats, error) {
+	var stats CacheStats
+
+	if s, ok := c.store.(cacheStatsStore); ok {
+		var err error
+		if stats, err = s.stats(ctx); err != nil {
+			return stats, err
+		}
+		stats.StoreStats = true
+	}
+
+	stats.Hits = c.hits.Load()
+	stats.Misses = c.misses.Load()
+
+	return stats, nil
+}
+
 // Set creates a cache set operation
 func (c *CacheClient) Set() *CacheSetOp {
 	return &CacheSetOp{
@@ -150,6 +244,12 @@ func (c *CacheSetOp) Tags(tags ...string) *CacheSetOp {
 	return c
 }
 
+// Version sets the version of the format of the data, when saved with SaveAs
+func (c *CacheSetOp) Version(version int) *CacheSetOp {
+	c.version = version
+	return c
+}
+
 // Save saves the data in the cache
 func (c *CacheSetOp) Save(ctx context.Context) error {
 	switch {
@@ -164,6 +264,23 @@ func (c *CacheSetOp) Save(ctx context.Context) error {
 	return c.client.store.set(ctx, c)
 }
 
+// Swap saves the data in the cache only if the data of the key is still the given data, which was fetched before,
+// or if the key does not exist if the given data is nil. It reports whether the data was saved, which is not the case
+// if the data of the key was changed in the meantime. This allows data to be updated atomically, even by several
+// instances of the app sharing the cache, by fetching it and retrying until the swap succeeds.
+func (c *CacheSetOp) Swap(ctx context.Context, old any) (bool, error) {
+	switch {
+	case c.key == "":
+		return false, errors.New("no cache key specified")
+	case c.data == nil:
+		return false, errors.New("no cache data specified")
+	case c.expiration == 0:
+		return false, errors.New("no cache expiration specified")
+	}
+
+	return c.client.store.swap(ctx, c, old)
+}
+
 // Key sets the cache key
 func (c *CacheGetOp) Key(key string) *CacheGetOp {
 	c.key = key
@@ -176,13 +293,27 @@ func (c *CacheGetOp) Group(group string) *CacheGetOp {
 	return c
 }
 
+// Version sets the version of the format of the data, when fetched with FetchAs
+func (c *CacheGetOp) Version(version int) *CacheGetOp {
+	c.version = version
+	return c
+}
+
 // Fetch fetches the data from the cache
 func (c *CacheGetOp) Fetch(ctx context.Context) (any, error) {
 	if c.key == "" {
 		return nil, errors.New("no cache key specified")
 	}
 
-	return c.client.store.get(ctx, c)
+	v, err := c.client.store.get(ctx, c)
+	switch {
+	case err == nil:
+		c.client.hits.Add(1)
+	case errors.Is(err, ErrCacheMiss):
+		c.client.misses.Add(1)
+	}
+
+	return v, err
 }
 
 // Key sets the cache key
@@ -212,12 +343,19 @@ func (c *CacheFlushOp) Execute(ctx context.Context) error {
 func newInMemoryCache(capacity int) (CacheStore, error) {
 	s := &inMemoryCacheStore{
 		tagIndex: newTagIndex(),
+		now:      time.Now,
 	}
 
-	store, err := otter.MustBuilder[string, any](capacity).
+	store, err := otter.MustBuilder[string, *inMemoryCacheEntry](capacity).
 		WithVariableTTL().
-		DeletionListener(func(key string, value any, cause otter.DeletionCause) {
-			s.tagIndex.purgeKeys(key)
+		DeletionListener(func(key string, entry *inMemoryCacheEntry, cause otter.DeletionCause) {
+			switch cause {
+			case otter.Size:
+				s.evictions.Add(1)
+			case otter.Expired:
+				s.expirations.Add(1)
+			}
+			s.tagIndex.remove(key, entry)
 		}).
 		Build()
 
@@ -231,121 +369,212 @@ func newInMemoryCache(capacity int) (CacheStore, error) {
 }
 
 func (s *inMemoryCacheStore) get(_ context.Context, op *CacheGetOp) (any, error) {
-	v, exists := s.store.Get(op.client.cacheKey(op.group, op.key))
+	key := op.client.cacheKey(op.group, op.key)
+	entry, exists := s.store.Get(key)
 
 	if !exists {
 		return nil, ErrCacheMiss
 	}
 
-	return v, nil
+	// Otter only tracks time to the second, so expire the entry here rather than serve it past its expiration.
+	if s.expired(entry) {
+		mu := s.lock(key)
+		mu.Lock()
+		s.expireLocked(key, entry)
+		mu.Unlock()
+		return nil, ErrCacheMiss
+	}
+
+	return entry.value, nil
 }
 
 func (s *inMemoryCacheStore) set(_ context.Context, op *CacheSetOp) error {
 	key := op.client.cacheKey(op.group, op.key)
 
-	added := s.store.Set(
-		key,
-		op.data,
-		op.expiration,
-	)
+	mu := s.lock(key)
+	mu.Lock()
+	defer mu.Unlock()
+
+	return s.setLocked(key, op)
+}
 
-	if len(op.tags) > 0 {
-		s.tagIndex.setTags(key, op.tags...)
+func (s *inMemoryCacheStore) swap(_ context.Context, op *CacheSetOp, old any) (bool, error) {
+	key := op.client.cacheKey(op.group, op.key)
+
+	mu := s.lock(key)
+	mu.Lock()
+	defer mu.Unlock()
+
+	entry, exists := s.store.Get(key)
+	if exists && s.expired(entry) {
+		s.expireLocked(key, entry)
+		exists = false
 	}
 
-	if !added {
-		return errors.New("cache set failed")
+	if exists != (old != nil) || (exists && !reflect.DeepEqual(entry.value, old)) {
+		return false, nil
 	}
 
-	return nil
+	return true, s.setLocked(key, op)
 }
 
-func (s *inMemoryCacheStore) flush(_ context.Context, op *CacheFlushOp) error {
-	keys := make([]string, 0)
+// setLocked sets an entry under a given key, while the lock of the key is held.
+func (s *inMemoryCacheStore) setLocked(key string, op *CacheSetOp) error {
+	entry := &inMemoryCacheEntry{
+		value:     op.data,
+		tags:      slices.Clone(op.tags),
+		expiresAt: s.now().Add(op.expiration),
+	}
 
-	if key := op.client.cacheKey(op.group, op.key); key != "" {
-		keys = append(keys, key)
+	// Index the entry first, so its deletion can never be notified before it is indexed.
+	s.tagIndex.add(key, entry)
+
+	if !s.store.Set(key, entry, op.expiration) {
+		s.tagIndex.remove(key, entry)
+		return errors.New("cache set failed")
 	}
 
-	if len(op.tags) > 0 {
-		keys = append(keys, s.tagIndex.purgeTags(op.tags...)...)
+	return nil
+}
+
+// expireLocked removes an expired entry, unless its key has since been set again, while the lock of the key is held.
+func (s *inMemoryCacheStore) expireLocked(key string, entry *inMemoryCacheEntry) {
+	if current, exists := s.store.Get(key); exists && current == entry {
+		s.store.Delete(key)
+		s.tagIndex.remove(key, entry)
+		s.expirations.Add(1)
 	}
+}
 
-	for _, key := range keys {
+func (s *inMemoryCacheStore) flush(_ context.Context, op *CacheFlushOp) error {
+	if key := op.client.cacheKey(op.group, op.key); key != "" {
+		mu := s.lock(key)
+		mu.Lock()
+		if entry, exists := s.store.Get(key); exists {
+			s.tagIndex.remove(key, entry)
+		}
 		s.store.Delete(key)
+		mu.Unlock()
 	}
 
-	s.tagIndex.purgeKeys(keys...)
+	for key, entry := range s.tagIndex.purgeTags(op.tags...) {
+		// Leave the key alone if it has since been set again.
+		mu := s.lock(key)
+		mu.Lock()
+		if current, exists := s.store.Get(key); exists && current == entry {
+			s.store.Delete(key)
+		}
+		mu.Unlock()
+	}
 
 	return nil
 }
 
+func (s *inMemoryCacheStore) stats(_ context.Context) (CacheStats, error) {
+	tags, entries := s.tagIndex.size()
+
+	return CacheStats{
+		Entries:       s.store.Size(),
+		Capacity:      s.store.Capacity(),
+		Evictions:     s.evictions.Load(),
+		Expirations:   s.expirations.Load(),
+		Tags:          tags,
+		TaggedEntries: entries,
+	}, nil
+}
+
+// lock returns the lock of a given key.
+func (s *inMemoryCacheStore) lock(key string) *sync.Mutex {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	return &s.locks[h.Sum32()%uint32(len(s.locks))]
+}
+
+// expired returns true if an entry has passed its expiration.
+func (s *inMemoryCacheStore) expired(entry *inMemoryCacheEntry) bool {
+	return !s.now().Before(entry.expiresAt)
+}
+
 func (s *inMemoryCacheStore) close() {
 	s.store.Close()
 }
 
 func newTagIndex() *tagIndex {
 	return &tagIndex{
-		tags: make(map[string]map[string]struct{}),
-		keys: make(map[string]map[string]struct{}),
+		tags: make(map[string]map[string]*inMemoryCacheEntry),
 	}
 }
 
-func (i *tagIndex) setTags(key string, tags ...string) {
+// add indexes an entry under each of its tags.
+func (i *tagIndex) add(key string, entry *inMemoryCacheEntry) {
+	if len(entry.tags) == 0 {
+		return
+	}
+
 	i.Lock()
 	defer i.Unlock()
 
-	if _, exists := i.keys[key]; !exists {
-		i.keys[key] = make(map[string]struct{})
-	}
-
-	for _, tag := range tags {
+	for _, tag := range entry.tags {
 		if _, exists := i.tags[tag]; !exists {
-			i.tags[tag] = make(map[string]struct{})
+			i.tags[tag] = make(map[string]*inMemoryCacheEntry)
 		}
-		i.tags[tag][key] = struct{}{}
-		i.keys[key][tag] = struct{}{}
+		i.tags[tag][key] = entry
 	}
 }
 
-func (i *tagIndex) purgeTags(tags ...string) []string {
+// remove removes an entry from the index, unless another entry has since been indexed under the same key.
+func (i *tagIndex) remove(key string, entry *inMemoryCacheEntry) {
+	if len(entry.tags) == 0 {
+		return
+	}
+
 	i.Lock()
 	defer i.Unlock()
 
-	keys := make([]string, 0)
+	i.removeLocked(key, entry)
+}
 
-	for _, tag := range tags {
-		if tagKeys, exists := i.tags[tag]; exists {
+func (i *tagIndex) removeLocked(key string, entry *inMemoryCacheEntry) {
+	for _, tag := range entry.tags {
+		if i.tags[tag][key] != entry {
+			continue
+		}
+
+		delete(i.tags[tag], key)
+		if len(i.tags[tag]) == 0 {
 			delete(i.tags, tag)
+		}
+	}
+}
+
+// purgeTags removes the entries of given tags from the index and returns them by key.
+func (i *tagIndex) purgeTags(tags ...string) map[string]*inMemoryCacheEntry {
+	i.Lock()
+	defer i.Unlock()
 
-			for key := range tagKeys {
-				delete(i.keys[key], tag)
-				if len(i.keys[key]) == 0 {
-					delete(i.keys, key)
-				}
+	entries := make(map[string]*inMemoryCacheEntry)
 
-				keys = append(keys, key)
-			}
+	for _, tag := range tags {
+		for key, entry := range i.tags[tag] {
+			i.removeLocked(key, entry)
+			entries[key] = entry
 		}
 	}
 
-	return keys
+	return entries
 }
 
-func (i *tagIndex) purgeKeys(keys ...string) {
+// size returns the amount of tags and of entries in the index.
+func (i *tagIndex) size() (int, int) {
 	i.Lock()
 	defer i.Unlock()
 
-	for _, key := range keys {
-		if keyTags, exists := i.keys[key]; exists {
-			delete(i.keys, key)
-
-			for tag := range keyTags {
-				delete(i.tags[tag], key)
-				if len(i.tags[tag]) == 0 {
-					delete(i.tags, tag)
-				}
-			}
+	keys := make(map[string]struct{})
+	for _, tagKeys := range i.tags {
+		for key := range tagKeys {
+			keys[key] = struct{}{}
 		}
 	}
+
+	return len(i.tags), len(keys)
 }
@@ -0,0 +1,127 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+type (
+	// CacheCodec encodes and decodes the data of typed cache operations, so it can be stored outside the app
+	CacheCodec interface {
+		// Name identifies the codec in the data it encodes, so data encoded by another codec is not decoded
+		Name() string
+
+		// Marshal encodes a value
+		Marshal(v any) ([]byte, error)
+
+		// Unmarshal decodes data into the value a given pointer points to
+		Unmarshal(data []byte, v any) error
+	}
+
+	// JSONCacheCodec is a CacheCodec which encodes data as JSON
+	JSONCacheCodec struct{}
+
+	// GobCacheCodec is a CacheCodec which encodes data with gob
+	GobCacheCodec struct{}
+
+	// MsgpackCacheCodec is a CacheCodec which encodes data as MessagePack, which is more compact than JSON.
+	// Times are decoded in the local time zone.
+	MsgpackCacheCodec struct{}
+)
+
+// Name implements CacheCodec.
+func (JSONCacheCodec) Name() string {
+	return "json"
+}
+
+// Marshal implements CacheCodec.
+func (JSONCacheCodec) Marshal(v any) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+// Unmarshal implements CacheCodec.
+func (JSONCacheCodec) Unmarshal(data []byte, v any) error {
+	return json.Unmarshal(data, v)
+}
+
+// Name implements CacheCodec.
+func (GobCacheCodec) Name() string {
+	return "gob"
+}
+
+// Marshal implements CacheCodec.
+func (GobCacheCodec) Marshal(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Unmarshal implements CacheCodec.
+func (GobCacheCodec) Unmarshal(data []byte, v any) error {
+	return gob.NewDecoder(bytes.NewReader(data)).Decode(v)
+}
+
+// Name implements CacheCodec.
+func (MsgpackCacheCodec) Name() string {
+	return "msgpack"
+}
+
+// Marshal implements CacheCodec.
+func (MsgpackCacheCodec) Marshal(v any) ([]byte, error) {
+	return msgpack.Marshal(v)
+}
+
+// Unmarshal implements CacheCodec.
+func (MsgpackCacheCodec) Unmarshal(data []byte, v any) error {
+	return msgpack.Unmarshal(data, v)
+}
+
+// SaveAs encodes data with the codec of the cache client and saves it in the cache, along with the name of the codec
+// and the version set on the operation. Unlike Save, this works the same with all cache stores, as long as the data
+// can be encoded.
+func SaveAs[T any](ctx context.Context, op *CacheSetOp, data T) error {
+	b, err := op.client.codec.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("unable to encode cache data: %w", err)
+	}
+
+	op.data = append(cacheDataHeader(op.client.codec, op.version), b...)
+
+	return op.Save(ctx)
+}
+
+// FetchAs fetches data saved in the cache by SaveAs and decodes it into a given type.
+// ErrCacheMiss is returned if the data was encoded by a different codec or its version differs from the one set on
+// the operation, so the format of data can be changed safely by changing its version.
+func FetchAs[T any](ctx context.Context, op *CacheGetOp) (T, error) {
+	var data T
+
+	v, err := op.Fetch(ctx)
+	if err != nil {
+		return data, err
+	}
+
+	b, ok := v.([]byte)
+	header := cacheDataHeader(op.client.codec, op.version)
+	if !ok || !bytes.HasPrefix(b, header) {
+		return data, ErrCacheMiss
+	}
+
+	if err = op.client.codec.Unmarshal(b[len(header):], &data); err != nil {
+		return data, fmt.Errorf("unable to decode cache data: %w", err)
+	}
+
+	return data, nil
+}
+
+// cacheDataHeader returns the header which precedes data encoded by a given codec in a given version.
+func cacheDataHeader(codec CacheCodec, version int) []byte {
+	return fmt.Appendf(nil, "%s/%d\n", codec.Name(), version)
+}
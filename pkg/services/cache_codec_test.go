@@ -0,0 +1,120 @@
+package services
+
+import (
+	"context"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type codecTest struct {
+	String  string
+	Long    string
+	Int     int
+	Small   int8
+	Neg     int
+	Big     int64
+	Huge    uint64
+	Float   float64
+	Bool    bool
+	Time    time.Time
+	Bytes   []byte
+	Pointer *int
+	Slice   []string
+	Map     map[string]int
+	Nested  []codecTestItem
+	Skipped string `json:"-"`
+}
+
+type codecTestItem struct {
+	Name string `json:"name"`
+}
+
+func TestCacheCodecs(t *testing.T) {
+	one := 1
+	data := codecTest{
+		String:  "abc",
+		Long:    strings.Repeat("x", 70000),
+		Int:     300,
+		Small:   -100,
+		Neg:     -70000,
+		Big:     math.MinInt64,
+		Huge:    math.MaxUint64,
+		Float:   1.5,
+		Bool:    true,
+		Time:    time.Date(2026, 1, 2, 3, 4, 5, 6, time.UTC),
+		Bytes:   []byte{0, 1, 2},
+		Pointer: &one,
+		Slice:   []string{"a", "b"},
+		Map:     map[string]int{"a": 1, "b": -1},
+		Nested:  make([]codecTestItem, 20),
+	}
+	for i := range data.Nested {
+		data.Nested[i].Name = strings.Repeat("n", i)
+	}
+
+	for _, codec := range []CacheCodec{JSONCacheCodec{}, GobCacheCodec{}, MsgpackCacheCodec{}} {
+		t.Run(codec.Name(), func(t *testing.T) {
+			store, err := newInMemoryCache(10)
+			require.NoError(t, err)
+			client := NewCacheClient(store, codec)
+			defer client.Close()
+			ctx := context.Background()
+
+			err = SaveAs(ctx, client.Set().Group("codec").Key("data").Expiration(time.Hour).Version(2), data)
+			require.NoError(t, err)
+
+			got, err := FetchAs[codecTest](ctx, client.Get().Group("codec").Key("data").Version(2))
+			require.NoError(t, err)
+			// Not every codec keeps the location of times.
+			assert.True(t, data.Time.Equal(got.Time))
+			got.Time = data.Time
+			assert.Equal(t, data, got)
+
+			// Data in another version is not decoded.
+			_, err = FetchAs[codecTest](ctx, client.Get().Group("codec").Key("data").Version(1))
+			assert.Equal(t, ErrCacheMiss, err)
+
+			// Data of another codec is not decoded.
+			other := NewCacheClient(store, otherCacheCodec{codec})
+			_, err = FetchAs[codecTest](ctx, other.Get().Group("codec").Key("data").Version(2))
+			assert.Equal(t, ErrCacheMiss, err)
+
+			// Data which was not saved by SaveAs is not decoded.
+			err = client.Set().Key("untyped").Data(data).Expiration(time.Hour).Save(ctx)
+			require.NoError(t, err)
+			_, err = FetchAs[codecTest](ctx, client.Get().Key("untyped"))
+			assert.Equal(t, ErrCacheMiss, err)
+		})
+	}
+}
+
+func TestMsgpackCacheCodec(t *testing.T) {
+	codec := MsgpackCacheCodec{}
+
+	b, err := codec.Marshal(map[string]any{"a": []int{1, -1}})
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x81, 0xa1, 'a', 0x92, 0x01, 0xff}, b)
+
+	var v map[string]any
+	err = codec.Unmarshal([]byte{0x81, 0xa1, 'a', 0xcb, 0x3f, 0xf8, 0, 0, 0, 0, 0, 0}, &v)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"a": 1.5}, v)
+
+	// Truncated data
+	err = codec.Unmarshal([]byte{0x92, 0x01}, &v)
+	assert.Error(t, err)
+}
+
+// otherCacheCodec encodes data like a given codec, under another name.
+type otherCacheCodec struct {
+	CacheCodec
+}
+
+func (otherCacheCodec) Name() string {
+	return "other"
+}
@@ -0,0 +1,478 @@
+package services
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/occult/pagode/config"
+)
+
+type (
+	// redisCacheStore is a cache store implementation for servers which speak the Redis protocol (RESP), so the cache
+	// can be shared by several instances of the app.
+	// Values are encoded with gob, so types other than the basic ones must be registered with gob.Register.
+	// The keys of each tag are stored in a set, which expires along with the last of its keys. An entry and the sets
+	// of its tags are written in a single transaction, which requires Redis 7 or Valkey.
+	redisCacheStore struct {
+		config config.RedisConfig
+
+		mu   sync.Mutex
+		idle []*respConn
+	}
+
+	// respConn is a connection to a RESP server.
+	respConn struct {
+		conn net.Conn
+		r    *bufio.Reader
+		w    *bufio.Writer
+	}
+
+	// respError is an error reply from a RESP server.
+	respError string
+)
+
+// Error implements the error interface.
+func (e respError) Error() string {
+	return string(e)
+}
+
+// newRedisCache creates a new CacheStore which connects to a RESP server
+func newRedisCache(cfg config.RedisConfig) (CacheStore, error) {
+	s := &redisCacheStore{config: cfg}
+
+	// Check that the server can be reached.
+	conn, err := s.conn(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	if _, err = conn.do("PING"); err != nil {
+		conn.close()
+		return nil, err
+	}
+	s.release(conn, nil)
+
+	return s, nil
+}
+
+func (s *redisCacheStore) get(ctx context.Context, op *CacheGetOp) (any, error) {
+	reply, err := s.do(ctx, "GET", s.key(op.client.cacheKey(op.group, op.key)))
+	if err != nil {
+		return nil, err
+	}
+
+	b, ok := reply.([]byte)
+	if !ok {
+		return nil, ErrCacheMiss
+	}
+
+	return decodeCacheValue(b)
+}
+
+func (s *redisCacheStore) set(ctx context.Context, op *CacheSetOp) error {
+	b, err := encodeCacheValue(op.data)
+	if err != nil {
+		return err
+	}
+
+	// An entry without tags is set with a single command, which needs no transaction.
+	commands := s.setCommands(s.key(op.client.cacheKey(op.group, op.key)), b, op)
+	if len(commands) == 1 {
+		_, err = s.do(ctx, commands[0][0], commands[0][1:]...)
+	} else {
+		_, err = s.exec(ctx, commands...)
+	}
+	return err
+}
+
+func (s *redisCacheStore) swap(ctx context.Context, op *CacheSetOp, old any) (bool, error) {
+	b, err := encodeCacheValue(op.data)
+	if err != nil {
+		return false, err
+	}
+
+	var oldValue []byte
+	if old != nil {
+		if oldValue, err = encodeCacheValue(old); err != nil {
+			return false, err
+		}
+	}
+
+	conn, err := s.conn(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	swapped, err := s.swapOn(conn, s.key(op.client.cacheKey(op.group, op.key)), b, oldValue, op)
+	s.release(conn, err)
+
+	return swapped, err
+}
+
+// swapOn swaps the value of a key using a given connection. The key is watched, so the transaction setting it is
+// aborted if it changes after its current value has been compared.
+func (s *redisCacheStore) swapOn(conn *respConn, key string, value, old []byte, op *CacheSetOp) (bool, error) {
+	if _, err := conn.do("WATCH", key); err != nil {
+		return false, err
+	}
+
+	reply, err := conn.do("GET", key)
+	if err != nil {
+		_, _ = conn.do("UNWATCH")
+		return false, err
+	}
+
+	if current, _ := reply.([]byte); (current == nil) != (old == nil) || !bytes.Equal(current, old) {
+		_, err = conn.do("UNWATCH")
+		return false, err
+	}
+
+	replies, err := conn.exec(s.setCommands(key, value, op)...)
+	return replies != nil, err
+}
+
+// setCommands returns the commands which set an entry and add it to the sets of its tags. These must be executed in
+// a transaction, so a failure or a concurrent flush can never leave an entry which is missing from the set of one of
+// its tags.
+func (s *redisCacheStore) setCommands(key string, value []byte, op *CacheSetOp) [][]string {
+	ttl := strconv.FormatInt(op.expiration.Milliseconds(), 10)
+
+	commands := [][]string{{"SET", key, string(value), "PX", ttl}}
+	for _, tag := range op.tags {
+		tagKey := s.tagKey(tag)
+		commands = append(commands,
+			[]string{"SADD", tagKey, key},
+			// Keep the set of keys for as long as the longest lived of them.
+			[]string{"PEXPIRE", tagKey, ttl, "NX"},
+			[]string{"PEXPIRE", tagKey, ttl, "GT"},
+		)
+	}
+
+	return commands
+}
+
+func (s *redisCacheStore) flush(ctx context.Context, op *CacheFlushOp) error {
+	keys := make([]string, 0)
+
+	if key := op.client.cacheKey(op.group, op.key); key != "" {
+		keys = append(keys, s.key(key))
+	}
+
+	tagKeys := make([]string, 0, len(op.tags))
+	for _, tag := range op.tags {
+		tagKeys = append(tagKeys, s.tagKey(tag))
+	}
+
+	switch {
+	case len(tagKeys) > 0:
+	case len(keys) == 0:
+		return nil
+	default:
+		_, err := s.do(ctx, "DEL", keys...)
+		return err
+	}
+
+	conn, err := s.conn(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Start over whenever a key was added to one of the tags since its keys were read.
+	for {
+		flushed, err := s.flushOn(conn, keys, tagKeys)
+		if err != nil || flushed {
+			s.release(conn, err)
+			return err
+		}
+	}
+}
+
+// flushOn deletes given keys, along with the keys of given tags and their sets, using a given connection. The sets
+// are watched, so the transaction deleting them is aborted if a key is added to them after they have been read.
+func (s *redisCacheStore) flushOn(conn *respConn, keys, tagKeys []string) (bool, error) {
+	if _, err := conn.do("WATCH", tagKeys...); err != nil {
+		return false, err
+	}
+
+	keys = slices.Concat(keys, tagKeys)
+
+	for _, tagKey := range tagKeys {
+		reply, err := conn.do("SMEMBERS", tagKey)
+		if err != nil {
+			_, _ = conn.do("UNWATCH")
+			return false, err
+		}
+
+		members, _ := reply.([]any)
+		for _, m := range members {
+			if b, ok := m.([]byte); ok {
+				keys = append(keys, string(b))
+			}
+		}
+	}
+
+	replies, err := conn.exec(append([]string{"DEL"}, keys...))
+	return replies != nil, err
+}
+
+func (s *redisCacheStore) close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, conn := range s.idle {
+		conn.close()
+	}
+	s.idle = nil
+}
+
+// key returns the key a cache key is stored under.
+func (s *redisCacheStore) key(key string) string {
+	return s.config.Prefix + key
+}
+
+// tagKey returns the key the set of keys of a cache tag is stored under.
+func (s *redisCacheStore) tagKey(tag string) string {
+	return fmt.Sprintf("%stag::%s", s.config.Prefix, tag)
+}
+
+// do executes a command using an idle connection or a new one.
+func (s *redisCacheStore) do(ctx context.Context, command string, args ...string) (any, error) {
+	conn, err := s.conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reply, err := conn.do(command, args...)
+	s.release(conn, err)
+
+	return reply, err
+}
+
+// exec executes commands in a transaction using an idle connection or a new one.
+func (s *redisCacheStore) exec(ctx context.Context, commands ...[]string) ([]any, error) {
+	conn, err := s.conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	replies, err := conn.exec(commands...)
+	s.release(conn, err)
+
+	return replies, err
+}
+
+// conn returns an idle connection, or else a new one, with its deadline set for a command.
+func (s *redisCacheStore) conn(ctx context.Context) (*respConn, error) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(s.config.Timeout)
+	}
+
+	s.mu.Lock()
+	if n := len(s.idle); n > 0 {
+		conn := s.idle[n-1]
+		s.idle = s.idle[:n-1]
+		s.mu.Unlock()
+		return conn, conn.conn.SetDeadline(deadline)
+	}
+	s.mu.Unlock()
+
+	d := net.Dialer{Deadline: deadline}
+	nc, err := d.DialContext(ctx, "tcp", s.config.Address)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to cache server: %w", err)
+	}
+	if err = nc.SetDeadline(deadline); err != nil {
+		nc.Close()
+		return nil, err
+	}
+
+	conn := &respConn{
+		conn: nc,
+		r:    bufio.NewReader(nc),
+		w:    bufio.NewWriter(nc),
+	}
+
+	if s.config.Password != "" {
+		if _, err = conn.do("AUTH", s.config.Password); err != nil {
+			conn.close()
+			return nil, err
+		}
+	}
+
+	if s.config.Database != 0 {
+		if _, err = conn.do("SELECT", strconv.Itoa(s.config.Database)); err != nil {
+			conn.close()
+			return nil, err
+		}
+	}
+
+	return conn, nil
+}
+
+// release returns a connection to the idle connections, unless the command it was used for failed in a way which
+// may have left it in an unknown state.
+func (s *redisCacheStore) release(conn *respConn, err error) {
+	var re respError
+	if err != nil && !errors.As(err, &re) {
+		conn.close()
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.idle) >= s.config.MaxIdleConnections {
+		conn.close()
+		return
+	}
+	s.idle = append(s.idle, conn)
+}
+
+// do sends a command and reads its reply.
+func (c *respConn) do(command string, args ...string) (any, error) {
+	c.write(append([]string{command}, args...))
+	if err := c.w.Flush(); err != nil {
+		return nil, err
+	}
+
+	return c.read()
+}
+
+// exec sends commands in a MULTI/EXEC transaction, so they are executed together, and returns their replies. If a
+// watched key changed, the transaction is aborted and nil is returned.
+func (c *respConn) exec(commands ...[]string) ([]any, error) {
+	c.write([]string{"MULTI"})
+	for _, command := range commands {
+		c.write(command)
+	}
+	c.write([]string{"EXEC"})
+	if err := c.w.Flush(); err != nil {
+		return nil, err
+	}
+
+	// All replies must be read to keep the connection usable, even once a command failed to be queued, in which
+	// case the transaction is discarded.
+	var queueErr error
+	for range len(commands) + 1 {
+		if _, err := c.read(); err != nil {
+			var re respError
+			if !errors.As(err, &re) {
+				return nil, err
+			}
+			if queueErr == nil {
+				queueErr = err
+			}
+		}
+	}
+
+	reply, err := c.read()
+	switch {
+	case queueErr != nil:
+		return nil, queueErr
+	case err != nil:
+		return nil, err
+	case reply == nil:
+		return nil, nil
+	}
+
+	replies, _ := reply.([]any)
+	for _, r := range replies {
+		if re, ok := r.(respError); ok {
+			return nil, re
+		}
+	}
+
+	return replies, nil
+}
+
+// write buffers a command.
+func (c *respConn) write(command []string) {
+	fmt.Fprintf(c.w, "*%d\r\n", len(command))
+	for _, arg := range command {
+		fmt.Fprintf(c.w, "$%d\r\n%s\r\n", len(arg), arg)
+	}
+}
+
+// read reads a reply, which is either a string, an integer, a byte slice, a slice of replies, or nil. Errors within
+// a slice of replies are returned as elements of type respError.
+func (c *respConn) read() (any, error) {
+	line, err := c.r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	if len(line) < 3 || line[len(line)-2] != '\r' {
+		return nil, fmt.Errorf("invalid reply from cache server: %q", line)
+	}
+	kind, body := line[0], line[1:len(line)-2]
+
+	switch kind {
+	case '+':
+		return body, nil
+	case '-':
+		return nil, respError(body)
+	case ':':
+		return strconv.ParseInt(body, 10, 64)
+	case '$':
+		n, err := strconv.Atoi(body)
+		if err != nil || n < 0 {
+			return nil, err
+		}
+		b := make([]byte, n+2)
+		if _, err = io.ReadFull(c.r, b); err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	case '*':
+		n, err := strconv.Atoi(body)
+		if err != nil || n < 0 {
+			return nil, err
+		}
+		replies := make([]any, n)
+		for i := range replies {
+			replies[i], err = c.read()
+			var re respError
+			switch {
+			case errors.As(err, &re):
+				replies[i] = re
+			case err != nil:
+				return nil, err
+			}
+		}
+		return replies, nil
+	default:
+		return nil, fmt.Errorf("invalid reply from cache server: %q", line)
+	}
+}
+
+// close closes the connection.
+func (c *respConn) close() {
+	_ = c.conn.Close()
+}
+
+// encodeCacheValue encodes a value along with its type, so it can be stored outside the app.
+func encodeCacheValue(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(&v); err != nil {
+		return nil, fmt.Errorf("unable to encode cache value: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// decodeCacheValue decodes a value encoded by encodeCacheValue.
+func decodeCacheValue(b []byte) (any, error) {
+	var v any
+	if err := gob.NewDecoder(bytes.NewReader(b)).Decode(&v); err != nil {
+		return nil, fmt.Errorf("unable to decode cache value: %w", err)
+	}
+	return v, nil
+}
@@ -0,0 +1,531 @@
+package services
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/occult/pagode/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// respServer is an in-process stand-in for a Redis server which supports the commands used by redisCacheStore.
+type respServer struct {
+	listener net.Listener
+	password string
+
+	mu      sync.Mutex
+	strings map[string]string
+	sets    map[string]map[string]struct{}
+	expires map[string]time.Time
+
+	// versions counts the changes of each key, for transactions watching keys.
+	versions map[string]int
+
+	// dropOn is a command which makes the server drop the connection instead of replying.
+	dropOn string
+
+	// after is called after each command which is not part of a transaction, before its reply is sent.
+	after func(cmd string)
+}
+
+func newRESPServer(t *testing.T, password string) *respServer {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	s := &respServer{
+		listener: l,
+		password: password,
+		strings:  make(map[string]string),
+		sets:     make(map[string]map[string]struct{}),
+		expires:  make(map[string]time.Time),
+		versions: make(map[string]int),
+	}
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+
+	t.Cleanup(func() {
+		_ = l.Close()
+	})
+
+	return s
+}
+
+func (s *respServer) serve(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	authenticated := s.password == ""
+
+	// The state of a transaction on this connection.
+	var queued [][]string
+	inTransaction := false
+	watched := make(map[string]int)
+
+	for {
+		args, err := s.readCommand(r)
+		if err != nil {
+			return
+		}
+
+		s.mu.Lock()
+		drop := s.dropOn != "" && strings.EqualFold(args[0], s.dropOn)
+		s.mu.Unlock()
+		if drop {
+			return
+		}
+
+		var reply string
+		switch cmd := strings.ToUpper(args[0]); {
+		case cmd == "AUTH":
+			authenticated = args[1] == s.password
+			reply = "+OK\r\n"
+			if !authenticated {
+				reply = "-WRONGPASS invalid password\r\n"
+			}
+		case !authenticated:
+			reply = "-NOAUTH Authentication required.\r\n"
+		case cmd == "WATCH":
+			s.mu.Lock()
+			for _, key := range args[1:] {
+				watched[key] = s.versions[key]
+			}
+			s.mu.Unlock()
+			reply = "+OK\r\n"
+		case cmd == "UNWATCH":
+			clear(watched)
+			reply = "+OK\r\n"
+		case cmd == "MULTI":
+			inTransaction = true
+			reply = "+OK\r\n"
+		case cmd == "EXEC":
+			reply = s.executeTransaction(queued, watched)
+			queued, inTransaction = nil, false
+			clear(watched)
+		case inTransaction:
+			queued = append(queued, args)
+			reply = "+QUEUED\r\n"
+		default:
+			s.mu.Lock()
+			reply = s.execute(cmd, args[1:])
+			after := s.after
+			s.mu.Unlock()
+			if after != nil {
+				after(cmd)
+			}
+		}
+
+		if _, err = io.WriteString(conn, reply); err != nil {
+			return
+		}
+	}
+}
+
+func (s *respServer) readCommand(r *bufio.Reader) ([]string, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(line[1:]))
+	if err != nil {
+		return nil, err
+	}
+
+	args := make([]string, n)
+	for i := range args {
+		if line, err = r.ReadString('\n'); err != nil {
+			return nil, err
+		}
+		size, err := strconv.Atoi(strings.TrimSpace(line[1:]))
+		if err != nil {
+			return nil, err
+		}
+		b := make([]byte, size+2)
+		if _, err = io.ReadFull(r, b); err != nil {
+			return nil, err
+		}
+		args[i] = string(b[:size])
+	}
+
+	return args, nil
+}
+
+// executeTransaction executes queued commands at once, unless one of the watched keys changed.
+func (s *respServer) executeTransaction(queued [][]string, watched map[string]int) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for key, version := range watched {
+		if s.versions[key] != version {
+			return "*-1\r\n"
+		}
+	}
+
+	reply := fmt.Sprintf("*%d\r\n", len(queued))
+	for _, args := range queued {
+		reply += s.execute(strings.ToUpper(args[0]), args[1:])
+	}
+	return reply
+}
+
+// execute executes a command, while the lock is held.
+func (s *respServer) execute(cmd string, args []string) string {
+	switch cmd {
+	case "SET", "SADD", "PEXPIRE":
+		s.versions[args[0]]++
+	case "DEL":
+		for _, key := range args {
+			s.versions[key]++
+		}
+	}
+
+	for key, at := range s.expires {
+		if time.Now().After(at) {
+			delete(s.strings, key)
+			delete(s.sets, key)
+			delete(s.expires, key)
+		}
+	}
+
+	bulk := func(v string) string {
+		return fmt.Sprintf("$%d\r\n%s\r\n", len(v), v)
+	}
+
+	switch cmd {
+	case "PING":
+		return "+PONG\r\n"
+	case "SELECT":
+		return "+OK\r\n"
+	case "GET":
+		v, ok := s.strings[args[0]]
+		if !ok {
+			return "$-1\r\n"
+		}
+		return bulk(v)
+	case "SET":
+		s.strings[args[0]] = args[1]
+		delete(s.expires, args[0])
+		if len(args) == 4 && strings.ToUpper(args[2]) == "PX" {
+			ms, _ := strconv.Atoi(args[3])
+			s.expires[args[0]] = time.Now().Add(time.Duration(ms) * time.Millisecond)
+		}
+		return "+OK\r\n"
+	case "DEL":
+		deleted := 0
+		for _, key := range args {
+			_, isString := s.strings[key]
+			_, isSet := s.sets[key]
+			if isString || isSet {
+				deleted++
+			}
+			delete(s.strings, key)
+			delete(s.sets, key)
+			delete(s.expires, key)
+		}
+		return fmt.Sprintf(":%d\r\n", deleted)
+	case "SADD":
+		if _, isString := s.strings[args[0]]; isString {
+			return "-WRONGTYPE Operation against a key holding the wrong kind of value\r\n"
+		}
+		if _, ok := s.sets[args[0]]; !ok {
+			s.sets[args[0]] = make(map[string]struct{})
+		}
+		for _, m := range args[1:] {
+			s.sets[args[0]][m] = struct{}{}
+		}
+		return fmt.Sprintf(":%d\r\n", len(args)-1)
+	case "SMEMBERS":
+		reply := fmt.Sprintf("*%d\r\n", len(s.sets[args[0]]))
+		for m := range s.sets[args[0]] {
+			reply += bulk(m)
+		}
+		return reply
+	case "PTTL":
+		_, isString := s.strings[args[0]]
+		_, isSet := s.sets[args[0]]
+		at, expires := s.expires[args[0]]
+		switch {
+		case !isString && !isSet:
+			return ":-2\r\n"
+		case !expires:
+			return ":-1\r\n"
+		default:
+			return fmt.Sprintf(":%d\r\n", time.Until(at).Milliseconds())
+		}
+	case "PEXPIRE":
+		ms, _ := strconv.Atoi(args[1])
+		at := time.Now().Add(time.Duration(ms) * time.Millisecond)
+		current, expires := s.expires[args[0]]
+		if len(args) == 3 {
+			switch strings.ToUpper(args[2]) {
+			case "NX":
+				if expires {
+					return ":0\r\n"
+				}
+			case "GT":
+				if !expires || !at.After(current) {
+					return ":0\r\n"
+				}
+			}
+		}
+		s.expires[args[0]] = at
+		return ":1\r\n"
+	default:
+		return fmt.Sprintf("-ERR unknown command '%s'\r\n", cmd)
+	}
+}
+
+func newRedisCacheTestClient(t *testing.T, srv *respServer, password string) *CacheClient {
+	store, err := newRedisCache(config.RedisConfig{
+		Address:            srv.listener.Addr().String(),
+		Password:           password,
+		Database:           1,
+		Prefix:             "test:",
+		Timeout:            time.Second,
+		MaxIdleConnections: 2,
+	})
+	require.NoError(t, err)
+
+	client := NewCacheClient(store, GobCacheCodec{})
+	t.Cleanup(client.Close)
+	return client
+}
+
+func TestRedisCacheStore(t *testing.T) {
+	srv := newRESPServer(t, "secret")
+	client := newRedisCacheTestClient(t, srv, "secret")
+	ctx := context.Background()
+
+	data := encodedCacheTest{Value: "abcdef"}
+	group := "testgroup"
+	key := "testkey"
+
+	err := client.
+		Set().
+		Group(group).
+		Key(key).
+		Data(data).
+		Expiration(time.Hour).
+		Save(ctx)
+	require.NoError(t, err)
+
+	// Keys are stored with the prefix.
+	srv.mu.Lock()
+	_, exists := srv.strings["test:testgroup::testkey"]
+	srv.mu.Unlock()
+	assert.True(t, exists)
+
+	fromCache, err := client.
+		Get().
+		Group(group).
+		Key(key).
+		Fetch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, data, fromCache)
+
+	// The same key with the wrong group should fail
+	_, err = client.
+		Get().
+		Key(key).
+		Fetch(ctx)
+	assert.Equal(t, ErrCacheMiss, err)
+
+	err = client.
+		Flush().
+		Group(group).
+		Key(key).
+		Execute(ctx)
+	require.NoError(t, err)
+
+	assertFlushed := func(key string) {
+		_, err := client.
+			Get().
+			Group(group).
+			Key(key).
+			Fetch(ctx)
+		assert.Equal(t, ErrCacheMiss, err)
+	}
+	assertFlushed(key)
+
+	// Set with tags
+	for _, k := range []string{"testkey2", "testkey3"} {
+		err = client.
+			Set().
+			Group(group).
+			Key(k).
+			Data(data).
+			Tags("tag1", "tag2").
+			Expiration(time.Hour).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	srv.mu.Lock()
+	assert.Len(t, srv.sets["test:tag::tag1"], 2)
+	assert.Len(t, srv.sets["test:tag::tag2"], 2)
+	_, expires := srv.expires["test:tag::tag1"]
+	srv.mu.Unlock()
+	assert.True(t, expires)
+
+	// Flush one of the tags
+	err = client.
+		Flush().
+		Tags("tag1").
+		Execute(ctx)
+	require.NoError(t, err)
+
+	assertFlushed("testkey2")
+	assertFlushed("testkey3")
+
+	srv.mu.Lock()
+	assert.NotContains(t, srv.sets, "test:tag::tag1")
+	srv.mu.Unlock()
+}
+
+func TestRedisCacheStore_Expiration(t *testing.T) {
+	srv := newRESPServer(t, "")
+	client := newRedisCacheTestClient(t, srv, "")
+	ctx := context.Background()
+
+	err := client.
+		Set().
+		Key("expiring").
+		Data("value").
+		Expiration(50 * time.Millisecond).
+		Save(ctx)
+	require.NoError(t, err)
+
+	v, err := client.Get().Key("expiring").Fetch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "value", v)
+
+	time.Sleep(100 * time.Millisecond)
+
+	_, err = client.Get().Key("expiring").Fetch(ctx)
+	assert.Equal(t, ErrCacheMiss, err)
+}
+
+func TestRedisCacheStore_Auth(t *testing.T) {
+	srv := newRESPServer(t, "secret")
+
+	_, err := newRedisCache(config.RedisConfig{
+		Address:  srv.listener.Addr().String(),
+		Password: "wrong",
+		Timeout:  time.Second,
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "WRONGPASS")
+
+	// Unreachable servers fail on start up.
+	_, err = newRedisCache(config.RedisConfig{
+		Address: "127.0.0.1:1",
+		Timeout: time.Second,
+	})
+	assert.Error(t, err)
+}
+
+func TestRedisCacheStore_Transaction(t *testing.T) {
+	srv := newRESPServer(t, "")
+	client := newRedisCacheTestClient(t, srv, "")
+	ctx := context.Background()
+
+	set := func(key string, expiration time.Duration) error {
+		return client.
+			Set().
+			Key(key).
+			Data("value").
+			Tags("tag").
+			Expiration(expiration).
+			Save(ctx)
+	}
+
+	// An entry is not stored if the connection fails before the sets of its tags are written.
+	srv.mu.Lock()
+	srv.dropOn = "SADD"
+	srv.mu.Unlock()
+	require.Error(t, set("partial", time.Hour))
+
+	srv.mu.Lock()
+	srv.dropOn = ""
+	assert.NotContains(t, srv.strings, "test:partial")
+	assert.NotContains(t, srv.sets, "test:tag::tag")
+	srv.mu.Unlock()
+
+	// The set of keys of a tag expires along with the longest lived of them.
+	require.NoError(t, set("key1", time.Hour))
+	require.NoError(t, set("key2", 2*time.Hour))
+	require.NoError(t, set("key3", time.Minute))
+
+	srv.mu.Lock()
+	assert.Len(t, srv.sets["test:tag::tag"], 3)
+	assert.WithinDuration(t, time.Now().Add(2*time.Hour), srv.expires["test:tag::tag"], time.Minute)
+	srv.mu.Unlock()
+
+	// Failed commands are reported, and the connection remains usable.
+	srv.mu.Lock()
+	srv.strings["test:tag::other"] = "not a set"
+	srv.mu.Unlock()
+	err := client.
+		Set().
+		Key("key4").
+		Data("value").
+		Tags("other").
+		Expiration(time.Hour).
+		Save(ctx)
+	assert.Error(t, err)
+
+	v, err := client.Get().Key("key1").Fetch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "value", v)
+}
+
+func TestRedisCacheStore_Flush(t *testing.T) {
+	srv := newRESPServer(t, "")
+	client := newRedisCacheTestClient(t, srv, "")
+	ctx := context.Background()
+
+	set := func(key string) error {
+		return client.
+			Set().
+			Key(key).
+			Data("value").
+			Tags("tag").
+			Expiration(time.Hour).
+			Save(ctx)
+	}
+	require.NoError(t, set("key1"))
+
+	// An entry which is set while the keys of its tag are being read is flushed as well.
+	var once sync.Once
+	srv.mu.Lock()
+	srv.after = func(cmd string) {
+		if cmd == "SMEMBERS" {
+			once.Do(func() {
+				assert.NoError(t, set("key2"))
+			})
+		}
+	}
+	srv.mu.Unlock()
+
+	require.NoError(t, client.Flush().Tags("tag").Execute(ctx))
+
+	srv.mu.Lock()
+	assert.Empty(t, srv.strings)
+	assert.Empty(t, srv.sets)
+	srv.mu.Unlock()
+}
@@ -0,0 +1,207 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/occult/pagode/pkg/log"
+)
+
+// rememberLoadTimeout is how long a loader may run for by default
+const rememberLoadTimeout = 30 * time.Second
+
+type (
+	// CacheRememberOp handles chaining a remember operation, which fetches a value from the cache or else loads and
+	// caches it
+	CacheRememberOp[T any] struct {
+		client         *CacheClient
+		key            string
+		group          string
+		expiration     time.Duration
+		stale          time.Duration
+		missExpiration time.Duration
+		tags           []string
+		version        int
+		timeout        time.Duration
+	}
+
+	// rememberedValue is the entry a remember operation stores in the cache.
+	rememberedValue[T any] struct {
+		Value T
+		// Missing indicates that the loader found no value.
+		Missing bool
+		// StaleAt is when the value should be loaded again.
+		StaleAt time.Time
+	}
+)
+
+// Remember creates a cache remember operation for values of a given type
+func Remember[T any](client *CacheClient) *CacheRememberOp[T] {
+	return &CacheRememberOp[T]{
+		client:  client,
+		timeout: rememberLoadTimeout,
+	}
+}
+
+// Key sets the cache key
+func (c *CacheRememberOp[T]) Key(key string) *CacheRememberOp[T] {
+	c.key = key
+	return c
+}
+
+// Group sets the cache group
+func (c *CacheRememberOp[T]) Group(group string) *CacheRememberOp[T] {
+	c.group = group
+	return c
+}
+
+// Expiration sets how long the value is fresh for
+func (c *CacheRememberOp[T]) Expiration(expiration time.Duration) *CacheRememberOp[T] {
+	c.expiration = expiration
+	return c
+}
+
+// StaleWhileRevalidate sets how long the value is served for once it is no longer fresh, while it is loaded again in
+// the background
+func (c *CacheRememberOp[T]) StaleWhileRevalidate(stale time.Duration) *CacheRememberOp[T] {
+	c.stale = stale
+	return c
+}
+
+// MissExpiration sets how long it is remembered that the loader found no value, by returning ErrCacheMiss
+func (c *CacheRememberOp[T]) MissExpiration(expiration time.Duration) *CacheRememberOp[T] {
+	c.missExpiration = expiration
+	return c
+}
+
+// Tags sets the cache tags
+func (c *CacheRememberOp[T]) Tags(tags ...string) *CacheRememberOp[T] {
+	c.tags = tags
+	return c
+}
+
+// Version sets the version of the format of the value, as with SaveAs
+func (c *CacheRememberOp[T]) Version(version int) *CacheRememberOp[T] {
+	c.version = version
+	return c
+}
+
+// Timeout sets how long the loader may run for, which defaults to 30 seconds
+func (c *CacheRememberOp[T]) Timeout(timeout time.Duration) *CacheRememberOp[T] {
+	c.timeout = timeout
+	return c
+}
+
+// Fetch fetches the value from the cache, or else loads it with a given loader and caches it.
+// Concurrent loads of the same key, type and version are deduplicated, so the loader runs once and all callers share
+// its result. Since the load is shared, it is not cancelled along with the context of the caller which started it,
+// but is limited by the timeout instead; a caller whose context is cancelled stops waiting for it.
+// A loader which finds no value should return ErrCacheMiss, which is returned for as long as the miss is remembered.
+// Other errors of the loader are returned but not cached. Failing to access the cache does not prevent the value
+// from being loaded.
+func (c *CacheRememberOp[T]) Fetch(ctx context.Context, loader func(context.Context) (T, error)) (T, error) {
+	var zero T
+
+	switch {
+	case c.key == "":
+		return zero, errors.New("no cache key specified")
+	case c.expiration == 0:
+		return zero, errors.New("no cache expiration specified")
+	}
+
+	key := c.client.cacheKey(c.group, c.key)
+
+	entry, err := FetchAs[rememberedValue[T]](ctx, c.client.
+		Get().
+		Group(c.group).
+		Key(c.key).
+		Version(c.version),
+	)
+	if err != nil {
+		if !errors.Is(err, ErrCacheMiss) {
+			log.Default().Error("unable to fetch remembered value", "key", key, "error", err)
+		}
+		return c.load(ctx, key, loader)
+	}
+
+	if time.Now().After(entry.StaleAt) {
+		// Serve the stale value while it is loaded again, without being cancelled along with this request.
+		go func() {
+			if _, err := c.load(context.WithoutCancel(ctx), key, loader); err != nil && !errors.Is(err, ErrCacheMiss) {
+				log.Default().Error("unable to revalidate remembered value", "key", key, "error", err)
+			}
+		}()
+	}
+
+	if entry.Missing {
+		return zero, ErrCacheMiss
+	}
+
+	return entry.Value, nil
+}
+
+// load loads the value with a given loader, unless it is already being loaded, and caches it.
+func (c *CacheRememberOp[T]) load(
+	ctx context.Context,
+	key string,
+	loader func(context.Context) (T, error),
+) (T, error) {
+	// Loads of other types or versions under the same key cannot share a result.
+	flight := fmt.Sprintf("%s::%d::%s", key, c.version, reflect.TypeFor[T]())
+
+	ch := c.client.loads.DoChan(flight, func() (any, error) {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), c.timeout)
+		defer cancel()
+
+		value, err := loader(ctx)
+
+		entry := rememberedValue[T]{
+			Value:   value,
+			StaleAt: time.Now().Add(c.expiration),
+		}
+		expiration := c.expiration + c.stale
+
+		switch {
+		case err == nil:
+		case errors.Is(err, ErrCacheMiss) && c.missExpiration > 0:
+			entry = rememberedValue[T]{
+				Missing: true,
+				StaleAt: time.Now().Add(c.missExpiration),
+			}
+			expiration = c.missExpiration
+		default:
+			return nil, err
+		}
+
+		err = SaveAs(ctx, c.client.
+			Set().
+			Group(c.group).
+			Key(c.key).
+			Tags(c.tags...).
+			Expiration(expiration).
+			Version(c.version),
+			entry,
+		)
+		if err != nil {
+			log.Default().Error("unable to store remembered value", "key", key, "error", err)
+		}
+
+		if entry.Missing {
+			return nil, ErrCacheMiss
+		}
+
+		return value, nil
+	})
+
+	select {
+	case res := <-ch:
+		value, _ := res.Val.(T)
+		return value, res.Err
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
@@ -0,0 +1,278 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheRememberOp_Fetch(t *testing.T) {
+	ctx := context.Background()
+	var loads atomic.Int32
+	loader := func(ctx context.Context) (int, error) {
+		return int(loads.Add(1)), nil
+	}
+
+	remember := func() *CacheRememberOp[int] {
+		return Remember[int](c.Cache).
+			Group("remember").
+			Key("fetch").
+			Tags("remember-tag").
+			Expiration(time.Hour)
+	}
+
+	v, err := remember().Fetch(ctx, loader)
+	require.NoError(t, err)
+	assert.Equal(t, 1, v)
+
+	// The value is served from the cache.
+	v, err = remember().Fetch(ctx, loader)
+	require.NoError(t, err)
+	assert.Equal(t, 1, v)
+	assert.Equal(t, int32(1), loads.Load())
+
+	// The value is stored in its group.
+	_, err = c.Cache.Get().Group("remember").Key("fetch").Fetch(ctx)
+	require.NoError(t, err)
+
+	// Flushing the tag removes the value, so it is loaded again.
+	err = c.Cache.Flush().Tags("remember-tag").Execute(ctx)
+	require.NoError(t, err)
+	v, err = remember().Fetch(ctx, loader)
+	require.NoError(t, err)
+	assert.Equal(t, 2, v)
+
+	// A key and expiration are required.
+	_, err = Remember[int](c.Cache).Expiration(time.Hour).Fetch(ctx, loader)
+	assert.Error(t, err)
+	_, err = Remember[int](c.Cache).Key("fetch").Fetch(ctx, loader)
+	assert.Error(t, err)
+}
+
+func TestCacheRememberOp_Fetch_Concurrent(t *testing.T) {
+	var loads atomic.Int32
+	release := make(chan struct{})
+	loader := func(ctx context.Context) (string, error) {
+		loads.Add(1)
+		<-release
+		return "value", nil
+	}
+
+	var wg sync.WaitGroup
+	results := make([]string, 10)
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v, err := Remember[string](c.Cache).
+				Key("remember-concurrent").
+				Expiration(time.Hour).
+				Fetch(context.Background(), loader)
+			assert.NoError(t, err)
+			results[i] = v
+		}()
+	}
+
+	// Let all callers wait on the load before it completes.
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), loads.Load())
+	for _, v := range results {
+		assert.Equal(t, "value", v)
+	}
+}
+
+func TestCacheRememberOp_Fetch_StaleWhileRevalidate(t *testing.T) {
+	ctx := context.Background()
+	var loads atomic.Int32
+	loader := func(ctx context.Context) (int, error) {
+		return int(loads.Add(1)), nil
+	}
+
+	remember := func() *CacheRememberOp[int] {
+		return Remember[int](c.Cache).
+			Key("remember-stale").
+			Expiration(10 * time.Millisecond).
+			StaleWhileRevalidate(time.Hour)
+	}
+
+	v, err := remember().Fetch(ctx, loader)
+	require.NoError(t, err)
+	assert.Equal(t, 1, v)
+
+	time.Sleep(20 * time.Millisecond)
+
+	// The stale value is served while it is loaded again.
+	v, err = remember().Fetch(ctx, loader)
+	require.NoError(t, err)
+	assert.Equal(t, 1, v)
+
+	// The value loaded in the background is served once it is cached.
+	assert.Eventually(t, func() bool {
+		v, err := remember().Fetch(ctx, loader)
+		return err == nil && v > 1
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestCacheRememberOp_Fetch_Misses(t *testing.T) {
+	ctx := context.Background()
+	var loads atomic.Int32
+	loader := func(ctx context.Context) (*int, error) {
+		loads.Add(1)
+		return nil, ErrCacheMiss
+	}
+
+	// Misses are not remembered by default.
+	for range 2 {
+		_, err := Remember[*int](c.Cache).
+			Key("remember-miss").
+			Expiration(time.Hour).
+			Fetch(ctx, loader)
+		assert.Equal(t, ErrCacheMiss, err)
+	}
+	assert.Equal(t, int32(2), loads.Load())
+
+	for range 2 {
+		_, err := Remember[*int](c.Cache).
+			Key("remember-miss").
+			Expiration(time.Hour).
+			MissExpiration(time.Hour).
+			Fetch(ctx, loader)
+		assert.Equal(t, ErrCacheMiss, err)
+	}
+	assert.Equal(t, int32(3), loads.Load())
+}
+
+func TestCacheRememberOp_Fetch_Error(t *testing.T) {
+	ctx := context.Background()
+	loadErr := errors.New("load failed")
+	fail := true
+	loader := func(ctx context.Context) (string, error) {
+		if fail {
+			return "", loadErr
+		}
+		return "value", nil
+	}
+
+	remember := func() *CacheRememberOp[string] {
+		return Remember[string](c.Cache).
+			Key("remember-error").
+			Expiration(time.Hour).
+			MissExpiration(time.Hour)
+	}
+
+	_, err := remember().Fetch(ctx, loader)
+	assert.Equal(t, loadErr, err)
+
+	// Errors are not cached.
+	fail = false
+	v, err := remember().Fetch(ctx, loader)
+	require.NoError(t, err)
+	assert.Equal(t, "value", v)
+}
+
+func TestCacheRememberOp_Fetch_Flights(t *testing.T) {
+	release := make(chan struct{})
+	loaded := make(chan struct{})
+
+	// Loads of the same key with another type or version do not share a result.
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		v, err := Remember[string](c.Cache).
+			Key("remember-flights").
+			Expiration(time.Hour).
+			Fetch(context.Background(), func(ctx context.Context) (string, error) {
+				close(loaded)
+				<-release
+				return "value", nil
+			})
+		assert.NoError(t, err)
+		assert.Equal(t, "value", v)
+	}()
+	<-loaded
+
+	n, err := Remember[int](c.Cache).
+		Key("remember-flights").
+		Expiration(time.Hour).
+		Fetch(context.Background(), func(ctx context.Context) (int, error) {
+			return 1, nil
+		})
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	v, err := Remember[string](c.Cache).
+		Key("remember-flights").
+		Version(2).
+		Expiration(time.Hour).
+		Fetch(context.Background(), func(ctx context.Context) (string, error) {
+			return "version 2", nil
+		})
+	require.NoError(t, err)
+	assert.Equal(t, "version 2", v)
+
+	close(release)
+	wg.Wait()
+}
+
+func TestCacheRememberOp_Fetch_Cancel(t *testing.T) {
+	release := make(chan struct{})
+	loaded := make(chan struct{})
+	var loadErr atomic.Value
+	var once sync.Once
+	loader := func(ctx context.Context) (string, error) {
+		once.Do(func() { close(loaded) })
+		<-release
+		if err := ctx.Err(); err != nil {
+			loadErr.Store(err)
+		}
+		return "value", nil
+	}
+
+	remember := func() *CacheRememberOp[string] {
+		return Remember[string](c.Cache).
+			Key("remember-cancel").
+			Expiration(time.Hour)
+	}
+
+	// The caller which started the load stops waiting when its context is cancelled.
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		_, err := remember().Fetch(ctx, loader)
+		done <- err
+	}()
+	<-loaded
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+
+	// The load carries on for the other callers.
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		close(release)
+	}()
+	v, err := remember().Fetch(context.Background(), loader)
+	require.NoError(t, err)
+	assert.Equal(t, "value", v)
+	assert.Nil(t, loadErr.Load())
+
+	// Loaders are limited by the timeout.
+	_, err = Remember[string](c.Cache).
+		Key("remember-timeout").
+		Expiration(time.Hour).
+		Timeout(10*time.Millisecond).
+		Fetch(context.Background(), func(ctx context.Context) (string, error) {
+			<-ctx.Done()
+			return "", ctx.Err()
+		})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -0,0 +1,411 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/occult/pagode/config"
+	"github.com/occult/pagode/pkg/log"
+)
+
+// sqliteCacheSchema creates the tables the SQLite cache store uses, if they do not exist.
+const sqliteCacheSchema = `
+CREATE TABLE IF NOT EXISTS cache_entries (
+	key TEXT PRIMARY KEY,
+	value BLOB NOT NULL,
+	expires_at INTEGER NOT NULL
+);
+CREATE INDEX IF NOT EXISTS cache_entries_expires_at ON cache_entries (expires_at);
+CREATE TABLE IF NOT EXISTS cache_tags (
+	tag TEXT NOT NULL,
+	key TEXT NOT NULL,
+	PRIMARY KEY (tag, key)
+);
+CREATE INDEX IF NOT EXISTS cache_tags_key ON cache_tags (key);
+`
+
+// sqliteCacheStore is a cache store implementation which persists to an SQLite database, so the cache survives
+// restarts of the app. It is meant for single-instance deployments.
+// Values are encoded with gob, so types other than the basic ones must be registered with gob.Register.
+// Expired entries are never returned, but are only removed from the database periodically. The most recently used
+// entries can be kept in memory, in front of the database, to avoid reading and decoding them on every get.
+type sqliteCacheStore struct {
+	db *sql.DB
+
+	// closeDB indicates that the database was opened for the cache and is closed along with it.
+	closeDB bool
+
+	// memory stores the entries kept in memory, if enabled.
+	memory CacheStore
+
+	// expirations counts the expired entries removed by the cleanup.
+	expirations atomic.Uint64
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// newSQLiteCache creates a new CacheStore which persists to the database of a given connection, or to a given
+// database if the connection is empty
+func newSQLiteCache(cfg config.SQLiteCacheConfig, db *sql.DB) (CacheStore, error) {
+	s := &sqliteCacheStore{
+		db:   db,
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+	}
+
+	if cfg.Connection != "" {
+		var err error
+		if s.db, err = openDB("sqlite3", cfg.Connection); err != nil {
+			return nil, err
+		}
+		s.closeDB = true
+	}
+
+	if _, err := s.db.Exec(sqliteCacheSchema); err != nil {
+		s.closeDatabase()
+		return nil, err
+	}
+
+	if cfg.MemoryCapacity > 0 {
+		memory, err := newInMemoryCache(cfg.MemoryCapacity)
+		if err != nil {
+			s.closeDatabase()
+			return nil, err
+		}
+		s.memory = memory
+	}
+
+	go s.cleanupPeriodically(cfg.CleanupInterval)
+
+	return s, nil
+}
+
+func (s *sqliteCacheStore) get(ctx context.Context, op *CacheGetOp) (any, error) {
+	if s.memory != nil {
+		if v, err := s.memory.get(ctx, op); err == nil {
+			return v, nil
+		}
+	}
+
+	key := op.client.cacheKey(op.group, op.key)
+
+	var b []byte
+	var expiresAt int64
+	err := s.db.QueryRowContext(ctx,
+		"SELECT value, expires_at FROM cache_entries WHERE key = ? AND expires_at > ?",
+		key,
+		time.Now().UnixMilli(),
+	).Scan(&b, &expiresAt)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, ErrCacheMiss
+	case err != nil:
+		return nil, err
+	}
+
+	v, err := decodeCacheValue(b)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.memory != nil {
+		// Keep the tags in memory too, so flushing them also removes the entry from memory.
+		tags, err := s.tags(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+
+		// Only keep the entry in memory if it was not written since it was read, so a concurrent write is never
+		// overwritten with the older value.
+		_, err = s.memory.swap(ctx, &CacheSetOp{
+			client:     op.client,
+			key:        op.key,
+			group:      op.group,
+			data:       v,
+			expiration: time.Until(time.UnixMilli(expiresAt)),
+			tags:       tags,
+		}, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return v, nil
+}
+
+func (s *sqliteCacheStore) set(ctx context.Context, op *CacheSetOp) error {
+	_, err := s.write(ctx, op, func(tx *sql.Tx, key string, value []byte, expiresAt int64) (sql.Result, error) {
+		return tx.ExecContext(ctx,
+			`INSERT INTO cache_entries (key, value, expires_at) VALUES (?, ?, ?)
+			ON CONFLICT (key) DO UPDATE SET value = excluded.value, expires_at = excluded.expires_at`,
+			key,
+			value,
+			expiresAt,
+		)
+	})
+	return err
+}
+
+func (s *sqliteCacheStore) swap(ctx context.Context, op *CacheSetOp, old any) (bool, error) {
+	if old == nil {
+		return s.write(ctx, op, func(tx *sql.Tx, key string, value []byte, expiresAt int64) (sql.Result, error) {
+			// Expired entries which have not been removed yet do not exist.
+			return tx.ExecContext(ctx,
+				`INSERT INTO cache_entries (key, value, expires_at) VALUES (?, ?, ?)
+				ON CONFLICT (key) DO UPDATE SET value = excluded.value, expires_at = excluded.expires_at
+				WHERE cache_entries.expires_at <= ?`,
+				key,
+				value,
+				expiresAt,
+				time.Now().UnixMilli(),
+			)
+		})
+	}
+
+	b, err := encodeCacheValue(old)
+	if err != nil {
+		return false, err
+	}
+
+	swapped, err := s.write(ctx, op, func(tx *sql.Tx, key string, value []byte, expiresAt int64) (sql.Result, error) {
+		return tx.ExecContext(ctx,
+			"UPDATE cache_entries SET value = ?, expires_at = ? WHERE key = ? AND value = ? AND expires_at > ?",
+			value,
+			expiresAt,
+			key,
+			b,
+			time.Now().UnixMilli(),
+		)
+	})
+
+	// Drop the entry from memory if the swap failed, so the value to retry from is read from the database.
+	if err == nil && !swapped && s.memory != nil {
+		err = s.memory.flush(ctx, &CacheFlushOp{client: op.client, key: op.key, group: op.group})
+	}
+
+	return swapped, err
+}
+
+// write writes an entry and its tags in a transaction, using a given statement to write the entry, and reports
+// whether the statement wrote it.
+func (s *sqliteCacheStore) write(
+	ctx context.Context,
+	op *CacheSetOp,
+	upsert func(tx *sql.Tx, key string, value []byte, expiresAt int64) (sql.Result, error),
+) (bool, error) {
+	b, err := encodeCacheValue(op.data)
+	if err != nil {
+		return false, err
+	}
+
+	key := op.client.cacheKey(op.group, op.key)
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+
+	res, err := upsert(tx, key, b, time.Now().Add(op.expiration).UnixMilli())
+	if err != nil {
+		_ = tx.Rollback()
+		return false, err
+	}
+
+	if n, err := res.RowsAffected(); err != nil || n == 0 {
+		_ = tx.Rollback()
+		return false, err
+	}
+
+	for _, tag := range op.tags {
+		_, err = tx.ExecContext(ctx, "INSERT OR IGNORE INTO cache_tags (tag, key) VALUES (?, ?)", tag, key)
+		if err != nil {
+			_ = tx.Rollback()
+			return false, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return false, err
+	}
+
+	if s.memory != nil {
+		return true, s.memory.set(ctx, op)
+	}
+
+	return true, nil
+}
+
+func (s *sqliteCacheStore) flush(ctx context.Context, op *CacheFlushOp) error {
+	keys := make([]any, 0)
+
+	if key := op.client.cacheKey(op.group, op.key); key != "" {
+		keys = append(keys, key)
+	}
+
+	tags := make([]any, len(op.tags))
+	for i, tag := range op.tags {
+		tags[i] = tag
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if len(tags) > 0 {
+		rows, err := tx.QueryContext(ctx,
+			"SELECT DISTINCT key FROM cache_tags WHERE tag IN ("+sqlPlaceholders(len(tags))+")",
+			tags...,
+		)
+		if err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+
+		for rows.Next() {
+			var key string
+			if err = rows.Scan(&key); err != nil {
+				_ = rows.Close()
+				_ = tx.Rollback()
+				return err
+			}
+			keys = append(keys, key)
+		}
+		_ = rows.Close()
+		if err = rows.Err(); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	if len(keys) > 0 {
+		in := sqlPlaceholders(len(keys))
+		if _, err = tx.ExecContext(ctx, "DELETE FROM cache_entries WHERE key IN ("+in+")", keys...); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+		if _, err = tx.ExecContext(ctx, "DELETE FROM cache_tags WHERE key IN ("+in+")", keys...); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	if s.memory != nil {
+		return s.memory.flush(ctx, op)
+	}
+
+	return nil
+}
+
+func (s *sqliteCacheStore) close() {
+	close(s.stop)
+	<-s.done
+
+	if s.memory != nil {
+		s.memory.close()
+	}
+
+	s.closeDatabase()
+}
+
+// closeDatabase closes the database, if it was opened for the cache.
+func (s *sqliteCacheStore) closeDatabase() {
+	if s.closeDB {
+		_ = s.db.Close()
+	}
+}
+
+func (s *sqliteCacheStore) stats(ctx context.Context) (CacheStats, error) {
+	stats := CacheStats{
+		Expirations: s.expirations.Load(),
+	}
+	now := time.Now().UnixMilli()
+
+	err := s.db.QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM cache_entries WHERE expires_at > ?",
+		now,
+	).Scan(&stats.Entries)
+	if err != nil {
+		return stats, err
+	}
+
+	err = s.db.QueryRowContext(ctx,
+		`SELECT COUNT(DISTINCT tag), COUNT(DISTINCT key) FROM cache_tags
+		WHERE key IN (SELECT key FROM cache_entries WHERE expires_at > ?)`,
+		now,
+	).Scan(&stats.Tags, &stats.TaggedEntries)
+
+	return stats, err
+}
+
+// tags returns the tags of a given key.
+func (s *sqliteCacheStore) tags(ctx context.Context, key string) ([]string, error) {
+	rows, err := s.db.QueryContext(ctx, "SELECT tag FROM cache_tags WHERE key = ?", key)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tags := make([]string, 0)
+	for rows.Next() {
+		var tag string
+		if err = rows.Scan(&tag); err != nil {
+			return nil, err
+		}
+		tags = append(tags, tag)
+	}
+
+	return tags, rows.Err()
+}
+
+// cleanup removes the expired entries, and the tags of entries which no longer exist.
+func (s *sqliteCacheStore) cleanup(ctx context.Context) error {
+	res, err := s.db.ExecContext(ctx, "DELETE FROM cache_entries WHERE expires_at <= ?", time.Now().UnixMilli())
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err == nil {
+		s.expirations.Add(uint64(n))
+	}
+
+	_, err = s.db.ExecContext(ctx, "DELETE FROM cache_tags WHERE key NOT IN (SELECT key FROM cache_entries)")
+	return err
+}
+
+// cleanupPeriodically runs the cleanup at a given interval until the store is closed.
+func (s *sqliteCacheStore) cleanupPeriodically(interval time.Duration) {
+	defer close(s.done)
+
+	if interval <= 0 {
+		<-s.stop
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+			if err := s.cleanup(context.Background()); err != nil {
+				log.Default().Error("unable to clean up the cache", "error", err)
+			}
+		}
+	}
+}
+
+// sqlPlaceholders returns a given amount of comma-separated query placeholders.
+func sqlPlaceholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
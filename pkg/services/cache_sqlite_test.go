@@ -0,0 +1,233 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/occult/pagode/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newSQLiteCacheTestClient(t *testing.T, memoryCapacity int) *CacheClient {
+	store, err := newSQLiteCache(config.SQLiteCacheConfig{
+		MemoryCapacity: memoryCapacity,
+	}, c.Database)
+	require.NoError(t, err)
+
+	client := NewCacheClient(store, GobCacheCodec{})
+	t.Cleanup(client.Close)
+	return client
+}
+
+func clearSQLiteCache(t *testing.T) {
+	_, err := c.Database.Exec("DELETE FROM cache_entries; DELETE FROM cache_tags")
+	require.NoError(t, err)
+}
+
+func TestSQLiteCacheStore(t *testing.T) {
+	for _, capacity := range []int{0, 100} {
+		client := newSQLiteCacheTestClient(t, capacity)
+		clearSQLiteCache(t)
+		ctx := context.Background()
+
+		data := encodedCacheTest{Value: "abcdef"}
+		group := "testgroup"
+		key := "testkey"
+
+		err := client.
+			Set().
+			Group(group).
+			Key(key).
+			Data(data).
+			Expiration(time.Hour).
+			Save(ctx)
+		require.NoError(t, err)
+
+		fromCache, err := client.
+			Get().
+			Group(group).
+			Key(key).
+			Fetch(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, data, fromCache)
+
+		// The same key with the wrong group should fail
+		_, err = client.
+			Get().
+			Key(key).
+			Fetch(ctx)
+		assert.Equal(t, ErrCacheMiss, err)
+
+		err = client.
+			Flush().
+			Group(group).
+			Key(key).
+			Execute(ctx)
+		require.NoError(t, err)
+
+		assertFlushed := func(key string) {
+			_, err := client.
+				Get().
+				Group(group).
+				Key(key).
+				Fetch(ctx)
+			assert.Equal(t, ErrCacheMiss, err)
+		}
+		assertFlushed(key)
+
+		// Set with tags
+		for _, k := range []string{"testkey2", "testkey3"} {
+			err = client.
+				Set().
+				Group(group).
+				Key(k).
+				Data(data).
+				Tags("tag1", "tag2").
+				Expiration(time.Hour).
+				Save(ctx)
+			require.NoError(t, err)
+		}
+
+		// Flush one of the tags
+		err = client.
+			Flush().
+			Tags("tag1").
+			Execute(ctx)
+		require.NoError(t, err)
+
+		assertFlushed("testkey2")
+		assertFlushed("testkey3")
+
+		var count int
+		err = c.Database.QueryRow("SELECT COUNT(*) FROM cache_tags").Scan(&count)
+		require.NoError(t, err)
+		assert.Zero(t, count)
+	}
+}
+
+func TestSQLiteCacheStore_Persistence(t *testing.T) {
+	clearSQLiteCache(t)
+	ctx := context.Background()
+	data := encodedCacheTest{Value: "persisted"}
+
+	first := newSQLiteCacheTestClient(t, 100)
+	err := first.
+		Set().
+		Key("persisted").
+		Data(data).
+		Tags("persisted").
+		Expiration(time.Hour).
+		Save(ctx)
+	require.NoError(t, err)
+
+	// A new store, as after a restart, loads the entry from the database.
+	second := newSQLiteCacheTestClient(t, 100)
+	v, err := second.Get().Key("persisted").Fetch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, data, v)
+
+	// The entry is now in memory, so it is returned even if the row is gone.
+	_, err = c.Database.Exec("DELETE FROM cache_entries")
+	require.NoError(t, err)
+	v, err = second.Get().Key("persisted").Fetch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, data, v)
+
+	// The tags were loaded into memory along with the entry.
+	err = second.Flush().Tags("persisted").Execute(ctx)
+	require.NoError(t, err)
+	_, err = second.Get().Key("persisted").Fetch(ctx)
+	assert.Equal(t, ErrCacheMiss, err)
+}
+
+func TestSQLiteCacheStore_Swap(t *testing.T) {
+	clearSQLiteCache(t)
+	ctx := context.Background()
+
+	first := newSQLiteCacheTestClient(t, 100)
+	second := newSQLiteCacheTestClient(t, 100)
+	set := func(client *CacheClient, data, old any) bool {
+		swapped, err := client.
+			Set().
+			Key("swap").
+			Data(data).
+			Expiration(time.Hour).
+			Swap(ctx, old)
+		require.NoError(t, err)
+		return swapped
+	}
+
+	require.True(t, set(first, 1, nil))
+	v, err := second.Get().Key("swap").Fetch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, v)
+
+	// The entry in memory of the second store is now outdated, so swapping from it fails.
+	require.True(t, set(first, 2, 1))
+	v, err = second.Get().Key("swap").Fetch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, v)
+	assert.False(t, set(second, 3, v))
+
+	// The failed swap dropped the entry from memory, so it can be retried from the current value.
+	v, err = second.Get().Key("swap").Fetch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, v)
+	assert.True(t, set(second, 3, v))
+}
+
+func TestSQLiteCacheStore_Cleanup(t *testing.T) {
+	client := newSQLiteCacheTestClient(t, 0)
+	clearSQLiteCache(t)
+	ctx := context.Background()
+
+	for key, expiration := range map[string]time.Duration{
+		"expiring": 10 * time.Millisecond,
+		"lasting":  time.Hour,
+	} {
+		err := client.
+			Set().
+			Key(key).
+			Data(key).
+			Tags("tag").
+			Expiration(expiration).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	time.Sleep(20 * time.Millisecond)
+
+	// Expired entries are not returned, even before they are removed.
+	_, err := client.Get().Key("expiring").Fetch(ctx)
+	assert.Equal(t, ErrCacheMiss, err)
+	v, err := client.Get().Key("lasting").Fetch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "lasting", v)
+
+	stats, err := client.Stats(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, stats.Entries)
+	assert.Equal(t, 1, stats.Tags)
+	assert.Equal(t, 1, stats.TaggedEntries)
+	assert.Zero(t, stats.Expirations)
+
+	err = client.store.(*sqliteCacheStore).cleanup(ctx)
+	require.NoError(t, err)
+
+	stats, err = client.Stats(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), stats.Expirations)
+
+	var keys []string
+	rows, err := c.Database.Query("SELECT key FROM cache_entries UNION ALL SELECT key FROM cache_tags")
+	require.NoError(t, err)
+	defer rows.Close()
+	for rows.Next() {
+		var key string
+		require.NoError(t, rows.Scan(&key))
+		keys = append(keys, key)
+	}
+	assert.Equal(t, []string{"lasting", "lasting"}, keys)
+}
@@ -2,6 +2,10 @@ package services
 
 import (
 	"context"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -9,6 +13,15 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+// encodedCacheTest is cached by the tests of cache stores which encode values.
+type encodedCacheTest struct {
+	Value string
+}
+
+func init() {
+	gob.Register(encodedCacheTest{})
+}
+
 func TestCacheClient(t *testing.T) {
 	type cacheTest struct {
 		Value string
@@ -80,14 +93,10 @@ func TestCacheClient(t *testing.T) {
 	// Check the tag index
 	index := c.Cache.store.(*inMemoryCacheStore).tagIndex
 	gk := c.Cache.cacheKey(group, key)
-	_, exists := index.tags["tag1"][gk]
-	assert.True(t, exists)
-	_, exists = index.tags["tag2"][gk]
-	assert.True(t, exists)
-	_, exists = index.keys[gk]["tag1"]
-	assert.True(t, exists)
-	_, exists = index.keys[gk]["tag2"]
-	assert.True(t, exists)
+	entry, exists := index.tags["tag1"][gk]
+	require.True(t, exists)
+	assert.Equal(t, data, entry.value)
+	assert.Same(t, entry, index.tags["tag2"][gk])
 
 	// Flush one of tags
 	err = c.Cache.
@@ -99,7 +108,171 @@ func TestCacheClient(t *testing.T) {
 	// The data should be gone
 	assertFlushed(key)
 
-	// The index should be empty
-	assert.Empty(t, index.tags)
-	assert.Empty(t, index.keys)
+	// The index should no longer contain the key
+	assert.NotContains(t, index.tags, "tag1")
+	assert.NotContains(t, index.tags, "tag2")
+}
+
+func TestInMemoryCacheStore_TagIndex(t *testing.T) {
+	store, err := newInMemoryCache(10)
+	require.NoError(t, err)
+	client := NewCacheClient(store, GobCacheCodec{})
+	defer client.Close()
+	index := store.(*inMemoryCacheStore).tagIndex
+	ctx := context.Background()
+
+	set := func(key string, tags ...string) {
+		err := client.Set().Key(key).Data(key).Tags(tags...).Expiration(time.Hour).Save(ctx)
+		require.NoError(t, err)
+	}
+
+	// Replacing an entry removes its previous tags, but never those of the new entry.
+	set("a", "tag1")
+	set("a", "tag2")
+	set("a", "tag1")
+	assert.Eventually(t, func() bool {
+		tags, entries := index.size()
+		return tags == 1 && entries == 1
+	}, time.Second, 5*time.Millisecond)
+
+	// Flushing a tag leaves keys which were set again without it.
+	set("b", "tag2")
+	set("b")
+	err = client.Flush().Tags("tag2").Execute(ctx)
+	require.NoError(t, err)
+	_, err = client.Get().Key("b").Fetch(ctx)
+	assert.NoError(t, err)
+
+	// Evicted entries are removed from the index, so it never holds more entries than the cache.
+	for i := range 100 {
+		set(fmt.Sprintf("key%d", i), "evicted", fmt.Sprintf("tag%d", i))
+	}
+	assert.Eventually(t, func() bool {
+		stats, err := client.Stats(ctx)
+		require.NoError(t, err)
+		_, entries := index.size()
+		return stats.Evictions > 0 && stats.Entries <= 10 && entries <= 10
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestCacheClient_Stats(t *testing.T) {
+	store, err := newInMemoryCache(10)
+	require.NoError(t, err)
+	client := NewCacheClient(store, GobCacheCodec{})
+	defer client.Close()
+	ctx := context.Background()
+
+	err = client.Set().Key("a").Data("a").Tags("tag1", "tag2").Expiration(time.Hour).Save(ctx)
+	require.NoError(t, err)
+	err = client.Set().Key("b").Data("b").Tags("tag1").Expiration(time.Minute).Save(ctx)
+	require.NoError(t, err)
+	err = client.Set().Key("c").Data("c").Expiration(time.Hour).Save(ctx)
+	require.NoError(t, err)
+
+	_, err = client.Get().Key("a").Fetch(ctx)
+	require.NoError(t, err)
+	_, err = client.Get().Key("missing").Fetch(ctx)
+	assert.Equal(t, ErrCacheMiss, err)
+
+	stats, err := client.Stats(ctx)
+	require.NoError(t, err)
+	assert.True(t, stats.StoreStats)
+	assert.Equal(t, uint64(1), stats.Hits)
+	assert.Equal(t, uint64(1), stats.Misses)
+	assert.Equal(t, 3, stats.Entries)
+	assert.Equal(t, 10, stats.Capacity)
+	assert.Equal(t, 2, stats.Tags)
+	assert.Equal(t, 2, stats.TaggedEntries)
+
+	// Expired entries are removed along with their tags.
+	store.(*inMemoryCacheStore).now = func() time.Time {
+		return time.Now().Add(2 * time.Minute)
+	}
+	_, err = client.Get().Key("b").Fetch(ctx)
+	assert.Equal(t, ErrCacheMiss, err)
+
+	stats, err = client.Stats(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), stats.Expirations)
+	assert.Equal(t, 1, stats.TaggedEntries)
+	assert.Equal(t, 2, stats.Tags)
+}
+
+func TestCacheSetOp_Swap(t *testing.T) {
+	memory, err := newInMemoryCache(100)
+	require.NoError(t, err)
+	memoryClient := NewCacheClient(memory, GobCacheCodec{})
+	t.Cleanup(memoryClient.Close)
+
+	clients := map[string]*CacheClient{
+		"memory":        memoryClient,
+		"sqlite":        newSQLiteCacheTestClient(t, 0),
+		"sqlite+memory": newSQLiteCacheTestClient(t, 100),
+		"redis":         newRedisCacheTestClient(t, newRESPServer(t, ""), ""),
+	}
+
+	for name, client := range clients {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			key := fmt.Sprintf("swap-%s", name)
+			swap := func(data, old any) bool {
+				swapped, err := client.
+					Set().
+					Key(key).
+					Data(data).
+					Expiration(time.Hour).
+					Swap(ctx, old)
+				require.NoError(t, err)
+				return swapped
+			}
+
+			// Keys which do not exist are only set when swapping from nil.
+			assert.False(t, swap(encodedCacheTest{Value: "a"}, encodedCacheTest{Value: "b"}))
+			assert.True(t, swap(encodedCacheTest{Value: "a"}, nil))
+			assert.False(t, swap(encodedCacheTest{Value: "b"}, nil))
+
+			// Data is only swapped if it did not change.
+			assert.False(t, swap(encodedCacheTest{Value: "b"}, encodedCacheTest{Value: "c"}))
+			assert.True(t, swap(encodedCacheTest{Value: "b"}, encodedCacheTest{Value: "a"}))
+
+			v, err := client.Get().Key(key).Fetch(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, encodedCacheTest{Value: "b"}, v)
+
+			// Concurrent updates are not lost.
+			counter := fmt.Sprintf("counter-%s", name)
+			var wg sync.WaitGroup
+			for range 20 {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for {
+						v, err := client.Get().Key(counter).Fetch(ctx)
+						if errors.Is(err, ErrCacheMiss) {
+							v, err = nil, nil
+						}
+						if !assert.NoError(t, err) {
+							return
+						}
+
+						n, _ := v.(int)
+						swapped, err := client.
+							Set().
+							Key(counter).
+							Data(n+1).
+							Expiration(time.Hour).
+							Swap(ctx, v)
+						if !assert.NoError(t, err) || swapped {
+							return
+						}
+					}
+				}()
+			}
+			wg.Wait()
+
+			v, err = client.Get().Key(counter).Fetch(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, 20, v)
+		})
+	}
 }
@@ -8,6 +8,7 @@ import (
 	"html/template"
 	"log/slog"
 	"math/rand"
+	"net"
 	"os"
 	"path"
 	"path/filepath"
@@ -61,9 +62,27 @@ type Container struct {
 	// Mail stores an email sending client.
 	Mail *MailClient
 
+	// Session stores the session store.
+	Session *SessionStore
+
 	// Auth stores an authentication client.
 	Auth *AuthClient
 
+	// Audit stores a client for recording sensitive actions in the audit log.
+	Audit *AuditClient
+
+	// OAuth stores a client for logging in with external identity providers.
+	OAuth *OAuthClient
+
+	// Organization stores a client for managing organizations and their members.
+	Organization *OrganizationClient
+
+	// WebAuthn stores a client for registering passkeys and logging in with them.
+	WebAuthn *WebAuthnClient
+
+	// Export stores a client for exporting the data stored about users.
+	Export *ExportClient
+
 	// Tasks stores the task client.
 	Tasks *backlite.Client
 
@@ -86,11 +105,17 @@ func NewContainer() *Container {
 	c.initConfig()
 	c.initValidator()
 	c.initWeb()
-	c.initCache()
 	c.initDatabase()
+	c.initCache()
 	c.initFiles()
 	c.initORM()
+	c.initAudit()
+	c.initSession()
 	c.initAuth()
+	c.initOAuth()
+	c.initOrganization()
+	c.initWebAuthn()
+	c.initExport()
 	c.initMail()
 	c.initTasks()
 	c.initPayment()
@@ -118,19 +143,22 @@ func (c *Container) Shutdown() error {
 		c.Chat.Shutdown()
 	}
 
+	// Shutdown the session store, which removes expired sessions.
+	c.Session.Close()
+
 	// Shutdown the ORM.
 	if err := c.ORM.Close(); err != nil {
 		return err
 	}
 
+	// Shutdown the cache, which may be stored in the database.
+	c.Cache.Close()
+
 	// Shutdown the database.
 	if err := c.Database.Close(); err != nil {
 		return err
 	}
 
-	// Shutdown the cache.
-	c.Cache.Close()
-
 	return nil
 }
 
@@ -158,19 +186,79 @@ func (c *Container) initValidator() {
 
 // initWeb initializes the web framework.
 func (c *Container) initWeb() {
+	ipExtractor, err := newIPExtractor(c.Config.HTTP.TrustedProxies)
+	if err != nil {
+		panic(err)
+	}
+
 	c.Web = echo.New()
 	c.Web.HideBanner = true
 	c.Web.Validator = c.Validator
+	c.Web.IPExtractor = ipExtractor
+}
+
+// newIPExtractor creates an IP extractor which only reads the client IP address from the X-Forwarded-For header if
+// the request was sent by one of the given trusted proxies. Without trusted proxies, the header is ignored.
+func newIPExtractor(trustedProxies []string) (echo.IPExtractor, error) {
+	if len(trustedProxies) == 0 {
+		return echo.ExtractIPDirect(), nil
+	}
+
+	options := []echo.TrustOption{
+		echo.TrustLoopback(false),
+		echo.TrustLinkLocal(false),
+		echo.TrustPrivateNet(false),
+	}
+	for _, proxy := range trustedProxies {
+		if !strings.Contains(proxy, "/") {
+			if ip := net.ParseIP(proxy); ip != nil && ip.To4() != nil {
+				proxy += "/32"
+			} else {
+				proxy += "/128"
+			}
+		}
+		_, ipRange, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy: %w", err)
+		}
+		options = append(options, echo.TrustIPRange(ipRange))
+	}
+
+	return echo.ExtractIPFromXFFHeader(options...), nil
 }
 
 // initCache initializes the cache.
 func (c *Container) initCache() {
-	store, err := newInMemoryCache(c.Config.Cache.Capacity)
+	var store CacheStore
+	var err error
+
+	switch c.Config.Cache.Driver {
+	case "", "memory":
+		store, err = newInMemoryCache(c.Config.Cache.Capacity)
+	case "sqlite":
+		store, err = newSQLiteCache(c.Config.Cache.SQLite, c.Database)
+	case "redis":
+		store, err = newRedisCache(c.Config.Cache.Redis)
+	default:
+		panic(fmt.Sprintf("unsupported cache driver: %s", c.Config.Cache.Driver))
+	}
 	if err != nil {
 		panic(err)
 	}
 
-	c.Cache = NewCacheClient(store)
+	var codec CacheCodec
+	switch c.Config.Cache.Codec {
+	case "", "json":
+		codec = JSONCacheCodec{}
+	case "gob":
+		codec = GobCacheCodec{}
+	case "msgpack":
+		codec = MsgpackCacheCodec{}
+	default:
+		panic(fmt.Sprintf("unsupported cache codec: %s", c.Config.Cache.Codec))
+	}
+
+	c.Cache = NewCacheClient(store, codec)
 }
 
 // initDatabase initializes the database.
@@ -228,9 +316,56 @@ func (c *Container) initORM() {
 	c.Graph = g
 }
 
+// initAudit initializes the audit client and registers its hook so that mutations are recorded.
+func (c *Container) initAudit() {
+	c.Audit = NewAuditClient(c.Config, c.ORM, c.Graph)
+	c.ORM.Use(c.Audit.Hook())
+}
+
+// initSession initializes the session store.
+func (c *Container) initSession() {
+	c.Session = NewSessionStore(c.Config, c.ORM, c.Web.IPExtractor)
+}
+
 // initAuth initializes the authentication client.
 func (c *Container) initAuth() {
 	c.Auth = NewAuthClient(c.Config, c.ORM)
+
+	if err := c.Auth.SyncPermissions(context.Background()); err != nil {
+		panic(fmt.Sprintf("failed to sync permissions: %v", err))
+	}
+
+	if err := c.Auth.MigrateAdminFlag(context.Background(), c.Config.Database.Driver, c.Database); err != nil {
+		panic(fmt.Sprintf("failed to migrate admin flag: %v", err))
+	}
+}
+
+// initOAuth initializes the OAuth client.
+func (c *Container) initOAuth() {
+	var err error
+	c.OAuth, err = NewOAuthClient(c.Config, c.ORM, c.Auth)
+	if err != nil {
+		panic(fmt.Sprintf("failed to create oauth client: %v", err))
+	}
+}
+
+// initOrganization initializes the organization client.
+func (c *Container) initOrganization() {
+	c.Organization = NewOrganizationClient(c.Config, c.ORM)
+}
+
+// initWebAuthn initializes the WebAuthn client.
+func (c *Container) initWebAuthn() {
+	var err error
+	c.WebAuthn, err = NewWebAuthnClient(c.Config, c.ORM)
+	if err != nil {
+		panic(fmt.Sprintf("failed to create webauthn client: %v", err))
+	}
+}
+
+// initExport initializes the data export client.
+func (c *Container) initExport() {
+	c.Export = NewExportClient(c.Config, c.ORM, c.Files)
 }
 
 // initMail initialize the mail client.
@@ -277,13 +412,9 @@ func (c *Container) initChat() {
 
 // initPayment initializes the payment client.
 func (c *Container) initPayment() {
-	var provider PaymentProvider
-
-	switch c.Config.Payment.Provider {
-	case "stripe":
-		provider = NewStripeProvider(c.Config)
-	default:
-		panic(fmt.Sprintf("unsupported payment provider: %s", c.Config.Payment.Provider))
+	provider, err := NewPaymentProvider(c.Config.Payment.Provider, c.Config)
+	if err != nil {
+		panic(err)
 	}
 
 	c.Payment = NewPaymentClient(c.Config, c.ORM, provider)
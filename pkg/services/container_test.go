@@ -1,9 +1,13 @@
 package services
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewContainer(t *testing.T) {
@@ -14,7 +18,39 @@ func TestNewContainer(t *testing.T) {
 	assert.NotNil(t, c.Database)
 	assert.NotNil(t, c.Files)
 	assert.NotNil(t, c.ORM)
+	assert.NotNil(t, c.Session)
 	assert.NotNil(t, c.Mail)
 	assert.NotNil(t, c.Auth)
+	assert.NotNil(t, c.Audit)
+	assert.NotNil(t, c.Export)
+	assert.NotNil(t, c.OAuth)
+	assert.NotNil(t, c.Organization)
+	assert.NotNil(t, c.WebAuthn)
 	assert.NotNil(t, c.Tasks)
 }
+
+func TestNewIPExtractor(t *testing.T) {
+	newRequest := func(remoteAddr, xff string) *http.Request {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.RemoteAddr = remoteAddr
+		r.Header.Set(echo.HeaderXForwardedFor, xff)
+		return r
+	}
+
+	// Without trusted proxies, the header is ignored
+	extractor, err := newIPExtractor(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "10.0.0.1", extractor(newRequest("10.0.0.1:1234", "1.2.3.4")))
+
+	extractor, err = newIPExtractor([]string{"10.0.0.0/8", "192.168.1.1"})
+	require.NoError(t, err)
+	assert.Equal(t, "1.2.3.4", extractor(newRequest("10.0.0.1:1234", "1.2.3.4")))
+	assert.Equal(t, "1.2.3.4", extractor(newRequest("192.168.1.1:1234", "5.6.7.8, 1.2.3.4")))
+	assert.Equal(t, "192.168.1.2", extractor(newRequest("192.168.1.2:1234", "1.2.3.4")))
+
+	// Clients cannot prepend addresses to the header of a trusted proxy
+	assert.Equal(t, "1.2.3.4", extractor(newRequest("10.0.0.1:1234", "5.6.7.8, 1.2.3.4")))
+
+	_, err = newIPExtractor([]string{"invalid"})
+	assert.Error(t, err)
+}
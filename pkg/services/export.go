@@ -0,0 +1,230 @@
+package services
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/occult/pagode/config"
+	"github.com/occult/pagode/ent"
+	"github.com/spf13/afero"
+)
+
+// exportDirectory stores the directory of the file system in which data exports are stored
+const exportDirectory = "exports"
+
+// InvalidExportTokenError is an error returned when a data export download token is invalid, has expired or was
+// generated for a different user
+type InvalidExportTokenError struct{}
+
+// Error implements the error interface.
+func (e InvalidExportTokenError) Error() string {
+	return "invalid or expired export token"
+}
+
+// ExportClient exports the data stored about users so they can download a copy of it
+type ExportClient struct {
+	config *config.Config
+	orm    *ent.Client
+	files  afero.Fs
+}
+
+// NewExportClient creates a new ExportClient
+func NewExportClient(cfg *config.Config, orm *ent.Client, files afero.Fs) *ExportClient {
+	return &ExportClient{
+		config: cfg,
+		orm:    orm,
+		files:  files,
+	}
+}
+
+// Export gathers the data stored about the user of a given ID into a zip archive containing a JSON file per type of
+// data, stores it in the file system and returns its name. Exports which have expired are removed beforehand.
+func (c *ExportClient) Export(ctx context.Context, userID int) (string, error) {
+	if err := c.removeExpired(); err != nil {
+		return "", fmt.Errorf("unable to remove expired exports: %w", err)
+	}
+
+	data, err := c.gather(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+
+	suffix := make([]byte, 16)
+	if _, err := rand.Read(suffix); err != nil {
+		return "", err
+	}
+	name := fmt.Sprintf("%d-%s.zip", userID, hex.EncodeToString(suffix))
+
+	if err := c.files.MkdirAll(exportDirectory, 0755); err != nil {
+		return "", err
+	}
+
+	f, err := c.files.Create(path.Join(exportDirectory, name))
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	zw := zip.NewWriter(f)
+	for _, d := range data {
+		w, err := zw.Create(d.name + ".json")
+		if err != nil {
+			return "", err
+		}
+
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(d.value); err != nil {
+			return "", err
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return "", err
+	}
+
+	return name, nil
+}
+
+// GenerateDownloadToken generates a token for the user of a given ID to download the export of a given name, which
+// expires along with the export.
+func (c *ExportClient) GenerateDownloadToken(userID int, name string) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"export": name,
+		"user":   userID,
+		"exp":    time.Now().Add(c.config.App.DataExport.Expiration).Unix(),
+	})
+
+	return token.SignedString([]byte(c.config.App.EncryptionKey))
+}
+
+// Open validates a download token for the user of a given ID and opens the export it was generated for.
+// An InvalidExportTokenError is returned if the token is invalid, expired or was generated for another user, or if
+// the export no longer exists.
+func (c *ExportClient) Open(token string, userID int) (afero.File, error) {
+	t, err := jwt.Parse(token, func(t *jwt.Token) (any, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
+		}
+
+		return []byte(c.config.App.EncryptionKey), nil
+	})
+	if err != nil || !t.Valid {
+		return nil, InvalidExportTokenError{}
+	}
+
+	claims, ok := t.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, InvalidExportTokenError{}
+	}
+
+	name, _ := claims["export"].(string)
+	user, _ := claims["user"].(float64)
+	if name == "" || path.Base(name) != name || int(user) != userID {
+		return nil, InvalidExportTokenError{}
+	}
+
+	f, err := c.files.Open(path.Join(exportDirectory, name))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, InvalidExportTokenError{}
+	}
+
+	return f, err
+}
+
+// exportData is a type of data included in an export.
+type exportData struct {
+	name  string
+	value any
+}
+
+// gather loads all the data stored about the user of a given ID.
+func (c *ExportClient) gather(ctx context.Context, userID int) ([]exportData, error) {
+	u, err := c.orm.User.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	messages, err := u.QueryChatMessages().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rooms, err := u.QueryOwnedChatRooms().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bans, err := u.QueryChatBans().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data := []exportData{
+		{name: "user", value: u},
+		{name: "chat_messages", value: messages},
+		{name: "chat_rooms", value: rooms},
+		{name: "chat_bans", value: bans},
+	}
+
+	customer, err := u.QueryPaymentCustomer().Only(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		return data, nil
+	case err != nil:
+		return nil, err
+	}
+
+	intents, err := customer.QueryPaymentIntents().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	methods, err := customer.QueryPaymentMethods().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptions, err := customer.QuerySubscriptions().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(data,
+		exportData{name: "payment_customer", value: customer},
+		exportData{name: "payment_intents", value: intents},
+		exportData{name: "payment_methods", value: methods},
+		exportData{name: "subscriptions", value: subscriptions},
+	), nil
+}
+
+// removeExpired removes the exports which can no longer be downloaded.
+func (c *ExportClient) removeExpired() error {
+	info, err := afero.ReadDir(c.files, exportDirectory)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return nil
+	case err != nil:
+		return err
+	}
+
+	cutoff := time.Now().Add(-c.config.App.DataExport.Expiration)
+	for _, f := range info {
+		if !f.IsDir() && f.ModTime().Before(cutoff) {
+			if err := c.files.Remove(path.Join(exportDirectory, f.Name())); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package services
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/occult/pagode/pkg/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportClient_Export(t *testing.T) {
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	room, err := c.ORM.ChatRoom.
+		Create().
+		SetName("Exported room").
+		SetOwner(u).
+		Save(context.Background())
+	require.NoError(t, err)
+
+	_, err = c.ORM.ChatMessage.
+		Create().
+		SetRoom(room).
+		SetSender(u).
+		SetBody("Exported message").
+		SetSenderName("Exporter").
+		Save(context.Background())
+	require.NoError(t, err)
+
+	name, err := c.Export.Export(context.Background(), u.ID)
+	require.NoError(t, err)
+
+	token, err := c.Export.GenerateDownloadToken(u.ID, name)
+	require.NoError(t, err)
+
+	f, err := c.Export.Open(token, u.ID)
+	require.NoError(t, err)
+	defer f.Close()
+
+	b, err := io.ReadAll(f)
+	require.NoError(t, err)
+	zr, err := zip.NewReader(bytes.NewReader(b), int64(len(b)))
+	require.NoError(t, err)
+
+	files := make(map[string][]byte)
+	for _, zf := range zr.File {
+		r, err := zf.Open()
+		require.NoError(t, err)
+		files[zf.Name], err = io.ReadAll(r)
+		require.NoError(t, err)
+	}
+
+	// The user has no payment customer, so there is no billing data to export.
+	require.Len(t, files, 4)
+
+	var user map[string]any
+	require.NoError(t, json.Unmarshal(files["user.json"], &user))
+	assert.Equal(t, u.Email, user["email"])
+	assert.NotContains(t, user, "password")
+
+	var messages []map[string]any
+	require.NoError(t, json.Unmarshal(files["chat_messages.json"], &messages))
+	require.Len(t, messages, 1)
+	assert.Equal(t, "Exported message", messages[0]["body"])
+
+	var rooms []map[string]any
+	require.NoError(t, json.Unmarshal(files["chat_rooms.json"], &rooms))
+	require.Len(t, rooms, 1)
+	assert.Equal(t, "Exported room", rooms[0]["name"])
+}
+
+func TestExportClient_Open(t *testing.T) {
+	name, err := c.Export.Export(context.Background(), usr.ID)
+	require.NoError(t, err)
+
+	token, err := c.Export.GenerateDownloadToken(usr.ID, name)
+	require.NoError(t, err)
+
+	// Another user.
+	_, err = c.Export.Open(token, usr.ID+1)
+	assert.Equal(t, InvalidExportTokenError{}, err)
+
+	// Invalid token.
+	_, err = c.Export.Open("abc", usr.ID)
+	assert.Equal(t, InvalidExportTokenError{}, err)
+
+	// Expired token.
+	expiration := c.Config.App.DataExport.Expiration
+	c.Config.App.DataExport.Expiration = -time.Hour
+	expired, err := c.Export.GenerateDownloadToken(usr.ID, name)
+	c.Config.App.DataExport.Expiration = expiration
+	require.NoError(t, err)
+	_, err = c.Export.Open(expired, usr.ID)
+	assert.Equal(t, InvalidExportTokenError{}, err)
+
+	// Removed export.
+	require.NoError(t, c.Files.Remove(path.Join(exportDirectory, name)))
+	_, err = c.Export.Open(token, usr.ID)
+	assert.Equal(t, InvalidExportTokenError{}, err)
+}
@@ -0,0 +1,372 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/occult/pagode/config"
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/accesstoken"
+	"github.com/occult/pagode/ent/credential"
+	"github.com/occult/pagode/ent/identity"
+	"github.com/occult/pagode/ent/logintoken"
+	"github.com/occult/pagode/ent/passwordtoken"
+	"github.com/occult/pagode/ent/recoverycode"
+	entsession "github.com/occult/pagode/ent/session"
+	"github.com/occult/pagode/ent/user"
+	"golang.org/x/oauth2"
+)
+
+// oauthStateCookieName stores the name of the cookie which holds the state of a login with an external provider
+const oauthStateCookieName = "oauth_state"
+
+// OAuthIdentity is the identity of a user at an external identity provider
+type OAuthIdentity struct {
+	// Subject is the ID of the user at the provider.
+	Subject string
+
+	// Email is the email address of the user.
+	Email string
+
+	// EmailVerified indicates if the provider verified that the user owns the email address.
+	EmailVerified bool
+
+	// Name is the name of the user.
+	Name string
+}
+
+// OAuthProvider is an external identity provider which users can log in with using the OAuth2 authorization code
+// flow with PKCE
+type OAuthProvider interface {
+	// Name returns the name of the provider, which is used in URLs.
+	Name() string
+
+	// Label returns the name of the provider displayed to users.
+	Label() string
+
+	// AuthCodeURL returns the URL to redirect the user to in order to log in with the provider.
+	AuthCodeURL(ctx context.Context, redirectURL, state, nonce, verifier string) (string, error)
+
+	// Identity exchanges an authorization code for the identity of the user.
+	Identity(ctx context.Context, redirectURL, code, nonce, verifier string) (*OAuthIdentity, error)
+}
+
+// InvalidOAuthStateError is an error returned when the state of a login with an external provider is missing,
+// expired or does not match the request
+type InvalidOAuthStateError struct{}
+
+// Error implements the error interface.
+func (e InvalidOAuthStateError) Error() string {
+	return "invalid oauth state"
+}
+
+// OAuthAccountExistsError is an error returned when a user with the email address of an external identity already
+// exists but the provider did not verify the email address, so the identity cannot be linked to the user
+type OAuthAccountExistsError struct{}
+
+// Error implements the error interface.
+func (e OAuthAccountExistsError) Error() string {
+	return "a user with this email address already exists"
+}
+
+// OAuthEmailRequiredError is an error returned when an external provider did not provide an email address
+type OAuthEmailRequiredError struct{}
+
+// Error implements the error interface.
+func (e OAuthEmailRequiredError) Error() string {
+	return "the identity provider did not provide an email address"
+}
+
+// OAuthClient is the client that handles logging in with external identity providers
+type OAuthClient struct {
+	config    *config.Config
+	orm       *ent.Client
+	auth      *AuthClient
+	providers map[string]OAuthProvider
+}
+
+// NewOAuthClient creates a new OAuth client with all providers which are enabled in the configuration
+func NewOAuthClient(cfg *config.Config, orm *ent.Client, auth *AuthClient) (*OAuthClient, error) {
+	c := &OAuthClient{
+		config:    cfg,
+		orm:       orm,
+		auth:      auth,
+		providers: make(map[string]OAuthProvider),
+	}
+
+	for name, pc := range cfg.OAuth.Providers {
+		if !pc.Enabled {
+			continue
+		}
+
+		switch pc.Type {
+		case "google":
+			pc.IssuerURL = googleIssuerURL
+			c.Register(NewOIDCProvider(name, pc))
+		case "github":
+			c.Register(NewGitHubProvider(name, pc))
+		case "oidc":
+			c.Register(NewOIDCProvider(name, pc))
+		default:
+			return nil, fmt.Errorf("unsupported oauth provider type for %s: %s", name, pc.Type)
+		}
+	}
+
+	return c, nil
+}
+
+// Register registers a provider, replacing any provider with the same name
+func (c *OAuthClient) Register(p OAuthProvider) {
+	c.providers[p.Name()] = p
+}
+
+// Provider returns the provider with a given name
+func (c *OAuthClient) Provider(name string) (OAuthProvider, bool) {
+	p, ok := c.providers[name]
+	return p, ok
+}
+
+// Providers returns all registered providers, sorted by name
+func (c *OAuthClient) Providers() []OAuthProvider {
+	providers := make([]OAuthProvider, 0, len(c.providers))
+	for _, p := range c.providers {
+		providers = append(providers, p)
+	}
+	slices.SortFunc(providers, func(a, b OAuthProvider) int {
+		return strings.Compare(a.Name(), b.Name())
+	})
+	return providers
+}
+
+// Begin starts a login with a given provider and returns the URL to redirect the user to.
+// The state, nonce and PKCE verifier are stored in a signed cookie rather than the session because the session
+// cookie is not sent when the provider redirects back.
+func (c *OAuthClient) Begin(ctx echo.Context, p OAuthProvider, redirectURL string) (string, error) {
+	state, err := c.auth.RandomToken(32)
+	if err != nil {
+		return "", err
+	}
+
+	nonce, err := c.auth.RandomToken(32)
+	if err != nil {
+		return "", err
+	}
+
+	verifier := oauth2.GenerateVerifier()
+
+	url, err := p.AuthCodeURL(ctx.Request().Context(), redirectURL, state, nonce, verifier)
+	if err != nil {
+		return "", err
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"provider": p.Name(),
+		"state":    state,
+		"nonce":    nonce,
+		"verifier": verifier,
+		"exp":      time.Now().Add(c.config.OAuth.StateExpiration).Unix(),
+	})
+
+	signed, err := token.SignedString([]byte(c.config.App.EncryptionKey))
+	if err != nil {
+		return "", err
+	}
+
+	ctx.SetCookie(&http.Cookie{
+		Name:     oauthStateCookieName,
+		Value:    signed,
+		Path:     "/",
+		MaxAge:   int(c.config.OAuth.StateExpiration.Seconds()),
+		HttpOnly: true,
+		Secure:   c.config.HTTP.TLS.Enabled,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	return url, nil
+}
+
+// Complete completes a login with a given provider once the provider redirected back, and returns the identity of
+// the user
+func (c *OAuthClient) Complete(ctx echo.Context, p OAuthProvider, redirectURL string) (*OAuthIdentity, error) {
+	cookie, err := ctx.Cookie(oauthStateCookieName)
+	if err != nil {
+		return nil, InvalidOAuthStateError{}
+	}
+
+	// The state can only be used once.
+	ctx.SetCookie(&http.Cookie{
+		Name:     oauthStateCookieName,
+		Path:     "/",
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   c.config.HTTP.TLS.Enabled,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	t, err := jwt.Parse(cookie.Value, func(t *jwt.Token) (any, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
+		}
+
+		return []byte(c.config.App.EncryptionKey), nil
+	})
+	if err != nil || !t.Valid {
+		return nil, InvalidOAuthStateError{}
+	}
+
+	claims, ok := t.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, InvalidOAuthStateError{}
+	}
+
+	state, _ := claims["state"].(string)
+	nonce, _ := claims["nonce"].(string)
+	verifier, _ := claims["verifier"].(string)
+
+	if claims["provider"] != p.Name() || state == "" || ctx.QueryParam("state") != state {
+		return nil, InvalidOAuthStateError{}
+	}
+
+	if e := ctx.QueryParam("error"); e != "" {
+		return nil, fmt.Errorf("provider returned an error: %s: %s", e, ctx.QueryParam("error_description"))
+	}
+
+	return p.Identity(ctx.Request().Context(), redirectURL, ctx.QueryParam("code"), nonce, verifier)
+}
+
+// ResolveUser returns the user an external identity belongs to.
+// If the identity has not been used before, it is linked to the user with the same email address if the provider
+// verified the email address, otherwise a new user is created.
+func (c *OAuthClient) ResolveUser(ctx echo.Context, provider string, id *OAuthIdentity) (*ent.User, error) {
+	rctx := ctx.Request().Context()
+
+	idt, err := c.orm.Identity.
+		Query().
+		Where(identity.Provider(provider)).
+		Where(identity.Subject(id.Subject)).
+		WithUser().
+		Only(rctx)
+
+	switch err.(type) {
+	case nil:
+		err = idt.Update().
+			SetEmail(id.Email).
+			SetLastLoginAt(time.Now()).
+			Exec(rctx)
+		return idt.Edges.User, err
+	case *ent.NotFoundError:
+	default:
+		return nil, err
+	}
+
+	if id.Email == "" {
+		return nil, OAuthEmailRequiredError{}
+	}
+
+	tx, err := c.orm.Tx(rctx)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := tx.User.
+		Query().
+		Where(user.Email(strings.ToLower(id.Email))).
+		Only(rctx)
+
+	switch err.(type) {
+	case nil:
+		// Only link to an existing user if the provider verified that the email address belongs to this person,
+		// otherwise anyone could take over an account by registering the address with the provider.
+		if !id.EmailVerified {
+			return nil, rollback(tx, OAuthAccountExistsError{})
+		}
+
+		if !u.Verified {
+			u, err = c.claimUser(rctx, tx, u)
+			if err != nil {
+				return nil, rollback(tx, err)
+			}
+		}
+	case *ent.NotFoundError:
+		u, err = c.createUser(rctx, tx, id)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+	default:
+		return nil, rollback(tx, err)
+	}
+
+	err = tx.Identity.
+		Create().
+		SetProvider(provider).
+		SetSubject(id.Subject).
+		SetEmail(id.Email).
+		SetUserID(u.ID).
+		Exec(rctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	return u, tx.Commit()
+}
+
+// claimUser hands an unverified user over to the owner of its email address, as verified by a provider. Anyone could
+// have registered the address before, so every way to log in which was set up until now is removed.
+func (c *OAuthClient) claimUser(ctx context.Context, tx *ent.Tx, u *ent.User) (*ent.User, error) {
+	password, err := c.auth.RandomToken(64)
+	if err != nil {
+		return nil, err
+	}
+
+	deletes := []interface {
+		Exec(context.Context) (int, error)
+	}{
+		tx.Session.Delete().Where(entsession.UserID(u.ID)),
+		tx.AccessToken.Delete().Where(accesstoken.UserID(u.ID)),
+		tx.Credential.Delete().Where(credential.UserID(u.ID)),
+		tx.RecoveryCode.Delete().Where(recoverycode.UserID(u.ID)),
+		tx.PasswordToken.Delete().Where(passwordtoken.UserID(u.ID)),
+		tx.LoginToken.Delete().Where(logintoken.UserID(u.ID)),
+	}
+	for _, d := range deletes {
+		if _, err = d.Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return u.Update().
+		SetVerified(true).
+		SetPassword(password).
+		ClearTotpSecret().
+		SetTotpLastStep(0).
+		ClearPendingEmail().
+		Save(ctx)
+}
+
+// createUser creates a user for an external identity. Since the user has no password, a random one is set which
+// can be changed by resetting the password.
+func (c *OAuthClient) createUser(ctx context.Context, tx *ent.Tx, id *OAuthIdentity) (*ent.User, error) {
+	password, err := c.auth.RandomToken(64)
+	if err != nil {
+		return nil, err
+	}
+
+	name := id.Name
+	if name == "" {
+		name, _, _ = strings.Cut(id.Email, "@")
+	}
+
+	return tx.User.
+		Create().
+		SetName(name).
+		SetEmail(id.Email).
+		SetPassword(password).
+		SetVerified(id.EmailVerified).
+		Save(ctx)
+}
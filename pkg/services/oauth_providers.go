@@ -0,0 +1,244 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/occult/pagode/config"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/github"
+)
+
+// googleIssuerURL stores the URL used to discover the OpenID Connect configuration of Google
+const googleIssuerURL = "https://accounts.google.com"
+
+// OIDCProvider is an OpenID Connect provider which is configured using discovery
+type OIDCProvider struct {
+	name   string
+	config config.OAuthProviderConfig
+
+	mu       sync.Mutex
+	provider *oidc.Provider
+}
+
+// NewOIDCProvider creates a new OpenID Connect provider.
+// The configuration is discovered when the provider is first used so the application can start while the
+// provider is unavailable.
+func NewOIDCProvider(name string, cfg config.OAuthProviderConfig) *OIDCProvider {
+	if len(cfg.Scopes) == 0 {
+		cfg.Scopes = []string{oidc.ScopeOpenID, "email", "profile"}
+	}
+
+	return &OIDCProvider{
+		name:   name,
+		config: cfg,
+	}
+}
+
+// Name returns the name of the provider
+func (p *OIDCProvider) Name() string {
+	return p.name
+}
+
+// Label returns the name of the provider displayed to users
+func (p *OIDCProvider) Label() string {
+	return p.config.Label
+}
+
+// AuthCodeURL returns the URL to redirect the user to in order to log in with the provider
+func (p *OIDCProvider) AuthCodeURL(ctx context.Context, redirectURL, state, nonce, verifier string) (string, error) {
+	cfg, err := p.oauth2Config(ctx, redirectURL)
+	if err != nil {
+		return "", err
+	}
+
+	return cfg.AuthCodeURL(state, oidc.Nonce(nonce), oauth2.S256ChallengeOption(verifier)), nil
+}
+
+// Identity exchanges an authorization code for the identity of the user, which is taken from the verified ID token
+func (p *OIDCProvider) Identity(ctx context.Context, redirectURL, code, nonce, verifier string) (*OAuthIdentity, error) {
+	cfg, err := p.oauth2Config(ctx, redirectURL)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := cfg.Exchange(ctx, code, oauth2.VerifierOption(verifier))
+	if err != nil {
+		return nil, err
+	}
+
+	raw, ok := token.Extra("id_token").(string)
+	if !ok {
+		return nil, errors.New("token response did not contain an id_token")
+	}
+
+	idToken, err := p.provider.Verifier(&oidc.Config{ClientID: p.config.ClientID}).Verify(ctx, raw)
+	if err != nil {
+		return nil, err
+	}
+
+	if idToken.Nonce != nonce {
+		return nil, errors.New("id_token nonce does not match")
+	}
+
+	var claims struct {
+		Email         string `json:"email"`
+		EmailVerified bool   `json:"email_verified"`
+		Name          string `json:"name"`
+	}
+	if err = idToken.Claims(&claims); err != nil {
+		return nil, err
+	}
+
+	return &OAuthIdentity{
+		Subject:       idToken.Subject,
+		Email:         claims.Email,
+		EmailVerified: claims.EmailVerified,
+		Name:          claims.Name,
+	}, nil
+}
+
+// oauth2Config discovers the configuration of the provider, if needed, and returns the OAuth2 configuration
+func (p *OIDCProvider) oauth2Config(ctx context.Context, redirectURL string) (*oauth2.Config, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.provider == nil {
+		// The provider keeps using the context to fetch signing keys, so it must outlive the request.
+		provider, err := oidc.NewProvider(context.WithoutCancel(ctx), p.config.IssuerURL)
+		if err != nil {
+			return nil, fmt.Errorf("unable to discover oidc provider %s: %w", p.name, err)
+		}
+		p.provider = provider
+	}
+
+	return &oauth2.Config{
+		ClientID:     p.config.ClientID,
+		ClientSecret: p.config.ClientSecret,
+		Endpoint:     p.provider.Endpoint(),
+		RedirectURL:  redirectURL,
+		Scopes:       p.config.Scopes,
+	}, nil
+}
+
+// GitHubProvider is the GitHub OAuth2 provider, which does not support OpenID Connect so the identity is loaded
+// from the GitHub API
+type GitHubProvider struct {
+	name   string
+	config config.OAuthProviderConfig
+
+	// Endpoint stores the OAuth2 endpoint of GitHub.
+	Endpoint oauth2.Endpoint
+
+	// APIURL stores the base URL of the GitHub API.
+	APIURL string
+}
+
+// NewGitHubProvider creates a new GitHub provider
+func NewGitHubProvider(name string, cfg config.OAuthProviderConfig) *GitHubProvider {
+	if len(cfg.Scopes) == 0 {
+		cfg.Scopes = []string{"read:user", "user:email"}
+	}
+
+	return &GitHubProvider{
+		name:     name,
+		config:   cfg,
+		Endpoint: github.Endpoint,
+		APIURL:   "https://api.github.com",
+	}
+}
+
+// Name returns the name of the provider
+func (p *GitHubProvider) Name() string {
+	return p.name
+}
+
+// Label returns the name of the provider displayed to users
+func (p *GitHubProvider) Label() string {
+	return p.config.Label
+}
+
+// AuthCodeURL returns the URL to redirect the user to in order to log in with the provider
+func (p *GitHubProvider) AuthCodeURL(_ context.Context, redirectURL, state, _, verifier string) (string, error) {
+	return p.oauth2Config(redirectURL).AuthCodeURL(state, oauth2.S256ChallengeOption(verifier)), nil
+}
+
+// Identity exchanges an authorization code for the identity of the user
+func (p *GitHubProvider) Identity(ctx context.Context, redirectURL, code, _, verifier string) (*OAuthIdentity, error) {
+	cfg := p.oauth2Config(redirectURL)
+
+	token, err := cfg.Exchange(ctx, code, oauth2.VerifierOption(verifier))
+	if err != nil {
+		return nil, err
+	}
+
+	client := cfg.Client(ctx, token)
+
+	var u struct {
+		ID    int64  `json:"id"`
+		Login string `json:"login"`
+		Name  string `json:"name"`
+	}
+	if err = p.get(client, "/user", &u); err != nil {
+		return nil, err
+	}
+
+	// The email address on the profile may be hidden or unverified, so use the verified primary address instead.
+	var emails []struct {
+		Email    string `json:"email"`
+		Primary  bool   `json:"primary"`
+		Verified bool   `json:"verified"`
+	}
+	if err = p.get(client, "/user/emails", &emails); err != nil {
+		return nil, err
+	}
+
+	id := &OAuthIdentity{
+		Subject: strconv.FormatInt(u.ID, 10),
+		Name:    u.Name,
+	}
+	if id.Name == "" {
+		id.Name = u.Login
+	}
+
+	for _, e := range emails {
+		if e.Primary {
+			id.Email = e.Email
+			id.EmailVerified = e.Verified
+		}
+	}
+
+	return id, nil
+}
+
+// get requests a given path of the GitHub API and decodes the response
+func (p *GitHubProvider) get(client *http.Client, path string, v any) error {
+	resp, err := client.Get(p.APIURL + path)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("github api returned status %d for %s", resp.StatusCode, path)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// oauth2Config returns the OAuth2 configuration
+func (p *GitHubProvider) oauth2Config(redirectURL string) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     p.config.ClientID,
+		ClientSecret: p.config.ClientSecret,
+		Endpoint:     p.Endpoint,
+		RedirectURL:  redirectURL,
+		Scopes:       p.config.Scopes,
+	}
+}
@@ -0,0 +1,201 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/occult/pagode/config"
+	"github.com/occult/pagode/pkg/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOAuthClient(t *testing.T) {
+	idp, err := tests.NewOIDCProvider()
+	require.NoError(t, err)
+	defer idp.Close()
+
+	c.OAuth.Register(NewOIDCProvider("stub", config.OAuthProviderConfig{
+		Label:        "Stub",
+		ClientID:     idp.ClientID,
+		ClientSecret: idp.ClientSecret,
+		IssuerURL:    idp.URL(),
+	}))
+	p, ok := c.OAuth.Provider("stub")
+	require.True(t, ok)
+	assert.Contains(t, c.OAuth.Providers(), p)
+
+	redirectURL := "http://localhost/auth/stub/callback"
+
+	// login logs in at the provider with a given identity and returns the identity the provider returns
+	login := func(identity tests.OIDCIdentity) (*OAuthIdentity, error) {
+		ctx1, rec := tests.NewContext(c.Web, "/auth/stub")
+		authURL, err := c.OAuth.Begin(ctx1, p, redirectURL)
+		require.NoError(t, err)
+
+		u, err := url.Parse(authURL)
+		require.NoError(t, err)
+		assert.Equal(t, redirectURL, u.Query().Get("redirect_uri"))
+
+		code, err := idp.Authorize(authURL, identity)
+		require.NoError(t, err)
+
+		ctx2, _ := tests.NewContext(c.Web, fmt.Sprintf(
+			"/auth/stub/callback?state=%s&code=%s",
+			u.Query().Get("state"),
+			code,
+		))
+		for _, cookie := range rec.Result().Cookies() {
+			ctx2.Request().AddCookie(cookie)
+		}
+		return c.OAuth.Complete(ctx2, p, redirectURL)
+	}
+
+	identity := tests.OIDCIdentity{
+		Subject:       "stub-user-1",
+		Email:         "oauth-stub-1@localhost.localhost",
+		EmailVerified: true,
+		Name:          "Stub User",
+	}
+
+	id, err := login(identity)
+	require.NoError(t, err)
+	assert.Equal(t, &OAuthIdentity{
+		Subject:       identity.Subject,
+		Email:         identity.Email,
+		EmailVerified: true,
+		Name:          identity.Name,
+	}, id)
+
+	// A new user should be created for a new identity
+	u, err := c.OAuth.ResolveUser(ctx, p.Name(), id)
+	require.NoError(t, err)
+	assert.Equal(t, identity.Email, u.Email)
+	assert.Equal(t, identity.Name, u.Name)
+	assert.True(t, u.Verified)
+
+	// The same user should be returned once the identity is linked
+	u2, err := c.OAuth.ResolveUser(ctx, p.Name(), id)
+	require.NoError(t, err)
+	assert.Equal(t, u.ID, u2.ID)
+
+	t.Run("invalid state", func(t *testing.T) {
+		ctx1, _ := tests.NewContext(c.Web, "/auth/stub/callback?state=abc&code=def")
+		_, err := c.OAuth.Complete(ctx1, p, redirectURL)
+		assert.Equal(t, InvalidOAuthStateError{}, err)
+
+		ctx2, rec := tests.NewContext(c.Web, "/auth/stub")
+		_, err = c.OAuth.Begin(ctx2, p, redirectURL)
+		require.NoError(t, err)
+
+		ctx3, _ := tests.NewContext(c.Web, "/auth/stub/callback?state=abc&code=def")
+		for _, cookie := range rec.Result().Cookies() {
+			ctx3.Request().AddCookie(cookie)
+		}
+		_, err = c.OAuth.Complete(ctx3, p, redirectURL)
+		assert.Equal(t, InvalidOAuthStateError{}, err)
+	})
+
+	t.Run("existing user", func(t *testing.T) {
+		existing, err := tests.CreateUser(c.ORM)
+		require.NoError(t, err)
+
+		// Whoever registered the unverified address can already log in to the user.
+		existing, err = existing.Update().
+			SetTotpSecret("secret").
+			Save(context.Background())
+		require.NoError(t, err)
+		err = c.ORM.Session.Create().
+			SetToken(hashSessionToken("oauth-existing")).
+			SetName(AuthSessionName).
+			SetData([]byte{}).
+			SetUserID(existing.ID).
+			SetExpiresAt(time.Now().Add(time.Hour)).
+			Exec(context.Background())
+		require.NoError(t, err)
+		err = c.ORM.AccessToken.Create().
+			SetName("existing").
+			SetToken(hashAccessToken("oauth-existing")).
+			SetScopes([]string{"read"}).
+			SetUserID(existing.ID).
+			Exec(context.Background())
+		require.NoError(t, err)
+
+		// An unverified email address should not be linked to an existing user
+		id, err := login(tests.OIDCIdentity{
+			Subject: "stub-user-2",
+			Email:   existing.Email,
+		})
+		require.NoError(t, err)
+		_, err = c.OAuth.ResolveUser(ctx, p.Name(), id)
+		assert.Equal(t, OAuthAccountExistsError{}, err)
+
+		// A verified email address should be linked to the existing user
+		id.EmailVerified = true
+		u, err := c.OAuth.ResolveUser(ctx, p.Name(), id)
+		require.NoError(t, err)
+		assert.Equal(t, existing.ID, u.ID)
+		assert.True(t, u.Verified)
+
+		count, err := existing.QueryIdentities().Count(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+
+		// Every way to log in which was set up before the address was verified is removed.
+		assert.Error(t, c.Auth.CheckPassword("password", u.Password))
+		assert.Empty(t, u.TotpSecret)
+		count, err = existing.QuerySessions().Count(context.Background())
+		require.NoError(t, err)
+		assert.Zero(t, count)
+		count, err = existing.QueryAccessTokens().Count(context.Background())
+		require.NoError(t, err)
+		assert.Zero(t, count)
+	})
+
+	t.Run("verified user", func(t *testing.T) {
+		existing, err := tests.CreateUser(c.ORM)
+		require.NoError(t, err)
+		existing, err = existing.Update().
+			SetVerified(true).
+			Save(context.Background())
+		require.NoError(t, err)
+
+		// Verified users keep their password.
+		u, err := c.OAuth.ResolveUser(ctx, p.Name(), &OAuthIdentity{
+			Subject:       "stub-user-4",
+			Email:         existing.Email,
+			EmailVerified: true,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, existing.ID, u.ID)
+		assert.NoError(t, c.Auth.CheckPassword("password", u.Password))
+	})
+
+	t.Run("missing email", func(t *testing.T) {
+		_, err := c.OAuth.ResolveUser(ctx, p.Name(), &OAuthIdentity{Subject: "stub-user-3"})
+		assert.Equal(t, OAuthEmailRequiredError{}, err)
+	})
+}
+
+func TestNewOAuthClient(t *testing.T) {
+	cfg := *c.Config
+	cfg.OAuth.Providers = map[string]config.OAuthProviderConfig{
+		"google":   {Enabled: true, Type: "google", Label: "Google"},
+		"github":   {Enabled: true, Type: "github", Label: "GitHub"},
+		"disabled": {Enabled: false, Type: "oidc"},
+	}
+
+	client, err := NewOAuthClient(&cfg, c.ORM, c.Auth)
+	require.NoError(t, err)
+	require.Len(t, client.Providers(), 2)
+	assert.Equal(t, "github", client.Providers()[0].Name())
+	assert.Equal(t, "google", client.Providers()[1].Name())
+
+	cfg.OAuth.Providers["invalid"] = config.OAuthProviderConfig{Enabled: true, Type: "invalid"}
+	_, err = NewOAuthClient(&cfg, c.ORM, c.Auth)
+	assert.Error(t, err)
+}
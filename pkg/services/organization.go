@@ -0,0 +1,318 @@
+package services
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/occult/pagode/config"
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/invitation"
+	"github.com/occult/pagode/ent/membership"
+	"github.com/occult/pagode/pkg/session"
+)
+
+const (
+	// organizationSessionName stores the name of the session which contains the active organization
+	organizationSessionName = "organization"
+
+	// organizationSessionKeyActiveID stores the key used to store the ID of the active organization in the session
+	organizationSessionKeyActiveID = "active_id"
+)
+
+// InvalidInvitationError is an error returned when an invalid or expired invitation token is provided
+type InvalidInvitationError struct{}
+
+// Error implements the error interface.
+func (e InvalidInvitationError) Error() string {
+	return "invalid invitation"
+}
+
+// InvitationEmailMismatchError is an error returned when a user attempts to accept an invitation which was sent
+// to a different email address
+type InvitationEmailMismatchError struct{}
+
+// Error implements the error interface.
+func (e InvitationEmailMismatchError) Error() string {
+	return "invitation was sent to a different email address"
+}
+
+// LastOwnerError is an error returned when removing or demoting the last owner of an organization
+type LastOwnerError struct{}
+
+// Error implements the error interface.
+func (e LastOwnerError) Error() string {
+	return "organization must have an owner"
+}
+
+// OrganizationClient manages organizations, which allow teams of users to share resources, their memberships
+// and the invitations to join them.
+type OrganizationClient struct {
+	config *config.Config
+	orm    *ent.Client
+}
+
+// NewOrganizationClient creates a new OrganizationClient
+func NewOrganizationClient(cfg *config.Config, orm *ent.Client) *OrganizationClient {
+	return &OrganizationClient{
+		config: cfg,
+		orm:    orm,
+	}
+}
+
+// CanManageOrganization returns true if a given membership allows managing the organization, such as its members and billing
+func CanManageOrganization(m *ent.Membership) bool {
+	return m != nil && (m.Role == membership.RoleOwner || m.Role == membership.RoleAdmin)
+}
+
+// Create creates an organization owned by a given user
+func (c *OrganizationClient) Create(ctx echo.Context, owner *ent.User, name string) (*ent.Organization, error) {
+	tx, err := c.orm.Tx(ctx.Request().Context())
+	if err != nil {
+		return nil, err
+	}
+
+	org, err := tx.Organization.
+		Create().
+		SetName(name).
+		Save(ctx.Request().Context())
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	err = tx.Membership.
+		Create().
+		SetOrganization(org).
+		SetUser(owner).
+		SetRole(membership.RoleOwner).
+		Exec(ctx.Request().Context())
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	return org, tx.Commit()
+}
+
+// GetMemberships returns the memberships of a given user along with their organizations
+func (c *OrganizationClient) GetMemberships(ctx echo.Context, userID int) ([]*ent.Membership, error) {
+	return c.orm.Membership.
+		Query().
+		Where(membership.UserID(userID)).
+		WithOrganization().
+		Order(ent.Asc(membership.FieldCreatedAt)).
+		All(ctx.Request().Context())
+}
+
+// GetMembership returns the membership of a given user in a given organization, along with the organization
+func (c *OrganizationClient) GetMembership(ctx echo.Context, orgID, userID int) (*ent.Membership, error) {
+	return c.orm.Membership.
+		Query().
+		Where(membership.OrganizationID(orgID)).
+		Where(membership.UserID(userID)).
+		WithOrganization().
+		Only(ctx.Request().Context())
+}
+
+// GetMembers returns the memberships of an organization along with their users
+func (c *OrganizationClient) GetMembers(ctx echo.Context, orgID int) ([]*ent.Membership, error) {
+	return c.orm.Membership.
+		Query().
+		Where(membership.OrganizationID(orgID)).
+		WithUser().
+		Order(ent.Asc(membership.FieldCreatedAt)).
+		All(ctx.Request().Context())
+}
+
+// RemoveMember removes a given user from an organization, unless they are its last owner
+func (c *OrganizationClient) RemoveMember(ctx echo.Context, orgID, userID int) error {
+	m, err := c.GetMembership(ctx, orgID, userID)
+	if err != nil {
+		return err
+	}
+
+	if m.Role == membership.RoleOwner {
+		owners, err := c.orm.Membership.
+			Query().
+			Where(membership.OrganizationID(orgID)).
+			Where(membership.RoleEQ(membership.RoleOwner)).
+			Count(ctx.Request().Context())
+		if err != nil {
+			return err
+		}
+		if owners <= 1 {
+			return LastOwnerError{}
+		}
+	}
+
+	return c.orm.Membership.
+		DeleteOne(m).
+		Exec(ctx.Request().Context())
+}
+
+// SetActive sets the organization a user is acting on behalf of, which is stored in the session.
+// An ID of zero means the user is acting on their own behalf.
+func (c *OrganizationClient) SetActive(ctx echo.Context, orgID int) error {
+	sess, err := session.Get(ctx, organizationSessionName)
+	if err != nil {
+		return err
+	}
+
+	if orgID == 0 {
+		delete(sess.Values, organizationSessionKeyActiveID)
+	} else {
+		sess.Values[organizationSessionKeyActiveID] = orgID
+	}
+
+	return sess.Save(ctx.Request(), ctx.Response())
+}
+
+// GetActiveMembership returns the membership of a given user in their active organization, along with the
+// organization, or nil if the user is acting on their own behalf or is no longer a member of it.
+func (c *OrganizationClient) GetActiveMembership(ctx echo.Context, u *ent.User) (*ent.Membership, error) {
+	sess, err := session.Get(ctx, organizationSessionName)
+	if err != nil {
+		return nil, err
+	}
+
+	orgID, ok := sess.Values[organizationSessionKeyActiveID].(int)
+	if !ok {
+		return nil, nil
+	}
+
+	m, err := c.GetMembership(ctx, orgID, u.ID)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+
+	return m, err
+}
+
+// Invite creates an invitation for a given email address to join an organization with a given role.
+// The returned token is to be sent to the email address, while only a hash of it is stored.
+func (c *OrganizationClient) Invite(
+	ctx echo.Context,
+	org *ent.Organization,
+	inviter *ent.User,
+	email string,
+	role invitation.Role,
+) (string, *ent.Invitation, error) {
+	token := rand.Text()
+
+	inv, err := c.orm.Invitation.
+		Create().
+		SetToken(hashInvitationToken(token)).
+		SetEmail(strings.ToLower(email)).
+		SetRole(role).
+		SetOrganization(org).
+		SetInvitedBy(inviter).
+		SetExpiresAt(time.Now().Add(c.config.App.Organization.InvitationExpiration)).
+		Save(ctx.Request().Context())
+	if err != nil {
+		return "", nil, err
+	}
+
+	return token, inv, nil
+}
+
+// GetInvitations returns the pending invitations of an organization
+func (c *OrganizationClient) GetInvitations(ctx echo.Context, orgID int) ([]*ent.Invitation, error) {
+	return c.orm.Invitation.
+		Query().
+		Where(invitation.OrganizationID(orgID)).
+		Where(invitation.ExpiresAtGT(time.Now())).
+		Order(ent.Desc(invitation.FieldCreatedAt)).
+		All(ctx.Request().Context())
+}
+
+// RevokeInvitation deletes an invitation of a given organization
+func (c *OrganizationClient) RevokeInvitation(ctx echo.Context, orgID, invitationID int) error {
+	deleted, err := c.orm.Invitation.
+		Delete().
+		Where(invitation.ID(invitationID)).
+		Where(invitation.OrganizationID(orgID)).
+		Exec(ctx.Request().Context())
+	if err == nil && deleted == 0 {
+		return &ent.NotFoundError{}
+	}
+	return err
+}
+
+// GetInvitation returns the valid invitation matching a given token, along with its organization
+func (c *OrganizationClient) GetInvitation(ctx echo.Context, token string) (*ent.Invitation, error) {
+	inv, err := c.orm.Invitation.
+		Query().
+		Where(invitation.Token(hashInvitationToken(token))).
+		Where(invitation.ExpiresAtGT(time.Now())).
+		WithOrganization().
+		Only(ctx.Request().Context())
+	if ent.IsNotFound(err) {
+		return nil, InvalidInvitationError{}
+	}
+	return inv, err
+}
+
+// AcceptInvitation adds a given user to the organization of the invitation matching a given token, provided the
+// invitation was sent to their email address, and deletes the invitation.
+// Users who are already members keep their existing role.
+func (c *OrganizationClient) AcceptInvitation(ctx echo.Context, token string, u *ent.User) (*ent.Membership, error) {
+	inv, err := c.GetInvitation(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.EqualFold(inv.Email, u.Email) {
+		return nil, InvitationEmailMismatchError{}
+	}
+
+	tx, err := c.orm.Tx(ctx.Request().Context())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Invitation.DeleteOne(inv).Exec(ctx.Request().Context()); err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	exists, err := tx.Membership.
+		Query().
+		Where(membership.OrganizationID(inv.OrganizationID)).
+		Where(membership.UserID(u.ID)).
+		Exist(ctx.Request().Context())
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if !exists {
+		err = tx.Membership.
+			Create().
+			SetOrganizationID(inv.OrganizationID).
+			SetUser(u).
+			SetRole(membership.Role(inv.Role)).
+			Exec(ctx.Request().Context())
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return c.GetMembership(ctx, inv.OrganizationID, u.ID)
+}
+
+// Delete deletes an organization along with its memberships and invitations
+func (c *OrganizationClient) Delete(ctx echo.Context, orgID int) error {
+	return c.orm.Organization.
+		DeleteOneID(orgID).
+		Exec(ctx.Request().Context())
+}
+
+// hashInvitationToken hashes an invitation token for storage
+func hashInvitationToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
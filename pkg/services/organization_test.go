@@ -0,0 +1,139 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/occult/pagode/ent/invitation"
+	"github.com/occult/pagode/ent/membership"
+	"github.com/occult/pagode/pkg/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrganizationClient_Create(t *testing.T) {
+	org, err := c.Organization.Create(ctx, usr, "Create")
+	require.NoError(t, err)
+	assert.Equal(t, "Create", org.Name)
+
+	m, err := c.Organization.GetMembership(ctx, org.ID, usr.ID)
+	require.NoError(t, err)
+	assert.Equal(t, membership.RoleOwner, m.Role)
+	assert.Equal(t, org.ID, m.Edges.Organization.ID)
+	assert.True(t, CanManageOrganization(m))
+
+	memberships, err := c.Organization.GetMemberships(ctx, usr.ID)
+	require.NoError(t, err)
+	assert.NotEmpty(t, memberships)
+}
+
+func TestOrganizationClient_Invitations(t *testing.T) {
+	org, err := c.Organization.Create(ctx, usr, "Invitations")
+	require.NoError(t, err)
+
+	invitee, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	token, inv, err := c.Organization.Invite(ctx, org, usr, strings.ToUpper(invitee.Email), invitation.RoleMember)
+	require.NoError(t, err)
+	assert.NotEqual(t, token, inv.Token)
+
+	invitations, err := c.Organization.GetInvitations(ctx, org.ID)
+	require.NoError(t, err)
+	require.Len(t, invitations, 1)
+	assert.Equal(t, invitee.Email, invitations[0].Email)
+
+	_, err = c.Organization.GetInvitation(ctx, "invalid")
+	assert.Equal(t, InvalidInvitationError{}, err)
+
+	got, err := c.Organization.GetInvitation(ctx, token)
+	require.NoError(t, err)
+	assert.Equal(t, org.ID, got.Edges.Organization.ID)
+
+	// Only the invited email address may accept the invitation
+	other, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	_, err = c.Organization.AcceptInvitation(ctx, token, other)
+	assert.Equal(t, InvitationEmailMismatchError{}, err)
+
+	m, err := c.Organization.AcceptInvitation(ctx, token, invitee)
+	require.NoError(t, err)
+	assert.Equal(t, membership.RoleMember, m.Role)
+	assert.False(t, CanManageOrganization(m))
+
+	// The invitation can only be used once
+	_, err = c.Organization.AcceptInvitation(ctx, token, invitee)
+	assert.Equal(t, InvalidInvitationError{}, err)
+
+	members, err := c.Organization.GetMembers(ctx, org.ID)
+	require.NoError(t, err)
+	assert.Len(t, members, 2)
+}
+
+func TestOrganizationClient_RevokeInvitation(t *testing.T) {
+	org, err := c.Organization.Create(ctx, usr, "Revoke")
+	require.NoError(t, err)
+
+	token, inv, err := c.Organization.Invite(ctx, org, usr, "revoke@localhost.localhost", invitation.RoleAdmin)
+	require.NoError(t, err)
+
+	// Invitations of other organizations cannot be revoked
+	err = c.Organization.RevokeInvitation(ctx, org.ID+1000, inv.ID)
+	require.Error(t, err)
+
+	require.NoError(t, c.Organization.RevokeInvitation(ctx, org.ID, inv.ID))
+	_, err = c.Organization.GetInvitation(ctx, token)
+	assert.Equal(t, InvalidInvitationError{}, err)
+}
+
+func TestOrganizationClient_RemoveMember(t *testing.T) {
+	org, err := c.Organization.Create(ctx, usr, "Remove")
+	require.NoError(t, err)
+
+	// The last owner cannot be removed
+	err = c.Organization.RemoveMember(ctx, org.ID, usr.ID)
+	assert.Equal(t, LastOwnerError{}, err)
+
+	member, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	err = c.ORM.Membership.
+		Create().
+		SetOrganization(org).
+		SetUser(member).
+		SetRole(membership.RoleOwner).
+		Exec(context.Background())
+	require.NoError(t, err)
+
+	require.NoError(t, c.Organization.RemoveMember(ctx, org.ID, usr.ID))
+	_, err = c.Organization.GetMembership(ctx, org.ID, usr.ID)
+	assert.Error(t, err)
+}
+
+func TestOrganizationClient_Active(t *testing.T) {
+	m, err := c.Organization.GetActiveMembership(ctx, usr)
+	require.NoError(t, err)
+	assert.Nil(t, m)
+
+	org, err := c.Organization.Create(ctx, usr, "Active")
+	require.NoError(t, err)
+
+	require.NoError(t, c.Organization.SetActive(ctx, org.ID))
+	m, err = c.Organization.GetActiveMembership(ctx, usr)
+	require.NoError(t, err)
+	require.NotNil(t, m)
+	assert.Equal(t, org.ID, m.Edges.Organization.ID)
+
+	// Users who are not members should not be acting on behalf of the organization
+	other, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	m, err = c.Organization.GetActiveMembership(ctx, other)
+	require.NoError(t, err)
+	assert.Nil(t, m)
+
+	require.NoError(t, c.Organization.SetActive(ctx, 0))
+	m, err = c.Organization.GetActiveMembership(ctx, usr)
+	require.NoError(t, err)
+	assert.Nil(t, m)
+}
@@ -2,11 +2,13 @@ package services
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/occult/pagode/config"
 	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/organization"
 	"github.com/occult/pagode/ent/paymentcustomer"
 	"github.com/occult/pagode/ent/paymentintent"
 	"github.com/occult/pagode/ent/paymentmethod"
@@ -16,6 +18,9 @@ import (
 
 // PaymentProvider defines the interface for payment providers (Stripe, PayPal, etc.)
 type PaymentProvider interface {
+	// Name returns the name the provider is registered under, which is stored on the entities it manages
+	Name() string
+
 	// Customer operations
 	CreateCustomer(ctx context.Context, params *CreateCustomerParams) (*CustomerResult, error)
 	GetCustomer(ctx context.Context, customerID string) (*CustomerResult, error)
@@ -43,6 +48,35 @@ type PaymentProvider interface {
 	// Refund operations
 	CreateRefund(ctx context.Context, params *CreateRefundParams) (*RefundResult, error)
 	GetRefund(ctx context.Context, refundID string) (*RefundResult, error)
+
+	// Webhook operations
+	WebhookSignatureHeader() string
+	ParseWebhookEvent(payload []byte, signature string) (*WebhookEvent, error)
+	DecodeWebhookEvent(payload []byte) (*WebhookEvent, error)
+}
+
+// PaymentProviderFactory creates a payment provider from the configuration
+type PaymentProviderFactory func(cfg *config.Config) (PaymentProvider, error)
+
+// paymentProviders stores the factories of the payment providers, keyed by the name they are registered under
+var paymentProviders = make(map[string]PaymentProviderFactory)
+
+// RegisterPaymentProvider registers a payment provider under a given name, which selects it with the
+// payment.provider configuration. It panics if the name is already registered, so it is meant to be called from init.
+func RegisterPaymentProvider(name string, factory PaymentProviderFactory) {
+	if _, exists := paymentProviders[name]; exists {
+		panic(fmt.Sprintf("payment provider already registered: %s", name))
+	}
+	paymentProviders[name] = factory
+}
+
+// NewPaymentProvider creates the payment provider registered under a given name
+func NewPaymentProvider(name string, cfg *config.Config) (PaymentProvider, error) {
+	factory, exists := paymentProviders[name]
+	if !exists {
+		return nil, fmt.Errorf("unsupported payment provider: %s", name)
+	}
+	return factory(cfg)
 }
 
 // PaymentClient wraps the payment provider and provides high-level operations
@@ -66,6 +100,11 @@ func (c *PaymentClient) GetConfig() *config.Config {
 	return c.config
 }
 
+// ProviderName returns the name of the payment provider
+func (c *PaymentClient) ProviderName() string {
+	return c.provider.Name()
+}
+
 // CreateCustomerParams contains parameters for creating a customer
 type CreateCustomerParams struct {
 	Email    string                 `json:"email"`
@@ -201,29 +240,58 @@ func (c *PaymentClient) CreateOrGetCustomer(ctx echo.Context, u *ent.User) (*ent
 		return existingCustomer, nil
 	}
 
-	// Create new customer with provider
-	providerCustomer, err := c.provider.CreateCustomer(ctx.Request().Context(), &CreateCustomerParams{
+	return c.createCustomer(ctx, &CreateCustomerParams{
 		Email: u.Email,
 		Name:  u.Name,
 		Metadata: map[string]interface{}{
 			"user_id": u.ID,
 		},
+	}, func(create *ent.PaymentCustomerCreate) {
+		create.SetUser(u)
+	})
+}
+
+// CreateOrGetOrganizationCustomer creates or retrieves an existing payment customer for an organization, so that
+// its members share its subscriptions. A new customer is billed to the email address of a given user managing it.
+func (c *PaymentClient) CreateOrGetOrganizationCustomer(ctx echo.Context, org *ent.Organization, billingUser *ent.User) (*ent.PaymentCustomer, error) {
+	// Check if customer already exists
+	existingCustomer, err := c.orm.PaymentCustomer.Query().
+		Where(paymentcustomer.HasOrganizationWith(organization.ID(org.ID))).
+		Only(ctx.Request().Context())
+
+	if err == nil {
+		return existingCustomer, nil
+	}
+
+	return c.createCustomer(ctx, &CreateCustomerParams{
+		Email: billingUser.Email,
+		Name:  org.Name,
+		Metadata: map[string]interface{}{
+			"organization_id": org.ID,
+		},
+	}, func(create *ent.PaymentCustomerCreate) {
+		create.SetOrganization(org)
 	})
+}
+
+// createCustomer creates a customer with the provider and saves it, owned by whoever a given function sets
+func (c *PaymentClient) createCustomer(ctx echo.Context, params *CreateCustomerParams, owner func(*ent.PaymentCustomerCreate)) (*ent.PaymentCustomer, error) {
+	// Create new customer with provider
+	providerCustomer, err := c.provider.CreateCustomer(ctx.Request().Context(), params)
 	if err != nil {
 		return nil, err
 	}
 
 	// Save customer to database
-	customer, err := c.orm.PaymentCustomer.Create().
+	create := c.orm.PaymentCustomer.Create().
 		SetProviderCustomerID(providerCustomer.ID).
-		SetProvider("stripe").
+		SetProvider(c.provider.Name()).
 		SetEmail(providerCustomer.Email).
 		SetName(providerCustomer.Name).
-		SetMetadata(providerCustomer.Metadata).
-		SetUser(u).
-		Save(ctx.Request().Context())
+		SetMetadata(providerCustomer.Metadata)
+	owner(create)
 
-	return customer, err
+	return create.Save(ctx.Request().Context())
 }
 
 // CreateOneTimePayment creates a payment intent for a one-time payment
@@ -242,7 +310,7 @@ func (c *PaymentClient) CreateOneTimePayment(ctx echo.Context, customer *ent.Pay
 	// Save payment intent to database
 	paymentIntent, err := c.orm.PaymentIntent.Create().
 		SetProviderPaymentIntentID(providerPaymentIntent.ID).
-		SetProvider("stripe").
+		SetProvider(c.provider.Name()).
 		SetStatus(paymentintent.Status(providerPaymentIntent.Status)).
 		SetAmount(providerPaymentIntent.Amount).
 		SetCurrency(providerPaymentIntent.Currency).
@@ -272,7 +340,7 @@ func (c *PaymentClient) CreateSubscription(ctx echo.Context, customer *ent.Payme
 	// Save subscription to database
 	subscriptionBuilder := c.orm.Subscription.Create().
 		SetProviderSubscriptionID(providerSubscription.ID).
-		SetProvider("stripe").
+		SetProvider(c.provider.Name()).
 		SetStatus(subscription.Status(providerSubscription.Status)).
 		SetPriceID(providerSubscription.PriceID).
 		SetAmount(providerSubscription.Amount).
@@ -323,7 +391,7 @@ func (c *PaymentClient) AttachPaymentMethodToCustomer(ctx echo.Context, customer
 	// Save payment method to database (only display data)
 	paymentMethod, err := c.orm.PaymentMethod.Create().
 		SetProviderPaymentMethodID(providerPaymentMethod.ID).
-		SetProvider("stripe").
+		SetProvider(c.provider.Name()).
 		SetType(paymentmethod.Type(providerPaymentMethod.Type)).
 		SetLastFour(providerPaymentMethod.LastFour).
 		SetBrand(providerPaymentMethod.Brand).
@@ -404,6 +472,53 @@ func (c *PaymentClient) CancelSubscription(ctx echo.Context, customer *ent.Payme
 	return err
 }
 
+// CloseCustomer cancels the subscriptions of a customer which have not ended and detaches their payment methods
+// with the provider, such as when the account they belong to is purged
+func (c *PaymentClient) CloseCustomer(ctx context.Context, customer *ent.PaymentCustomer) error {
+	subs, err := c.orm.Subscription.Query().
+		Where(
+			subscription.HasCustomerWith(paymentcustomer.ID(customer.ID)),
+			subscription.StatusNotIn(subscription.StatusCanceled, subscription.StatusIncompleteExpired),
+		).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, sub := range subs {
+		if _, err = c.provider.CancelSubscription(ctx, sub.ProviderSubscriptionID); err != nil {
+			return err
+		}
+
+		_, err = c.orm.Subscription.UpdateOne(sub).
+			SetStatus(subscription.StatusCanceled).
+			SetCanceledAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	methods, err := c.orm.PaymentMethod.Query().
+		Where(paymentmethod.HasCustomerWith(paymentcustomer.ID(customer.ID))).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, pm := range methods {
+		if _, err = c.provider.DetachPaymentMethod(ctx, pm.ProviderPaymentMethodID); err != nil {
+			return err
+		}
+
+		if err = c.orm.PaymentMethod.DeleteOne(pm).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // GetCustomerPaymentIntents retrieves all payment intents for a customer
 func (c *PaymentClient) GetCustomerPaymentIntents(ctx echo.Context, customer *ent.PaymentCustomer) ([]*ent.PaymentIntent, error) {
 	return c.orm.PaymentIntent.Query().
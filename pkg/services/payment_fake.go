@@ -0,0 +1,864 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/occult/pagode/config"
+)
+
+// PaymentProviderFake is the name of the fake payment provider
+const PaymentProviderFake = "fake"
+
+// Payment method IDs of the fake payment provider which determine the outcome of charging them, like the test cards
+// of Stripe. Charging any other payment method succeeds, unless it is given another outcome with SetOutcome.
+const (
+	FakePaymentMethodSucceeds       = "pm_fake_succeeds"
+	FakePaymentMethodDeclined       = "pm_fake_declined"
+	FakePaymentMethodRequiresAction = "pm_fake_requires_action"
+	FakePaymentMethodDelayed        = "pm_fake_delayed"
+)
+
+// ErrFakeCardDeclined is returned by the fake payment provider when a payment method is declined
+var ErrFakeCardDeclined = errors.New("fake payment provider: card declined")
+
+// FakeOutcome is the outcome of charging a payment method with the fake payment provider
+type FakeOutcome int
+
+const (
+	// FakeOutcomeSucceed charges the payment method immediately
+	FakeOutcomeSucceed FakeOutcome = iota
+
+	// FakeOutcomeDecline declines the payment method
+	FakeOutcomeDecline
+
+	// FakeOutcomeRequireAction requires the customer to authenticate the payment, as with 3D Secure, which is done
+	// with CompleteAction
+	FakeOutcomeRequireAction
+
+	// FakeOutcomeSucceedLater leaves the payment processing until it settles, after the configured delay or when
+	// Settle is called
+	FakeOutcomeSucceedLater
+)
+
+type (
+	// FakeProvider implements the PaymentProvider interface entirely in memory, so the billing flows can be run in
+	// tests and locally without a payment provider account. Everything is lost when the app stops.
+	// The outcome of charges is controlled by the payment method, and every change of state is recorded as a webhook
+	// event which can be taken with WebhookEvents and handled by the PaymentClient, or signed with SignWebhook and
+	// delivered to the webhook route.
+	FakeProvider struct {
+		config config.FakePaymentConfig
+
+		// instance distinguishes the IDs of this provider from those of other instances, which may be stored in the
+		// same database.
+		instance string
+
+		mu             sync.Mutex
+		lastID         int
+		customers      map[string]*CustomerResult
+		paymentIntents map[string]*fakePaymentIntent
+		subscriptions  map[string]*SubscriptionResult
+		paymentMethods map[string]*PaymentMethodResult
+		refunds        map[string]*RefundResult
+		defaults       map[string]string // customer->payment method
+		prices         map[string]FakePrice
+		outcomes       map[string]FakeOutcome
+		events         []*WebhookEvent
+	}
+
+	// FakePrice is a recurring price which subscriptions of the fake payment provider can be created for
+	FakePrice struct {
+		Amount        int64
+		Currency      string
+		Interval      string
+		IntervalCount int
+	}
+
+	// fakePaymentIntent is a payment intent of the fake payment provider
+	fakePaymentIntent struct {
+		PaymentIntentResult
+
+		// subscriptionID is the subscription the payment is for, if any, which becomes active once it succeeds.
+		subscriptionID string
+
+		// settleAt is when a processing payment succeeds.
+		settleAt time.Time
+
+		// refunded is the amount which has been refunded.
+		refunded int64
+	}
+)
+
+// defaultFakePrice is the price of subscriptions of the fake payment provider for prices which were not set
+var defaultFakePrice = FakePrice{
+	Amount:        1000,
+	Currency:      "usd",
+	Interval:      "month",
+	IntervalCount: 1,
+}
+
+func init() {
+	RegisterPaymentProvider(PaymentProviderFake, func(cfg *config.Config) (PaymentProvider, error) {
+		return NewFakeProvider(cfg.Payment.Fake), nil
+	})
+}
+
+// NewFakeProvider creates a new fake payment provider
+func NewFakeProvider(cfg config.FakePaymentConfig) *FakeProvider {
+	return &FakeProvider{
+		config:         cfg,
+		instance:       strings.ToLower(rand.Text()[:8]),
+		customers:      make(map[string]*CustomerResult),
+		paymentIntents: make(map[string]*fakePaymentIntent),
+		subscriptions:  make(map[string]*SubscriptionResult),
+		paymentMethods: make(map[string]*PaymentMethodResult),
+		refunds:        make(map[string]*RefundResult),
+		defaults:       make(map[string]string),
+		prices:         make(map[string]FakePrice),
+		outcomes:       make(map[string]FakeOutcome),
+	}
+}
+
+// Name returns the name of the provider
+func (p *FakeProvider) Name() string {
+	return PaymentProviderFake
+}
+
+// SetOutcome sets the outcome of charging a given payment method
+func (p *FakeProvider) SetOutcome(paymentMethodID string, outcome FakeOutcome) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.outcomes[paymentMethodID] = outcome
+}
+
+// SetPrice sets a price which subscriptions can be created for. Subscriptions for other prices cost 10.00 USD a month.
+func (p *FakeProvider) SetPrice(priceID string, price FakePrice) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.prices[priceID] = price
+}
+
+// CompleteAction completes the authentication of a payment which requires action, which succeeds or is declined
+// depending on whether the customer authenticated it
+func (p *FakeProvider) CompleteAction(paymentIntentID string, authenticated bool) (*PaymentIntentResult, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	pi, err := p.paymentIntent(paymentIntentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if pi.Status != "requires_action" {
+		return nil, fmt.Errorf("fake payment provider: payment intent %s does not require action", pi.ID)
+	}
+
+	if authenticated {
+		p.succeed(pi)
+	} else {
+		p.decline(pi)
+	}
+
+	return pi.result(), nil
+}
+
+// Settle settles all processing payments immediately
+func (p *FakeProvider) Settle() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, id := range slices.Sorted(maps.Keys(p.paymentIntents)) {
+		if pi := p.paymentIntents[id]; pi.Status == "processing" {
+			p.succeed(pi)
+		}
+	}
+}
+
+// WebhookEvents settles the processing payments which are due and returns the webhook events recorded since it was
+// last called, in the order they occurred
+func (p *FakeProvider) WebhookEvents() []*WebhookEvent {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.settleDue()
+
+	events := p.events
+	p.events = nil
+	return events
+}
+
+// SignWebhook returns the signature of a webhook payload, to deliver it in the signature header
+func (p *FakeProvider) SignWebhook(payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(p.config.WebhookSecret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// CreateCustomer creates a new customer
+func (p *FakeProvider) CreateCustomer(ctx context.Context, params *CreateCustomerParams) (*CustomerResult, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	cust := &CustomerResult{
+		ID:       p.newID("cus"),
+		Email:    params.Email,
+		Name:     params.Name,
+		Metadata: params.Metadata,
+		Created:  time.Now(),
+	}
+	p.customers[cust.ID] = cust
+
+	c := *cust
+	return &c, nil
+}
+
+// GetCustomer retrieves a customer
+func (p *FakeProvider) GetCustomer(ctx context.Context, customerID string) (*CustomerResult, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	cust, err := p.customer(customerID)
+	if err != nil {
+		return nil, err
+	}
+
+	c := *cust
+	return &c, nil
+}
+
+// UpdateCustomer updates a customer
+func (p *FakeProvider) UpdateCustomer(ctx context.Context, customerID string, params *UpdateCustomerParams) (*CustomerResult, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	cust, err := p.customer(customerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.Email != "" {
+		cust.Email = params.Email
+	}
+	if params.Name != "" {
+		cust.Name = params.Name
+	}
+	if params.Metadata != nil {
+		cust.Metadata = params.Metadata
+	}
+
+	c := *cust
+	return &c, nil
+}
+
+// CreatePaymentIntent creates a new payment intent, which requires a payment method to be confirmed with
+func (p *FakeProvider) CreatePaymentIntent(ctx context.Context, params *CreatePaymentIntentParams) (*PaymentIntentResult, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, err := p.customer(params.CustomerID); err != nil {
+		return nil, err
+	}
+
+	pi := p.newPaymentIntent(params.CustomerID, params.Amount, params.Currency)
+	pi.Description = params.Description
+	pi.Metadata = params.Metadata
+
+	return pi.result(), nil
+}
+
+// ConfirmPaymentIntent charges a payment method, or the default payment method of the customer if it is empty, for
+// a payment intent
+func (p *FakeProvider) ConfirmPaymentIntent(ctx context.Context, paymentIntentID string, paymentMethodID string) (*PaymentIntentResult, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	pi, err := p.paymentIntent(paymentIntentID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch pi.Status {
+	case "requires_payment_method", "requires_confirmation":
+	default:
+		return nil, fmt.Errorf("fake payment provider: payment intent %s cannot be confirmed in status %s", pi.ID, pi.Status)
+	}
+
+	if paymentMethodID == "" {
+		paymentMethodID = p.defaults[pi.CustomerID]
+	}
+	if paymentMethodID == "" {
+		return nil, fmt.Errorf("fake payment provider: no payment method for payment intent %s", pi.ID)
+	}
+
+	if err = p.charge(pi, paymentMethodID); err != nil {
+		return nil, err
+	}
+
+	return pi.result(), nil
+}
+
+// GetPaymentIntent retrieves a payment intent
+func (p *FakeProvider) GetPaymentIntent(ctx context.Context, paymentIntentID string) (*PaymentIntentResult, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.settleDue()
+
+	pi, err := p.paymentIntent(paymentIntentID)
+	if err != nil {
+		return nil, err
+	}
+
+	return pi.result(), nil
+}
+
+// CancelPaymentIntent cancels a payment intent which has not succeeded
+func (p *FakeProvider) CancelPaymentIntent(ctx context.Context, paymentIntentID string) (*PaymentIntentResult, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	pi, err := p.paymentIntent(paymentIntentID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch pi.Status {
+	case "succeeded", "processing", "canceled":
+		return nil, fmt.Errorf("fake payment provider: payment intent %s cannot be canceled in status %s", pi.ID, pi.Status)
+	}
+
+	pi.Status = "canceled"
+	p.recordEvent("payment_intent.canceled", &WebhookEvent{PaymentIntent: pi.result()})
+
+	return pi.result(), nil
+}
+
+// CreateSubscription creates a new subscription, which is trialing if it has a trial period and otherwise charges
+// the payment method, or the default payment method of the customer, for the first period. Subscriptions are
+// incomplete until their first payment succeeds, and are not created if it is declined.
+func (p *FakeProvider) CreateSubscription(ctx context.Context, params *CreateSubscriptionParams) (*SubscriptionResult, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, err := p.customer(params.CustomerID); err != nil {
+		return nil, err
+	}
+
+	price, exists := p.prices[params.PriceID]
+	if !exists {
+		price = defaultFakePrice
+	}
+
+	now := time.Now()
+	sub := &SubscriptionResult{
+		ID:                 p.newID("sub"),
+		Status:             "trialing",
+		CustomerID:         params.CustomerID,
+		PriceID:            params.PriceID,
+		Amount:             price.Amount,
+		Currency:           price.Currency,
+		Interval:           price.Interval,
+		IntervalCount:      price.IntervalCount,
+		CurrentPeriodStart: now,
+		Metadata:           params.Metadata,
+		Created:            now,
+	}
+
+	if params.TrialPeriodDays > 0 {
+		trialEnd := now.AddDate(0, 0, params.TrialPeriodDays)
+		sub.TrialStart = &now
+		sub.TrialEnd = &trialEnd
+		sub.CurrentPeriodEnd = trialEnd
+	} else {
+		sub.CurrentPeriodEnd = addFakeInterval(now, price)
+
+		paymentMethodID := params.PaymentMethodID
+		if paymentMethodID == "" {
+			paymentMethodID = p.defaults[params.CustomerID]
+		}
+		if paymentMethodID == "" {
+			return nil, fmt.Errorf("fake payment provider: customer %s has no payment method", params.CustomerID)
+		}
+
+		pi := p.newPaymentIntent(params.CustomerID, price.Amount, price.Currency)
+		pi.Description = fmt.Sprintf("Subscription %s", sub.ID)
+		if err := p.charge(pi, paymentMethodID); err != nil {
+			return nil, err
+		}
+
+		// The subscription becomes active once a payment which did not succeed immediately succeeds.
+		pi.subscriptionID = sub.ID
+		sub.Status = "incomplete"
+		if pi.Status == "succeeded" {
+			sub.Status = "active"
+		}
+	}
+
+	p.subscriptions[sub.ID] = sub
+	p.recordEvent("customer.subscription.created", &WebhookEvent{Subscription: copyFakeSubscription(sub)})
+
+	return copyFakeSubscription(sub), nil
+}
+
+// GetSubscription retrieves a subscription
+func (p *FakeProvider) GetSubscription(ctx context.Context, subscriptionID string) (*SubscriptionResult, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.settleDue()
+
+	sub, err := p.subscription(subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return copyFakeSubscription(sub), nil
+}
+
+// UpdateSubscription changes the price, payment method or metadata of a subscription
+func (p *FakeProvider) UpdateSubscription(ctx context.Context, subscriptionID string, params *UpdateSubscriptionParams) (*SubscriptionResult, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	sub, err := p.subscription(subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.PriceID != "" {
+		price, exists := p.prices[params.PriceID]
+		if !exists {
+			price = defaultFakePrice
+		}
+		sub.PriceID = params.PriceID
+		sub.Amount = price.Amount
+		sub.Currency = price.Currency
+		sub.Interval = price.Interval
+		sub.IntervalCount = price.IntervalCount
+	}
+	if params.PaymentMethodID != "" {
+		if _, err = p.paymentMethod(params.PaymentMethodID); err != nil {
+			return nil, err
+		}
+	}
+	if params.Metadata != nil {
+		sub.Metadata = params.Metadata
+	}
+
+	p.recordEvent("customer.subscription.updated", &WebhookEvent{Subscription: copyFakeSubscription(sub)})
+
+	return copyFakeSubscription(sub), nil
+}
+
+// CancelSubscription cancels a subscription immediately
+func (p *FakeProvider) CancelSubscription(ctx context.Context, subscriptionID string) (*SubscriptionResult, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	sub, err := p.subscription(subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if sub.Status != "canceled" {
+		now := time.Now()
+		sub.Status = "canceled"
+		sub.CanceledAt = &now
+		sub.EndedAt = &now
+		p.recordEvent("customer.subscription.deleted", &WebhookEvent{Subscription: copyFakeSubscription(sub)})
+	}
+
+	return copyFakeSubscription(sub), nil
+}
+
+// GetPaymentMethod retrieves a payment method. Payment methods are created when first used, as they would be by
+// the client of a real provider.
+func (p *FakeProvider) GetPaymentMethod(ctx context.Context, paymentMethodID string) (*PaymentMethodResult, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	pm, err := p.paymentMethod(paymentMethodID)
+	if err != nil {
+		return nil, err
+	}
+
+	c := *pm
+	return &c, nil
+}
+
+// AttachPaymentMethod attaches a payment method to a customer
+func (p *FakeProvider) AttachPaymentMethod(ctx context.Context, paymentMethodID, customerID string) (*PaymentMethodResult, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, err := p.customer(customerID); err != nil {
+		return nil, err
+	}
+
+	pm, err := p.paymentMethod(paymentMethodID)
+	if err != nil {
+		return nil, err
+	}
+
+	if pm.CustomerID != "" && pm.CustomerID != customerID {
+		return nil, fmt.Errorf("fake payment provider: payment method %s is attached to another customer", pm.ID)
+	}
+
+	if p.outcome(pm.ID) == FakeOutcomeDecline {
+		return nil, ErrFakeCardDeclined
+	}
+
+	pm.CustomerID = customerID
+	c := *pm
+	p.recordEvent("payment_method.attached", &WebhookEvent{PaymentMethod: &c})
+
+	return &c, nil
+}
+
+// DetachPaymentMethod detaches a payment method from its customer
+func (p *FakeProvider) DetachPaymentMethod(ctx context.Context, paymentMethodID string) (*PaymentMethodResult, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	pm, exists := p.paymentMethods[paymentMethodID]
+	if !exists || pm.CustomerID == "" {
+		return nil, fmt.Errorf("fake payment provider: payment method %s is not attached", paymentMethodID)
+	}
+
+	if p.defaults[pm.CustomerID] == pm.ID {
+		delete(p.defaults, pm.CustomerID)
+	}
+
+	pm.CustomerID = ""
+	c := *pm
+	p.recordEvent("payment_method.detached", &WebhookEvent{PaymentMethod: &c})
+
+	return &c, nil
+}
+
+// ListPaymentMethods lists the payment methods attached to a customer
+func (p *FakeProvider) ListPaymentMethods(ctx context.Context, customerID string) ([]*PaymentMethodResult, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, err := p.customer(customerID); err != nil {
+		return nil, err
+	}
+
+	methods := make([]*PaymentMethodResult, 0)
+	for _, id := range slices.Sorted(maps.Keys(p.paymentMethods)) {
+		if pm := p.paymentMethods[id]; pm.CustomerID == customerID {
+			c := *pm
+			methods = append(methods, &c)
+		}
+	}
+
+	return methods, nil
+}
+
+// SetDefaultPaymentMethod sets the payment method a customer is charged with when none is given
+func (p *FakeProvider) SetDefaultPaymentMethod(ctx context.Context, customerID, paymentMethodID string) (*PaymentMethodResult, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	pm, exists := p.paymentMethods[paymentMethodID]
+	if !exists || pm.CustomerID != customerID {
+		return nil, fmt.Errorf("fake payment provider: payment method %s is not attached to customer %s", paymentMethodID, customerID)
+	}
+
+	p.defaults[customerID] = pm.ID
+
+	c := *pm
+	return &c, nil
+}
+
+// CreateRefund refunds a succeeded payment intent, fully unless an amount is given
+func (p *FakeProvider) CreateRefund(ctx context.Context, params *CreateRefundParams) (*RefundResult, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	pi, err := p.paymentIntent(params.PaymentIntentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if pi.Status != "succeeded" {
+		return nil, fmt.Errorf("fake payment provider: payment intent %s has not succeeded", pi.ID)
+	}
+
+	amount := params.Amount
+	if amount == 0 {
+		amount = pi.Amount - pi.refunded
+	}
+	if amount <= 0 || pi.refunded+amount > pi.Amount {
+		return nil, fmt.Errorf("fake payment provider: cannot refund %d of payment intent %s", amount, pi.ID)
+	}
+	pi.refunded += amount
+
+	refund := &RefundResult{
+		ID:              p.newID("re"),
+		PaymentIntentID: pi.ID,
+		Amount:          amount,
+		Currency:        pi.Currency,
+		Status:          "succeeded",
+		Reason:          params.Reason,
+		Metadata:        params.Metadata,
+		Created:         time.Now(),
+	}
+	p.refunds[refund.ID] = refund
+
+	c := *refund
+	return &c, nil
+}
+
+// GetRefund retrieves a refund
+func (p *FakeProvider) GetRefund(ctx context.Context, refundID string) (*RefundResult, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	refund, exists := p.refunds[refundID]
+	if !exists {
+		return nil, fmt.Errorf("fake payment provider: no such refund: %s", refundID)
+	}
+
+	c := *refund
+	return &c, nil
+}
+
+// WebhookSignatureHeader returns the name of the header webhook payloads are signed in
+func (p *FakeProvider) WebhookSignatureHeader() string {
+	return "Fake-Signature"
+}
+
+// ParseWebhookEvent verifies the signature of a webhook payload, as created by SignWebhook, and parses the event
+func (p *FakeProvider) ParseWebhookEvent(payload []byte, signature string) (*WebhookEvent, error) {
+	expected, err := hex.DecodeString(p.SignWebhook(payload))
+	if err != nil {
+		return nil, err
+	}
+
+	given, err := hex.DecodeString(signature)
+	if err != nil || !hmac.Equal(expected, given) {
+		return nil, InvalidWebhookError{Err: errors.New("signature mismatch")}
+	}
+
+	return p.DecodeWebhookEvent(payload)
+}
+
+// DecodeWebhookEvent parses a webhook payload which has previously been verified
+func (p *FakeProvider) DecodeWebhookEvent(payload []byte) (*WebhookEvent, error) {
+	var event WebhookEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, InvalidWebhookError{Err: err}
+	}
+	event.Payload = payload
+
+	return &event, nil
+}
+
+// newID returns a new ID with a given prefix.
+func (p *FakeProvider) newID(prefix string) string {
+	p.lastID++
+	return fmt.Sprintf("%s_fake_%s_%d", prefix, p.instance, p.lastID)
+}
+
+// customer returns the customer of a given ID.
+func (p *FakeProvider) customer(id string) (*CustomerResult, error) {
+	cust, exists := p.customers[id]
+	if !exists {
+		return nil, fmt.Errorf("fake payment provider: no such customer: %s", id)
+	}
+	return cust, nil
+}
+
+// paymentIntent returns the payment intent of a given ID.
+func (p *FakeProvider) paymentIntent(id string) (*fakePaymentIntent, error) {
+	pi, exists := p.paymentIntents[id]
+	if !exists {
+		return nil, fmt.Errorf("fake payment provider: no such payment intent: %s", id)
+	}
+	return pi, nil
+}
+
+// subscription returns the subscription of a given ID.
+func (p *FakeProvider) subscription(id string) (*SubscriptionResult, error) {
+	sub, exists := p.subscriptions[id]
+	if !exists {
+		return nil, fmt.Errorf("fake payment provider: no such subscription: %s", id)
+	}
+	return sub, nil
+}
+
+// paymentMethod returns the card of a given ID, which is created if it does not exist.
+func (p *FakeProvider) paymentMethod(id string) (*PaymentMethodResult, error) {
+	if id == "" {
+		return nil, errors.New("fake payment provider: no payment method specified")
+	}
+
+	if pm, exists := p.paymentMethods[id]; exists {
+		return pm, nil
+	}
+
+	lastFour := map[FakeOutcome]string{
+		FakeOutcomeSucceed:       "4242",
+		FakeOutcomeDecline:       "0002",
+		FakeOutcomeRequireAction: "3155",
+		FakeOutcomeSucceedLater:  "1117",
+	}
+
+	pm := &PaymentMethodResult{
+		ID:       id,
+		Type:     "card",
+		LastFour: lastFour[p.outcome(id)],
+		Brand:    "visa",
+		ExpMonth: 12,
+		ExpYear:  time.Now().Year() + 5,
+		Created:  time.Now(),
+	}
+	p.paymentMethods[id] = pm
+
+	return pm, nil
+}
+
+// outcome returns the outcome of charging a given payment method.
+func (p *FakeProvider) outcome(paymentMethodID string) FakeOutcome {
+	if outcome, exists := p.outcomes[paymentMethodID]; exists {
+		return outcome
+	}
+
+	switch paymentMethodID {
+	case FakePaymentMethodDeclined:
+		return FakeOutcomeDecline
+	case FakePaymentMethodRequiresAction:
+		return FakeOutcomeRequireAction
+	case FakePaymentMethodDelayed:
+		return FakeOutcomeSucceedLater
+	default:
+		return FakeOutcomeSucceed
+	}
+}
+
+// newPaymentIntent creates a payment intent which requires a payment method.
+func (p *FakeProvider) newPaymentIntent(customerID string, amount int64, currency string) *fakePaymentIntent {
+	id := p.newID("pi")
+	pi := &fakePaymentIntent{
+		PaymentIntentResult: PaymentIntentResult{
+			ID:           id,
+			Status:       "requires_payment_method",
+			Amount:       amount,
+			Currency:     currency,
+			CustomerID:   customerID,
+			ClientSecret: fmt.Sprintf("%s_secret", id),
+			Created:      time.Now(),
+		},
+	}
+	p.paymentIntents[id] = pi
+	return pi
+}
+
+// charge charges a payment method for a payment intent, with the outcome of the payment method.
+func (p *FakeProvider) charge(pi *fakePaymentIntent, paymentMethodID string) error {
+	if _, err := p.paymentMethod(paymentMethodID); err != nil {
+		return err
+	}
+
+	switch p.outcome(paymentMethodID) {
+	case FakeOutcomeDecline:
+		p.decline(pi)
+		return ErrFakeCardDeclined
+	case FakeOutcomeRequireAction:
+		pi.Status = "requires_action"
+		p.recordEvent("payment_intent.requires_action", &WebhookEvent{PaymentIntent: pi.result()})
+	case FakeOutcomeSucceedLater:
+		pi.Status = "processing"
+		pi.settleAt = time.Now().Add(p.config.SettleDelay)
+		p.recordEvent("payment_intent.processing", &WebhookEvent{PaymentIntent: pi.result()})
+	default:
+		p.succeed(pi)
+	}
+
+	return nil
+}
+
+// succeed marks a payment intent as succeeded, along with the subscription it is for.
+func (p *FakeProvider) succeed(pi *fakePaymentIntent) {
+	pi.Status = "succeeded"
+	p.recordEvent("payment_intent.succeeded", &WebhookEvent{PaymentIntent: pi.result()})
+
+	if sub, exists := p.subscriptions[pi.subscriptionID]; exists && sub.Status == "incomplete" {
+		sub.Status = "active"
+		p.recordEvent("customer.subscription.updated", &WebhookEvent{Subscription: copyFakeSubscription(sub)})
+	}
+}
+
+// decline marks a payment intent as requiring another payment method, and the subscription it is for as expired.
+func (p *FakeProvider) decline(pi *fakePaymentIntent) {
+	pi.Status = "requires_payment_method"
+	p.recordEvent("payment_intent.payment_failed", &WebhookEvent{PaymentIntent: pi.result()})
+
+	if sub, exists := p.subscriptions[pi.subscriptionID]; exists && sub.Status == "incomplete" {
+		sub.Status = "incomplete_expired"
+		p.recordEvent("customer.subscription.updated", &WebhookEvent{Subscription: copyFakeSubscription(sub)})
+	}
+}
+
+// settleDue settles the processing payments which are due.
+func (p *FakeProvider) settleDue() {
+	now := time.Now()
+	for _, id := range slices.Sorted(maps.Keys(p.paymentIntents)) {
+		if pi := p.paymentIntents[id]; pi.Status == "processing" && !now.Before(pi.settleAt) {
+			p.succeed(pi)
+		}
+	}
+}
+
+// recordEvent records a webhook event of a given type.
+func (p *FakeProvider) recordEvent(eventType string, event *WebhookEvent) {
+	event.ID = p.newID("evt")
+	event.Type = eventType
+	event.Created = time.Now()
+	event.Payload, _ = json.Marshal(event)
+	p.events = append(p.events, event)
+}
+
+// result returns a copy of the state of the payment intent.
+func (pi *fakePaymentIntent) result() *PaymentIntentResult {
+	r := pi.PaymentIntentResult
+	return &r
+}
+
+// copyFakeSubscription returns a copy of a subscription.
+func copyFakeSubscription(sub *SubscriptionResult) *SubscriptionResult {
+	c := *sub
+	return &c
+}
+
+// addFakeInterval returns the end of a period of a price which starts at a given time.
+func addFakeInterval(start time.Time, price FakePrice) time.Time {
+	count := max(price.IntervalCount, 1)
+
+	switch price.Interval {
+	case "day":
+		return start.AddDate(0, 0, count)
+	case "week":
+		return start.AddDate(0, 0, 7*count)
+	case "year":
+		return start.AddDate(count, 0, 0)
+	default:
+		return start.AddDate(0, count, 0)
+	}
+}
@@ -0,0 +1,220 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/paymentintent"
+	"github.com/occult/pagode/ent/subscription"
+	"github.com/occult/pagode/pkg/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakePaymentTest runs billing flows with the fake payment provider.
+type fakePaymentTest struct {
+	t        *testing.T
+	ctx      echo.Context
+	provider *FakeProvider
+	client   *PaymentClient
+	customer *ent.PaymentCustomer
+}
+
+func newFakePaymentTest(t *testing.T) *fakePaymentTest {
+	f := &fakePaymentTest{
+		t:        t,
+		provider: NewFakeProvider(c.Config.Payment.Fake),
+	}
+	f.client = NewPaymentClient(c.Config, c.ORM, f.provider)
+	f.ctx, _ = tests.NewContext(c.Web, "/")
+
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	f.customer, err = f.client.CreateOrGetCustomer(f.ctx, u)
+	require.NoError(t, err)
+
+	return f
+}
+
+// deliverWebhooks handles the webhook events recorded by the provider.
+func (f *fakePaymentTest) deliverWebhooks() {
+	for _, event := range f.provider.WebhookEvents() {
+		require.NoError(f.t, f.client.HandleWebhookEvent(context.Background(), event))
+	}
+}
+
+func (f *fakePaymentTest) payment(paymentMethodID string) (*ent.PaymentIntent, error) {
+	pi, err := f.client.CreateOneTimePayment(f.ctx, f.customer, 1500, "usd", "Test")
+	require.NoError(f.t, err)
+	assert.Equal(f.t, paymentintent.StatusRequiresPaymentMethod, pi.Status)
+
+	err = f.client.ConfirmPaymentIntent(f.ctx, pi, paymentMethodID)
+	pi, getErr := c.ORM.PaymentIntent.Get(context.Background(), pi.ID)
+	require.NoError(f.t, getErr)
+	return pi, err
+}
+
+func TestFakeProvider_Payments(t *testing.T) {
+	f := newFakePaymentTest(t)
+	assert.Equal(t, PaymentProviderFake, f.customer.Provider)
+
+	t.Run("succeeds", func(t *testing.T) {
+		pi, err := f.payment(FakePaymentMethodSucceeds)
+		require.NoError(t, err)
+		assert.Equal(t, paymentintent.StatusSucceeded, pi.Status)
+		assert.Equal(t, PaymentProviderFake, pi.Provider)
+
+		refund, err := f.client.RefundPayment(f.ctx, pi, 500, "requested_by_customer")
+		require.NoError(t, err)
+		assert.Equal(t, int64(500), refund.Amount)
+		refund, err = f.client.RefundPayment(f.ctx, pi, 0, "")
+		require.NoError(t, err)
+		assert.Equal(t, int64(1000), refund.Amount)
+		_, err = f.client.RefundPayment(f.ctx, pi, 0, "")
+		assert.Error(t, err)
+	})
+
+	t.Run("declined", func(t *testing.T) {
+		pi, err := f.payment(FakePaymentMethodDeclined)
+		assert.ErrorIs(t, err, ErrFakeCardDeclined)
+		assert.Equal(t, paymentintent.StatusRequiresPaymentMethod, pi.Status)
+
+		// Another payment method can be tried.
+		err = f.client.ConfirmPaymentIntent(f.ctx, pi, FakePaymentMethodSucceeds)
+		require.NoError(t, err)
+	})
+
+	t.Run("requires action", func(t *testing.T) {
+		pi, err := f.payment(FakePaymentMethodRequiresAction)
+		require.NoError(t, err)
+		assert.Equal(t, paymentintent.StatusRequiresAction, pi.Status)
+
+		_, err = f.provider.CompleteAction(pi.ProviderPaymentIntentID, true)
+		require.NoError(t, err)
+		f.deliverWebhooks()
+		pi, err = c.ORM.PaymentIntent.Get(context.Background(), pi.ID)
+		require.NoError(t, err)
+		assert.Equal(t, paymentintent.StatusSucceeded, pi.Status)
+
+		// Payments which are not authenticated are declined.
+		pi, err = f.payment(FakePaymentMethodRequiresAction)
+		require.NoError(t, err)
+		_, err = f.provider.CompleteAction(pi.ProviderPaymentIntentID, false)
+		require.NoError(t, err)
+		f.deliverWebhooks()
+		pi, err = c.ORM.PaymentIntent.Get(context.Background(), pi.ID)
+		require.NoError(t, err)
+		assert.Equal(t, paymentintent.StatusRequiresPaymentMethod, pi.Status)
+	})
+
+	t.Run("delayed", func(t *testing.T) {
+		f.provider.SetOutcome("pm_custom", FakeOutcomeSucceedLater)
+		pi, err := f.payment("pm_custom")
+		require.NoError(t, err)
+		assert.Equal(t, paymentintent.StatusProcessing, pi.Status)
+
+		f.provider.Settle()
+		f.deliverWebhooks()
+		pi, err = c.ORM.PaymentIntent.Get(context.Background(), pi.ID)
+		require.NoError(t, err)
+		assert.Equal(t, paymentintent.StatusSucceeded, pi.Status)
+	})
+}
+
+func TestFakeProvider_Subscriptions(t *testing.T) {
+	f := newFakePaymentTest(t)
+	f.provider.SetPrice("price_pro", FakePrice{
+		Amount:        9900,
+		Currency:      "usd",
+		Interval:      "year",
+		IntervalCount: 1,
+	})
+
+	// Subscriptions are charged to the default payment method.
+	_, err := f.client.AttachPaymentMethodToCustomer(f.ctx, f.customer, FakePaymentMethodSucceeds, true)
+	require.NoError(t, err)
+	sub, err := f.client.CreateSubscription(f.ctx, f.customer, "price_pro", &CreateSubscriptionParams{})
+	require.NoError(t, err)
+	assert.Equal(t, subscription.StatusActive, sub.Status)
+	assert.Equal(t, PaymentProviderFake, sub.Provider)
+	assert.Equal(t, int64(9900), sub.Amount)
+	assert.Equal(t, subscription.IntervalYear, sub.Interval)
+	assert.Equal(t, sub.CurrentPeriodStart.AddDate(1, 0, 0), sub.CurrentPeriodEnd)
+
+	// Trials are not charged.
+	sub, err = f.client.CreateSubscription(f.ctx, f.customer, "price_pro", &CreateSubscriptionParams{
+		PaymentMethodID: FakePaymentMethodDeclined,
+		TrialPeriodDays: 14,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, subscription.StatusTrialing, sub.Status)
+
+	// Declined subscriptions are not created.
+	_, err = f.client.CreateSubscription(f.ctx, f.customer, "price_pro", &CreateSubscriptionParams{
+		PaymentMethodID: FakePaymentMethodDeclined,
+	})
+	assert.ErrorIs(t, err, ErrFakeCardDeclined)
+
+	// Subscriptions are incomplete until a delayed payment succeeds.
+	sub, err = f.client.CreateSubscription(f.ctx, f.customer, "price_pro", &CreateSubscriptionParams{
+		PaymentMethodID: FakePaymentMethodDelayed,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, subscription.StatusIncomplete, sub.Status)
+	f.provider.Settle()
+	f.deliverWebhooks()
+	sub, err = c.ORM.Subscription.Get(context.Background(), sub.ID)
+	require.NoError(t, err)
+	assert.Equal(t, subscription.StatusActive, sub.Status)
+
+	// Canceling
+	err = f.client.CancelSubscription(f.ctx, f.customer, sub.ProviderSubscriptionID)
+	require.NoError(t, err)
+	result, err := f.provider.GetSubscription(context.Background(), sub.ProviderSubscriptionID)
+	require.NoError(t, err)
+	assert.Equal(t, "canceled", result.Status)
+}
+
+func TestFakeProvider_Webhooks(t *testing.T) {
+	f := newFakePaymentTest(t)
+	_, err := f.payment(FakePaymentMethodSucceeds)
+	require.NoError(t, err)
+
+	events := f.provider.WebhookEvents()
+	require.Len(t, events, 1)
+	payload := events[0].Payload
+
+	event, err := f.provider.ParseWebhookEvent(payload, f.provider.SignWebhook(payload))
+	require.NoError(t, err)
+	assert.Equal(t, events[0].ID, event.ID)
+	assert.Equal(t, "payment_intent.succeeded", event.Type)
+	require.NotNil(t, event.PaymentIntent)
+	assert.Equal(t, "succeeded", event.PaymentIntent.Status)
+
+	_, err = f.provider.ParseWebhookEvent(payload, "invalid")
+	var invalid InvalidWebhookError
+	assert.True(t, errors.As(err, &invalid))
+
+	// Events are recorded under the name of the provider.
+	require.NoError(t, f.client.HandleWebhookEvent(context.Background(), event))
+	record, err := c.ORM.PaymentEvent.Query().Order(ent.Desc("id")).First(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, PaymentProviderFake, record.Provider)
+	assert.Equal(t, event.ID, record.EventID)
+}
+
+func TestNewPaymentProvider(t *testing.T) {
+	provider, err := NewPaymentProvider(PaymentProviderFake, c.Config)
+	require.NoError(t, err)
+	assert.Equal(t, PaymentProviderFake, provider.Name())
+
+	provider, err = NewPaymentProvider(PaymentProviderStripe, c.Config)
+	require.NoError(t, err)
+	assert.Equal(t, PaymentProviderStripe, provider.Name())
+
+	_, err = NewPaymentProvider("other", c.Config)
+	assert.Error(t, err)
+}
@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 
 	"github.com/occult/pagode/config"
@@ -11,13 +12,23 @@ import (
 	"github.com/stripe/stripe-go/v82/paymentmethod"
 	"github.com/stripe/stripe-go/v82/refund"
 	"github.com/stripe/stripe-go/v82/subscription"
+	"github.com/stripe/stripe-go/v82/webhook"
 )
 
+// PaymentProviderStripe is the name of the Stripe payment provider
+const PaymentProviderStripe = "stripe"
+
 // StripeProvider implements the PaymentProvider interface for Stripe
 type StripeProvider struct {
 	config *config.Config
 }
 
+func init() {
+	RegisterPaymentProvider(PaymentProviderStripe, func(cfg *config.Config) (PaymentProvider, error) {
+		return NewStripeProvider(cfg), nil
+	})
+}
+
 // NewStripeProvider creates a new Stripe payment provider
 func NewStripeProvider(cfg *config.Config) *StripeProvider {
 	stripe.Key = cfg.Payment.Stripe.SecretKey
@@ -26,6 +37,11 @@ func NewStripeProvider(cfg *config.Config) *StripeProvider {
 	}
 }
 
+// Name returns the name of the provider
+func (s *StripeProvider) Name() string {
+	return PaymentProviderStripe
+}
+
 // CreateCustomer creates a new customer in Stripe
 func (s *StripeProvider) CreateCustomer(ctx context.Context, params *CreateCustomerParams) (*CustomerResult, error) {
 	stripeParams := &stripe.CustomerParams{
@@ -50,13 +66,7 @@ func (s *StripeProvider) CreateCustomer(ctx context.Context, params *CreateCusto
 		return nil, err
 	}
 
-	return &CustomerResult{
-		ID:       cust.ID,
-		Email:    cust.Email,
-		Name:     cust.Name,
-		Metadata: convertStripeMetadata(cust.Metadata),
-		Created:  time.Unix(cust.Created, 0),
-	}, nil
+	return stripeCustomerResult(cust), nil
 }
 
 // GetCustomer retrieves a customer from Stripe
@@ -66,13 +76,7 @@ func (s *StripeProvider) GetCustomer(ctx context.Context, customerID string) (*C
 		return nil, err
 	}
 
-	return &CustomerResult{
-		ID:       cust.ID,
-		Email:    cust.Email,
-		Name:     cust.Name,
-		Metadata: convertStripeMetadata(cust.Metadata),
-		Created:  time.Unix(cust.Created, 0),
-	}, nil
+	return stripeCustomerResult(cust), nil
 }
 
 // UpdateCustomer updates a customer in Stripe
@@ -101,13 +105,7 @@ func (s *StripeProvider) UpdateCustomer(ctx context.Context, customerID string,
 		return nil, err
 	}
 
-	return &CustomerResult{
-		ID:       cust.ID,
-		Email:    cust.Email,
-		Name:     cust.Name,
-		Metadata: convertStripeMetadata(cust.Metadata),
-		Created:  time.Unix(cust.Created, 0),
-	}, nil
+	return stripeCustomerResult(cust), nil
 }
 
 // CreatePaymentIntent creates a new payment intent in Stripe
@@ -136,17 +134,7 @@ func (s *StripeProvider) CreatePaymentIntent(ctx context.Context, params *Create
 		return nil, err
 	}
 
-	return &PaymentIntentResult{
-		ID:           pi.ID,
-		Status:       string(pi.Status),
-		Amount:       pi.Amount,
-		Currency:     string(pi.Currency),
-		CustomerID:   pi.Customer.ID,
-		Description:  pi.Description,
-		ClientSecret: pi.ClientSecret,
-		Metadata:     convertStripeMetadata(pi.Metadata),
-		Created:      time.Unix(pi.Created, 0),
-	}, nil
+	return stripePaymentIntentResult(pi), nil
 }
 
 // ConfirmPaymentIntent confirms a payment intent in Stripe
@@ -159,17 +147,7 @@ func (s *StripeProvider) ConfirmPaymentIntent(ctx context.Context, paymentIntent
 		return nil, err
 	}
 
-	return &PaymentIntentResult{
-		ID:           pi.ID,
-		Status:       string(pi.Status),
-		Amount:       pi.Amount,
-		Currency:     string(pi.Currency),
-		CustomerID:   pi.Customer.ID,
-		Description:  pi.Description,
-		ClientSecret: pi.ClientSecret,
-		Metadata:     convertStripeMetadata(pi.Metadata),
-		Created:      time.Unix(pi.Created, 0),
-	}, nil
+	return stripePaymentIntentResult(pi), nil
 }
 
 // GetPaymentIntent retrieves a payment intent from Stripe
@@ -179,17 +157,7 @@ func (s *StripeProvider) GetPaymentIntent(ctx context.Context, paymentIntentID s
 		return nil, err
 	}
 
-	return &PaymentIntentResult{
-		ID:           pi.ID,
-		Status:       string(pi.Status),
-		Amount:       pi.Amount,
-		Currency:     string(pi.Currency),
-		CustomerID:   pi.Customer.ID,
-		Description:  pi.Description,
-		ClientSecret: pi.ClientSecret,
-		Metadata:     convertStripeMetadata(pi.Metadata),
-		Created:      time.Unix(pi.Created, 0),
-	}, nil
+	return stripePaymentIntentResult(pi), nil
 }
 
 // CancelPaymentIntent cancels a payment intent in Stripe
@@ -199,17 +167,7 @@ func (s *StripeProvider) CancelPaymentIntent(ctx context.Context, paymentIntentI
 		return nil, err
 	}
 
-	return &PaymentIntentResult{
-		ID:           pi.ID,
-		Status:       string(pi.Status),
-		Amount:       pi.Amount,
-		Currency:     string(pi.Currency),
-		CustomerID:   pi.Customer.ID,
-		Description:  pi.Description,
-		ClientSecret: pi.ClientSecret,
-		Metadata:     convertStripeMetadata(pi.Metadata),
-		Created:      time.Unix(pi.Created, 0),
-	}, nil
+	return stripePaymentIntentResult(pi), nil
 }
 
 // CreateSubscription creates a new sub in Stripe
@@ -245,49 +203,7 @@ func (s *StripeProvider) CreateSubscription(ctx context.Context, params *CreateS
 		return nil, err
 	}
 
-	result := &SubscriptionResult{
-		ID:         sub.ID,
-		Status:     string(sub.Status),
-		CustomerID: sub.Customer.ID,
-		Metadata:   convertStripeMetadata(sub.Metadata),
-		Created:    time.Unix(sub.Created, 0),
-	}
-
-	// Get pricing information and period information from the first item
-	if len(sub.Items.Data) > 0 {
-		item := sub.Items.Data[0]
-		result.PriceID = item.Price.ID
-		result.Amount = item.Price.UnitAmount
-		result.Currency = string(item.Price.Currency)
-		result.Interval = string(item.Price.Recurring.Interval)
-		result.IntervalCount = int(item.Price.Recurring.IntervalCount)
-
-		// Period information is now at the subscription item level
-		result.CurrentPeriodStart = time.Unix(item.CurrentPeriodStart, 0)
-		result.CurrentPeriodEnd = time.Unix(item.CurrentPeriodEnd, 0)
-	}
-
-	if sub.TrialStart != 0 {
-		trialStart := time.Unix(sub.TrialStart, 0)
-		result.TrialStart = &trialStart
-	}
-
-	if sub.TrialEnd != 0 {
-		trialEnd := time.Unix(sub.TrialEnd, 0)
-		result.TrialEnd = &trialEnd
-	}
-
-	if sub.CanceledAt != 0 {
-		canceledAt := time.Unix(sub.CanceledAt, 0)
-		result.CanceledAt = &canceledAt
-	}
-
-	if sub.EndedAt != 0 {
-		endedAt := time.Unix(sub.EndedAt, 0)
-		result.EndedAt = &endedAt
-	}
-
-	return result, nil
+	return stripeSubscriptionResult(sub), nil
 }
 
 // GetSubscription retrieves a sub from Stripe
@@ -297,49 +213,7 @@ func (s *StripeProvider) GetSubscription(ctx context.Context, subscriptionID str
 		return nil, err
 	}
 
-	result := &SubscriptionResult{
-		ID:         sub.ID,
-		Status:     string(sub.Status),
-		CustomerID: sub.Customer.ID,
-		Metadata:   convertStripeMetadata(sub.Metadata),
-		Created:    time.Unix(sub.Created, 0),
-	}
-
-	// Get pricing information and period information from the first item
-	if len(sub.Items.Data) > 0 {
-		item := sub.Items.Data[0]
-		result.PriceID = item.Price.ID
-		result.Amount = item.Price.UnitAmount
-		result.Currency = string(item.Price.Currency)
-		result.Interval = string(item.Price.Recurring.Interval)
-		result.IntervalCount = int(item.Price.Recurring.IntervalCount)
-
-		// Period information is now at the subscription item level
-		result.CurrentPeriodStart = time.Unix(item.CurrentPeriodStart, 0)
-		result.CurrentPeriodEnd = time.Unix(item.CurrentPeriodEnd, 0)
-	}
-
-	if sub.TrialStart != 0 {
-		trialStart := time.Unix(sub.TrialStart, 0)
-		result.TrialStart = &trialStart
-	}
-
-	if sub.TrialEnd != 0 {
-		trialEnd := time.Unix(sub.TrialEnd, 0)
-		result.TrialEnd = &trialEnd
-	}
-
-	if sub.CanceledAt != 0 {
-		canceledAt := time.Unix(sub.CanceledAt, 0)
-		result.CanceledAt = &canceledAt
-	}
-
-	if sub.EndedAt != 0 {
-		endedAt := time.Unix(sub.EndedAt, 0)
-		result.EndedAt = &endedAt
-	}
-
-	return result, nil
+	return stripeSubscriptionResult(sub), nil
 }
 
 // UpdateSubscription updates a sub in Stripe
@@ -381,29 +255,7 @@ func (s *StripeProvider) UpdateSubscription(ctx context.Context, subscriptionID
 		return nil, err
 	}
 
-	result := &SubscriptionResult{
-		ID:         sub.ID,
-		Status:     string(sub.Status),
-		CustomerID: sub.Customer.ID,
-		Metadata:   convertStripeMetadata(sub.Metadata),
-		Created:    time.Unix(sub.Created, 0),
-	}
-
-	// Get pricing information and period information from the first item
-	if len(sub.Items.Data) > 0 {
-		item := sub.Items.Data[0]
-		result.PriceID = item.Price.ID
-		result.Amount = item.Price.UnitAmount
-		result.Currency = string(item.Price.Currency)
-		result.Interval = string(item.Price.Recurring.Interval)
-		result.IntervalCount = int(item.Price.Recurring.IntervalCount)
-
-		// Period information is now at the subscription item level
-		result.CurrentPeriodStart = time.Unix(item.CurrentPeriodStart, 0)
-		result.CurrentPeriodEnd = time.Unix(item.CurrentPeriodEnd, 0)
-	}
-
-	return result, nil
+	return stripeSubscriptionResult(sub), nil
 }
 
 // CancelSubscription cancels a sub in Stripe
@@ -413,39 +265,7 @@ func (s *StripeProvider) CancelSubscription(ctx context.Context, subscriptionID
 		return nil, err
 	}
 
-	result := &SubscriptionResult{
-		ID:         sub.ID,
-		Status:     string(sub.Status),
-		CustomerID: sub.Customer.ID,
-		Metadata:   convertStripeMetadata(sub.Metadata),
-		Created:    time.Unix(sub.Created, 0),
-	}
-
-	// Get pricing information and period information from the first item
-	if len(sub.Items.Data) > 0 {
-		item := sub.Items.Data[0]
-		result.PriceID = item.Price.ID
-		result.Amount = item.Price.UnitAmount
-		result.Currency = string(item.Price.Currency)
-		result.Interval = string(item.Price.Recurring.Interval)
-		result.IntervalCount = int(item.Price.Recurring.IntervalCount)
-
-		// Period information is now at the subscription item level
-		result.CurrentPeriodStart = time.Unix(item.CurrentPeriodStart, 0)
-		result.CurrentPeriodEnd = time.Unix(item.CurrentPeriodEnd, 0)
-	}
-
-	if sub.CanceledAt != 0 {
-		canceledAt := time.Unix(sub.CanceledAt, 0)
-		result.CanceledAt = &canceledAt
-	}
-
-	if sub.EndedAt != 0 {
-		endedAt := time.Unix(sub.EndedAt, 0)
-		result.EndedAt = &endedAt
-	}
-
-	return result, nil
+	return stripeSubscriptionResult(sub), nil
 }
 
 // GetPaymentMethod retrieves a payment method from Stripe
@@ -455,25 +275,7 @@ func (s *StripeProvider) GetPaymentMethod(ctx context.Context, paymentMethodID s
 		return nil, err
 	}
 
-	result := &PaymentMethodResult{
-		ID:       pm.ID,
-		Type:     string(pm.Type),
-		Metadata: convertStripeMetadata(pm.Metadata),
-		Created:  time.Unix(pm.Created, 0),
-	}
-
-	if pm.Customer != nil {
-		result.CustomerID = pm.Customer.ID
-	}
-
-	if pm.Card != nil {
-		result.LastFour = pm.Card.Last4
-		result.Brand = string(pm.Card.Brand)
-		result.ExpMonth = int(pm.Card.ExpMonth)
-		result.ExpYear = int(pm.Card.ExpYear)
-	}
-
-	return result, nil
+	return stripePaymentMethodResult(pm), nil
 }
 
 // AttachPaymentMethod attaches a payment method to a customer in Stripe
@@ -485,22 +287,7 @@ func (s *StripeProvider) AttachPaymentMethod(ctx context.Context, paymentMethodI
 		return nil, err
 	}
 
-	result := &PaymentMethodResult{
-		ID:         pm.ID,
-		Type:       string(pm.Type),
-		CustomerID: pm.Customer.ID,
-		Metadata:   convertStripeMetadata(pm.Metadata),
-		Created:    time.Unix(pm.Created, 0),
-	}
-
-	if pm.Card != nil {
-		result.LastFour = pm.Card.Last4
-		result.Brand = string(pm.Card.Brand)
-		result.ExpMonth = int(pm.Card.ExpMonth)
-		result.ExpYear = int(pm.Card.ExpYear)
-	}
-
-	return result, nil
+	return stripePaymentMethodResult(pm), nil
 }
 
 // DetachPaymentMethod detaches a payment method from a customer in Stripe
@@ -510,21 +297,7 @@ func (s *StripeProvider) DetachPaymentMethod(ctx context.Context, paymentMethodI
 		return nil, err
 	}
 
-	result := &PaymentMethodResult{
-		ID:       pm.ID,
-		Type:     string(pm.Type),
-		Metadata: convertStripeMetadata(pm.Metadata),
-		Created:  time.Unix(pm.Created, 0),
-	}
-
-	if pm.Card != nil {
-		result.LastFour = pm.Card.Last4
-		result.Brand = string(pm.Card.Brand)
-		result.ExpMonth = int(pm.Card.ExpMonth)
-		result.ExpYear = int(pm.Card.ExpYear)
-	}
-
-	return result, nil
+	return stripePaymentMethodResult(pm), nil
 }
 
 // ListPaymentMethods lists payment methods for a customer in Stripe
@@ -538,23 +311,7 @@ func (s *StripeProvider) ListPaymentMethods(ctx context.Context, customerID stri
 	var results []*PaymentMethodResult
 
 	for iter.Next() {
-		pm := iter.PaymentMethod()
-		result := &PaymentMethodResult{
-			ID:         pm.ID,
-			Type:       string(pm.Type),
-			CustomerID: pm.Customer.ID,
-			Metadata:   convertStripeMetadata(pm.Metadata),
-			Created:    time.Unix(pm.Created, 0),
-		}
-
-		if pm.Card != nil {
-			result.LastFour = pm.Card.Last4
-			result.Brand = string(pm.Card.Brand)
-			result.ExpMonth = int(pm.Card.ExpMonth)
-			result.ExpYear = int(pm.Card.ExpYear)
-		}
-
-		results = append(results, result)
+		results = append(results, stripePaymentMethodResult(iter.PaymentMethod()))
 	}
 
 	return results, iter.Err()
@@ -604,16 +361,7 @@ func (s *StripeProvider) CreateRefund(ctx context.Context, params *CreateRefundP
 		return nil, err
 	}
 
-	return &RefundResult{
-		ID:              r.ID,
-		PaymentIntentID: r.PaymentIntent.ID,
-		Amount:          r.Amount,
-		Currency:        string(r.Currency),
-		Status:          string(r.Status),
-		Reason:          string(r.Reason),
-		Metadata:        convertStripeMetadata(r.Metadata),
-		Created:         time.Unix(r.Created, 0),
-	}, nil
+	return stripeRefundResult(r), nil
 }
 
 // GetRefund retrieves a refund from Stripe
@@ -623,16 +371,216 @@ func (s *StripeProvider) GetRefund(ctx context.Context, refundID string) (*Refun
 		return nil, err
 	}
 
-	return &RefundResult{
-		ID:              r.ID,
-		PaymentIntentID: r.PaymentIntent.ID,
-		Amount:          r.Amount,
-		Currency:        string(r.Currency),
-		Status:          string(r.Status),
-		Reason:          string(r.Reason),
-		Metadata:        convertStripeMetadata(r.Metadata),
-		Created:         time.Unix(r.Created, 0),
-	}, nil
+	return stripeRefundResult(r), nil
+}
+
+// WebhookSignatureHeader returns the name of the header Stripe signs webhook payloads in
+func (s *StripeProvider) WebhookSignatureHeader() string {
+	return "Stripe-Signature"
+}
+
+// ParseWebhookEvent verifies the Stripe-Signature header of a webhook payload and parses the event
+func (s *StripeProvider) ParseWebhookEvent(payload []byte, signature string) (*WebhookEvent, error) {
+	event, err := webhook.ConstructEvent(payload, signature, s.config.Payment.Stripe.WebhookSecret)
+	if err != nil {
+		return nil, InvalidWebhookError{Err: err}
+	}
+
+	return stripeWebhookEvent(event, payload)
+}
+
+// DecodeWebhookEvent parses a Stripe webhook payload which has previously been verified
+func (s *StripeProvider) DecodeWebhookEvent(payload []byte) (*WebhookEvent, error) {
+	var event stripe.Event
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, InvalidWebhookError{Err: err}
+	}
+
+	return stripeWebhookEvent(event, payload)
+}
+
+// stripeWebhookEvent converts a Stripe event to a WebhookEvent
+func stripeWebhookEvent(event stripe.Event, payload []byte) (*WebhookEvent, error) {
+	result := &WebhookEvent{
+		ID:      event.ID,
+		Type:    string(event.Type),
+		Payload: payload,
+		Created: time.Unix(event.Created, 0),
+	}
+
+	switch event.Type {
+	case stripe.EventTypeCustomerSubscriptionCreated,
+		stripe.EventTypeCustomerSubscriptionUpdated,
+		stripe.EventTypeCustomerSubscriptionDeleted,
+		stripe.EventTypeCustomerSubscriptionPaused,
+		stripe.EventTypeCustomerSubscriptionResumed:
+		var sub stripe.Subscription
+		if err := json.Unmarshal(event.Data.Raw, &sub); err != nil {
+			return nil, InvalidWebhookError{Err: err}
+		}
+		result.Subscription = stripeSubscriptionResult(&sub)
+
+	case stripe.EventTypeInvoicePaid,
+		stripe.EventTypeInvoicePaymentFailed:
+		// Invoices only reference the subscription so the current state must be fetched.
+		var inv stripe.Invoice
+		if err := json.Unmarshal(event.Data.Raw, &inv); err != nil {
+			return nil, InvalidWebhookError{Err: err}
+		}
+		if inv.Parent != nil && inv.Parent.SubscriptionDetails != nil && inv.Parent.SubscriptionDetails.Subscription != nil {
+			result.SubscriptionID = inv.Parent.SubscriptionDetails.Subscription.ID
+		}
+
+	case stripe.EventTypePaymentIntentSucceeded,
+		stripe.EventTypePaymentIntentPaymentFailed,
+		stripe.EventTypePaymentIntentProcessing,
+		stripe.EventTypePaymentIntentRequiresAction,
+		stripe.EventTypePaymentIntentCanceled:
+		var pi stripe.PaymentIntent
+		if err := json.Unmarshal(event.Data.Raw, &pi); err != nil {
+			return nil, InvalidWebhookError{Err: err}
+		}
+		result.PaymentIntent = stripePaymentIntentResult(&pi)
+
+	case stripe.EventTypePaymentMethodAttached,
+		stripe.EventTypePaymentMethodUpdated,
+		stripe.EventTypePaymentMethodAutomaticallyUpdated,
+		stripe.EventTypePaymentMethodDetached:
+		var pm stripe.PaymentMethod
+		if err := json.Unmarshal(event.Data.Raw, &pm); err != nil {
+			return nil, InvalidWebhookError{Err: err}
+		}
+		result.PaymentMethod = stripePaymentMethodResult(&pm)
+	}
+
+	return result, nil
+}
+
+// stripeCustomerResult converts a Stripe customer to a CustomerResult
+func stripeCustomerResult(cust *stripe.Customer) *CustomerResult {
+	return &CustomerResult{
+		ID:       cust.ID,
+		Email:    cust.Email,
+		Name:     cust.Name,
+		Metadata: convertStripeMetadata(cust.Metadata),
+		Created:  time.Unix(cust.Created, 0),
+	}
+}
+
+// stripeSubscriptionResult converts a Stripe subscription to a SubscriptionResult
+func stripeSubscriptionResult(sub *stripe.Subscription) *SubscriptionResult {
+	result := &SubscriptionResult{
+		ID:       sub.ID,
+		Status:   string(sub.Status),
+		Metadata: convertStripeMetadata(sub.Metadata),
+		Created:  time.Unix(sub.Created, 0),
+	}
+
+	if sub.Customer != nil {
+		result.CustomerID = sub.Customer.ID
+	}
+
+	// Get pricing information and period information from the first item
+	if sub.Items != nil && len(sub.Items.Data) > 0 {
+		item := sub.Items.Data[0]
+		result.CurrentPeriodStart = time.Unix(item.CurrentPeriodStart, 0)
+		result.CurrentPeriodEnd = time.Unix(item.CurrentPeriodEnd, 0)
+
+		if item.Price != nil {
+			result.PriceID = item.Price.ID
+			result.Amount = item.Price.UnitAmount
+			result.Currency = string(item.Price.Currency)
+
+			if item.Price.Recurring != nil {
+				result.Interval = string(item.Price.Recurring.Interval)
+				result.IntervalCount = int(item.Price.Recurring.IntervalCount)
+			}
+		}
+	}
+
+	if sub.TrialStart != 0 {
+		trialStart := time.Unix(sub.TrialStart, 0)
+		result.TrialStart = &trialStart
+	}
+
+	if sub.TrialEnd != 0 {
+		trialEnd := time.Unix(sub.TrialEnd, 0)
+		result.TrialEnd = &trialEnd
+	}
+
+	if sub.CanceledAt != 0 {
+		canceledAt := time.Unix(sub.CanceledAt, 0)
+		result.CanceledAt = &canceledAt
+	}
+
+	if sub.EndedAt != 0 {
+		endedAt := time.Unix(sub.EndedAt, 0)
+		result.EndedAt = &endedAt
+	}
+
+	return result
+}
+
+// stripePaymentIntentResult converts a Stripe payment intent to a PaymentIntentResult
+func stripePaymentIntentResult(pi *stripe.PaymentIntent) *PaymentIntentResult {
+	result := &PaymentIntentResult{
+		ID:           pi.ID,
+		Status:       string(pi.Status),
+		Amount:       pi.Amount,
+		Currency:     string(pi.Currency),
+		Description:  pi.Description,
+		ClientSecret: pi.ClientSecret,
+		Metadata:     convertStripeMetadata(pi.Metadata),
+		Created:      time.Unix(pi.Created, 0),
+	}
+
+	if pi.Customer != nil {
+		result.CustomerID = pi.Customer.ID
+	}
+
+	return result
+}
+
+// stripePaymentMethodResult converts a Stripe payment method to a PaymentMethodResult
+func stripePaymentMethodResult(pm *stripe.PaymentMethod) *PaymentMethodResult {
+	result := &PaymentMethodResult{
+		ID:       pm.ID,
+		Type:     string(pm.Type),
+		Metadata: convertStripeMetadata(pm.Metadata),
+		Created:  time.Unix(pm.Created, 0),
+	}
+
+	if pm.Customer != nil {
+		result.CustomerID = pm.Customer.ID
+	}
+
+	if pm.Card != nil {
+		result.LastFour = pm.Card.Last4
+		result.Brand = string(pm.Card.Brand)
+		result.ExpMonth = int(pm.Card.ExpMonth)
+		result.ExpYear = int(pm.Card.ExpYear)
+	}
+
+	return result
+}
+
+// stripeRefundResult converts a Stripe refund to a RefundResult
+func stripeRefundResult(r *stripe.Refund) *RefundResult {
+	result := &RefundResult{
+		ID:       r.ID,
+		Amount:   r.Amount,
+		Currency: string(r.Currency),
+		Status:   string(r.Status),
+		Reason:   string(r.Reason),
+		Metadata: convertStripeMetadata(r.Metadata),
+		Created:  time.Unix(r.Created, 0),
+	}
+
+	if r.PaymentIntent != nil {
+		result.PaymentIntentID = r.PaymentIntent.ID
+	}
+
+	return result
 }
 
 // Helper function to convert Stripe metadata to map[string]interface{}
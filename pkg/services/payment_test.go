@@ -0,0 +1,413 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/paymentevent"
+	"github.com/occult/pagode/ent/paymentintent"
+	"github.com/occult/pagode/ent/paymentmethod"
+	"github.com/occult/pagode/ent/subscription"
+	"github.com/occult/pagode/pkg/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stripe/stripe-go/v82"
+	"github.com/stripe/stripe-go/v82/webhook"
+)
+
+func createPaymentCustomer(t *testing.T) *ent.PaymentCustomer {
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	customer, err := c.ORM.PaymentCustomer.Create().
+		SetProviderCustomerID(fmt.Sprintf("cus_%d_%d", u.ID, time.Now().UnixNano())).
+		SetEmail(u.Email).
+		SetUser(u).
+		Save(context.Background())
+	require.NoError(t, err)
+	return customer
+}
+
+func TestPaymentClient_ProcessWebhookEvent(t *testing.T) {
+	customer := createPaymentCustomer(t)
+	now := time.Now().Truncate(time.Second)
+
+	t.Run("subscription", func(t *testing.T) {
+		event := &WebhookEvent{
+			ID:   "evt_sub",
+			Type: "customer.subscription.updated",
+			Subscription: &SubscriptionResult{
+				ID:                 "sub_webhook",
+				Status:             "active",
+				CustomerID:         customer.ProviderCustomerID,
+				PriceID:            "price_123",
+				Amount:             2900,
+				Currency:           "usd",
+				Interval:           "month",
+				IntervalCount:      1,
+				CurrentPeriodStart: now,
+				CurrentPeriodEnd:   now.AddDate(0, 1, 0),
+			},
+		}
+
+		// Unknown subscriptions are created.
+		require.NoError(t, c.Payment.ProcessWebhookEvent(context.Background(), event))
+		sub, err := c.ORM.Subscription.Query().
+			Where(subscription.ProviderSubscriptionID("sub_webhook")).
+			Only(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, subscription.StatusActive, sub.Status)
+		assert.Equal(t, customer.Provider, sub.Provider)
+
+		// Existing subscriptions are updated.
+		canceledAt := now
+		event.Subscription.Status = "canceled"
+		event.Subscription.CanceledAt = &canceledAt
+		require.NoError(t, c.Payment.ProcessWebhookEvent(context.Background(), event))
+		sub, err = c.ORM.Subscription.Get(context.Background(), sub.ID)
+		require.NoError(t, err)
+		assert.Equal(t, subscription.StatusCanceled, sub.Status)
+		assert.True(t, canceledAt.Equal(sub.CanceledAt))
+	})
+
+	t.Run("payment intent", func(t *testing.T) {
+		pi, err := c.ORM.PaymentIntent.Create().
+			SetProviderPaymentIntentID("pi_webhook").
+			SetAmount(1000).
+			SetCustomer(customer).
+			Save(context.Background())
+		require.NoError(t, err)
+
+		err = c.Payment.ProcessWebhookEvent(context.Background(), &WebhookEvent{
+			ID:   "evt_pi",
+			Type: "payment_intent.succeeded",
+			PaymentIntent: &PaymentIntentResult{
+				ID:         "pi_webhook",
+				Status:     "succeeded",
+				Amount:     1000,
+				Currency:   "usd",
+				CustomerID: customer.ProviderCustomerID,
+			},
+		})
+		require.NoError(t, err)
+
+		pi, err = c.ORM.PaymentIntent.Get(context.Background(), pi.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "succeeded", pi.Status.String())
+	})
+
+	t.Run("payment method", func(t *testing.T) {
+		event := &WebhookEvent{
+			ID:   "evt_pm",
+			Type: "payment_method.attached",
+			PaymentMethod: &PaymentMethodResult{
+				ID:         "pm_webhook",
+				Type:       "card",
+				CustomerID: customer.ProviderCustomerID,
+				LastFour:   "4242",
+				Brand:      "visa",
+				ExpMonth:   12,
+				ExpYear:    2030,
+			},
+		}
+		require.NoError(t, c.Payment.ProcessWebhookEvent(context.Background(), event))
+		exists, err := c.ORM.PaymentMethod.Query().
+			Where(paymentmethod.ProviderPaymentMethodID("pm_webhook")).
+			Exist(context.Background())
+		require.NoError(t, err)
+		assert.True(t, exists)
+
+		// Detaching removes the payment method.
+		event.PaymentMethod.CustomerID = ""
+		require.NoError(t, c.Payment.ProcessWebhookEvent(context.Background(), event))
+		exists, err = c.ORM.PaymentMethod.Query().
+			Where(paymentmethod.ProviderPaymentMethodID("pm_webhook")).
+			Exist(context.Background())
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+
+	t.Run("stale events", func(t *testing.T) {
+		event := func(id, status string, created time.Time) *WebhookEvent {
+			return &WebhookEvent{
+				ID:      id,
+				Type:    "payment_intent." + status,
+				Created: created,
+				PaymentIntent: &PaymentIntentResult{
+					ID:         "pi_stale",
+					Status:     status,
+					Amount:     1000,
+					Currency:   "usd",
+					CustomerID: customer.ProviderCustomerID,
+				},
+			}
+		}
+
+		// Events delivered out of order do not roll back the state of newer events.
+		err := c.Payment.ProcessWebhookEvent(context.Background(), event("evt_stale_1", "succeeded", now))
+		require.NoError(t, err)
+		err = c.Payment.ProcessWebhookEvent(context.Background(), event("evt_stale_2", "processing", now.Add(-time.Minute)))
+		require.NoError(t, err)
+
+		pi, err := c.ORM.PaymentIntent.Query().
+			Where(paymentintent.ProviderPaymentIntentID("pi_stale")).
+			Only(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, paymentintent.StatusSucceeded, pi.Status)
+		assert.True(t, now.Equal(pi.SyncedAt))
+
+		// Newer events are applied.
+		err = c.Payment.ProcessWebhookEvent(context.Background(), event("evt_stale_3", "canceled", now.Add(time.Minute)))
+		require.NoError(t, err)
+		pi, err = c.ORM.PaymentIntent.Get(context.Background(), pi.ID)
+		require.NoError(t, err)
+		assert.Equal(t, paymentintent.StatusCanceled, pi.Status)
+	})
+
+	t.Run("unknown customer", func(t *testing.T) {
+		err := c.Payment.ProcessWebhookEvent(context.Background(), &WebhookEvent{
+			ID:   "evt_unknown",
+			Type: "payment_intent.succeeded",
+			PaymentIntent: &PaymentIntentResult{
+				ID:         "pi_unknown",
+				Status:     "succeeded",
+				Currency:   "usd",
+				CustomerID: "cus_unknown",
+			},
+		})
+		assert.NoError(t, err)
+	})
+}
+
+func stripePaymentIntentEvent(eventID, customerID, status string) []byte {
+	return []byte(fmt.Sprintf(`{
+		"id": %q,
+		"object": "event",
+		"api_version": %q,
+		"created": 1700000000,
+		"type": "payment_intent.succeeded",
+		"data": {
+			"object": {
+				"id": "pi_%s",
+				"object": "payment_intent",
+				"amount": 500,
+				"currency": "usd",
+				"customer": %q,
+				"status": %q
+			}
+		}
+	}`, eventID, stripe.APIVersion, eventID, customerID, status))
+}
+
+func TestPaymentClient_HandleWebhookEvent(t *testing.T) {
+	customer := createPaymentCustomer(t)
+	provider := NewStripeProvider(c.Config)
+
+	t.Run("processed once", func(t *testing.T) {
+		event, err := provider.DecodeWebhookEvent(stripePaymentIntentEvent("evt_once", customer.ProviderCustomerID, "succeeded"))
+		require.NoError(t, err)
+		require.NoError(t, c.Payment.HandleWebhookEvent(context.Background(), event))
+
+		record, err := c.ORM.PaymentEvent.Query().
+			Where(paymentevent.EventID("evt_once")).
+			Only(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "stripe", record.Provider)
+		assert.Equal(t, "payment_intent.succeeded", record.Type)
+		assert.Equal(t, string(event.Payload), record.Payload)
+		assert.Equal(t, 1, record.Attempts)
+		assert.False(t, record.ProcessedAt.IsZero())
+		assert.Empty(t, record.Error)
+
+		// Duplicate deliveries are ignored.
+		require.NoError(t, c.Payment.HandleWebhookEvent(context.Background(), event))
+		record, err = c.ORM.PaymentEvent.Get(context.Background(), record.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 1, record.Attempts)
+
+		// Processed events cannot be replayed.
+		err = c.Payment.ReplayWebhookEvent(context.Background(), record.ID)
+		assert.ErrorIs(t, err, ErrWebhookEventProcessed)
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		event, err := provider.DecodeWebhookEvent(stripePaymentIntentEvent("evt_failed", customer.ProviderCustomerID, "invalid"))
+		require.NoError(t, err)
+		assert.Error(t, c.Payment.HandleWebhookEvent(context.Background(), event))
+
+		record, err := c.ORM.PaymentEvent.Query().
+			Where(paymentevent.EventID("evt_failed")).
+			Only(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 1, record.Attempts)
+		assert.True(t, record.ProcessedAt.IsZero())
+		assert.NotEmpty(t, record.Error)
+
+		// Failed events are processed again when redelivered.
+		assert.Error(t, c.Payment.HandleWebhookEvent(context.Background(), event))
+		record, err = c.ORM.PaymentEvent.Get(context.Background(), record.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 2, record.Attempts)
+	})
+
+	t.Run("replay", func(t *testing.T) {
+		record, err := c.ORM.PaymentEvent.Create().
+			SetEventID("evt_replay").
+			SetType("payment_intent.succeeded").
+			SetPayload(string(stripePaymentIntentEvent("evt_replay", customer.ProviderCustomerID, "succeeded"))).
+			SetAttempts(1).
+			SetError("failed").
+			Save(context.Background())
+		require.NoError(t, err)
+
+		require.NoError(t, c.Payment.ReplayWebhookEvent(context.Background(), record.ID))
+		record, err = c.ORM.PaymentEvent.Get(context.Background(), record.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 2, record.Attempts)
+		assert.False(t, record.ProcessedAt.IsZero())
+		assert.Empty(t, record.Error)
+
+		exists, err := c.ORM.PaymentIntent.Query().
+			Where(paymentintent.ProviderPaymentIntentID("pi_evt_replay")).
+			Exist(context.Background())
+		require.NoError(t, err)
+		assert.True(t, exists)
+	})
+}
+
+// closingProvider records the subscriptions and payment methods it is asked to cancel and detach.
+type closingProvider struct {
+	PaymentProvider
+	canceled []string
+	detached []string
+}
+
+func (p *closingProvider) CancelSubscription(ctx context.Context, subscriptionID string) (*SubscriptionResult, error) {
+	p.canceled = append(p.canceled, subscriptionID)
+	return &SubscriptionResult{ID: subscriptionID}, nil
+}
+
+func (p *closingProvider) DetachPaymentMethod(ctx context.Context, paymentMethodID string) (*PaymentMethodResult, error) {
+	p.detached = append(p.detached, paymentMethodID)
+	return &PaymentMethodResult{ID: paymentMethodID}, nil
+}
+
+func TestPaymentClient_CloseCustomer(t *testing.T) {
+	customer := createPaymentCustomer(t)
+	provider := &closingProvider{}
+	client := NewPaymentClient(c.Config, c.ORM, provider)
+
+	createSubscription := func(id string, status subscription.Status) {
+		_, err := c.ORM.Subscription.Create().
+			SetProviderSubscriptionID(id).
+			SetStatus(status).
+			SetPriceID("price_close").
+			SetAmount(1000).
+			SetInterval(subscription.IntervalMonth).
+			SetCustomer(customer).
+			Save(context.Background())
+		require.NoError(t, err)
+	}
+	createSubscription("sub_close_active", subscription.StatusActive)
+	createSubscription("sub_close_canceled", subscription.StatusCanceled)
+
+	_, err := c.ORM.PaymentMethod.Create().
+		SetProviderPaymentMethodID("pm_close").
+		SetCustomer(customer).
+		Save(context.Background())
+	require.NoError(t, err)
+
+	require.NoError(t, client.CloseCustomer(context.Background(), customer))
+	assert.Equal(t, []string{"sub_close_active"}, provider.canceled)
+	assert.Equal(t, []string{"pm_close"}, provider.detached)
+
+	sub, err := c.ORM.Subscription.Query().
+		Where(subscription.ProviderSubscriptionID("sub_close_active")).
+		Only(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, subscription.StatusCanceled, sub.Status)
+	assert.False(t, sub.CanceledAt.IsZero())
+
+	exists, err := c.ORM.PaymentMethod.Query().
+		Where(paymentmethod.ProviderPaymentMethodID("pm_close")).
+		Exist(context.Background())
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestStripeProvider_ParseWebhookEvent(t *testing.T) {
+	provider := NewStripeProvider(c.Config)
+	payload := []byte(fmt.Sprintf(`{
+		"id": "evt_123",
+		"object": "event",
+		"api_version": %q,
+		"created": 1700000000,
+		"type": "payment_intent.succeeded",
+		"data": {
+			"object": {
+				"id": "pi_123",
+				"object": "payment_intent",
+				"amount": 500,
+				"currency": "usd",
+				"customer": "cus_123",
+				"status": "succeeded"
+			}
+		}
+	}`, stripe.APIVersion))
+
+	t.Run("valid signature", func(t *testing.T) {
+		signed := webhook.GenerateTestSignedPayload(&webhook.UnsignedPayload{
+			Payload: payload,
+			Secret:  c.Config.Payment.Stripe.WebhookSecret,
+		})
+		event, err := provider.ParseWebhookEvent(payload, signed.Header)
+		require.NoError(t, err)
+		assert.Equal(t, "evt_123", event.ID)
+		require.NotNil(t, event.PaymentIntent)
+		assert.Equal(t, "pi_123", event.PaymentIntent.ID)
+		assert.Equal(t, "cus_123", event.PaymentIntent.CustomerID)
+		assert.Equal(t, "succeeded", event.PaymentIntent.Status)
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		signed := webhook.GenerateTestSignedPayload(&webhook.UnsignedPayload{
+			Payload: payload,
+			Secret:  "wrong",
+		})
+		_, err := provider.ParseWebhookEvent(payload, signed.Header)
+		var invalid InvalidWebhookError
+		assert.True(t, errors.As(err, &invalid))
+	})
+}
+
+func TestStripeResults(t *testing.T) {
+	// Objects which are not expanded or have no items are converted without the missing details.
+	sub := stripeSubscriptionResult(&stripe.Subscription{
+		ID:     "sub_123",
+		Status: stripe.SubscriptionStatusActive,
+		Items: &stripe.SubscriptionItemList{
+			Data: []*stripe.SubscriptionItem{{CurrentPeriodEnd: 1700000000}},
+		},
+	})
+	assert.Equal(t, "sub_123", sub.ID)
+	assert.Empty(t, sub.CustomerID)
+	assert.Empty(t, sub.PriceID)
+	assert.Equal(t, time.Unix(1700000000, 0), sub.CurrentPeriodEnd)
+
+	pi := stripePaymentIntentResult(&stripe.PaymentIntent{ID: "pi_123"})
+	assert.Equal(t, "pi_123", pi.ID)
+	assert.Empty(t, pi.CustomerID)
+
+	pm := stripePaymentMethodResult(&stripe.PaymentMethod{ID: "pm_123"})
+	assert.Equal(t, "pm_123", pm.ID)
+	assert.Empty(t, pm.CustomerID)
+
+	r := stripeRefundResult(&stripe.Refund{ID: "re_123", Amount: 500})
+	assert.Equal(t, "re_123", r.ID)
+	assert.Equal(t, int64(500), r.Amount)
+	assert.Empty(t, r.PaymentIntentID)
+}
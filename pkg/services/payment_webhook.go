@@ -0,0 +1,457 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/paymentcustomer"
+	"github.com/occult/pagode/ent/paymentevent"
+	"github.com/occult/pagode/ent/paymentintent"
+	"github.com/occult/pagode/ent/paymentmethod"
+	"github.com/occult/pagode/ent/subscription"
+	"github.com/occult/pagode/pkg/log"
+)
+
+// ErrWebhookEventProcessed is returned when attempting to replay a webhook event which was already processed
+var ErrWebhookEventProcessed = errors.New("webhook event has already been processed")
+
+// InvalidWebhookError is an error returned when a webhook payload cannot be verified or parsed
+type InvalidWebhookError struct {
+	Err error
+}
+
+// Error implements the error interface.
+func (e InvalidWebhookError) Error() string {
+	return "invalid webhook: " + e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e InvalidWebhookError) Unwrap() error {
+	return e.Err
+}
+
+// WebhookEvent represents a verified event received from the payment provider.
+// Providers populate whichever objects the event describes so the PaymentClient can reconcile
+// the local entities without knowing anything about the provider's payload format.
+type WebhookEvent struct {
+	ID      string    `json:"id"`
+	Type    string    `json:"type"`
+	Payload []byte    `json:"-"`
+	Created time.Time `json:"created"`
+
+	// Subscription contains the subscription state included in the event, if any
+	Subscription *SubscriptionResult `json:"subscription,omitempty"`
+
+	// SubscriptionID references a subscription which should be re-fetched from the provider because the
+	// event only references it (ie, when an invoice is paid)
+	SubscriptionID string `json:"subscription_id,omitempty"`
+
+	// PaymentIntent contains the payment intent state included in the event, if any
+	PaymentIntent *PaymentIntentResult `json:"payment_intent,omitempty"`
+
+	// PaymentMethod contains the payment method state included in the event, if any.
+	// Payment methods which have been detached from their customer have no CustomerID.
+	PaymentMethod *PaymentMethodResult `json:"payment_method,omitempty"`
+}
+
+// WebhookSignatureHeader returns the name of the header of webhook requests which contains the signature of the payload
+func (c *PaymentClient) WebhookSignatureHeader() string {
+	return c.provider.WebhookSignatureHeader()
+}
+
+// ParseWebhookEvent verifies the signature of a webhook payload and parses it in to an event
+func (c *PaymentClient) ParseWebhookEvent(payload []byte, signature string) (*WebhookEvent, error) {
+	return c.provider.ParseWebhookEvent(payload, signature)
+}
+
+// HandleWebhookEvent records a webhook event in the event log and processes it.
+// Events which have already been processed successfully are ignored so duplicate deliveries from the provider
+// never get processed twice. Events which previously failed are processed again.
+func (c *PaymentClient) HandleWebhookEvent(ctx context.Context, event *WebhookEvent) error {
+	record, err := c.orm.PaymentEvent.Query().
+		Where(
+			paymentevent.Provider(c.provider.Name()),
+			paymentevent.EventID(event.ID),
+		).
+		Only(ctx)
+
+	switch {
+	case err == nil:
+		if !record.ProcessedAt.IsZero() {
+			log.Default().Info("ignoring duplicate webhook event",
+				"event_id", event.ID,
+				"event_type", event.Type,
+			)
+			return nil
+		}
+
+	case ent.IsNotFound(err):
+		record, err = c.orm.PaymentEvent.Create().
+			SetProvider(c.provider.Name()).
+			SetEventID(event.ID).
+			SetType(event.Type).
+			SetPayload(string(event.Payload)).
+			Save(ctx)
+
+		switch {
+		case err == nil:
+		case ent.IsConstraintError(err):
+			// The same event is being delivered concurrently.
+			return nil
+		default:
+			return err
+		}
+
+	default:
+		return err
+	}
+
+	return c.processPaymentEvent(ctx, record, event)
+}
+
+// ReplayWebhookEvent processes a recorded webhook event which previously failed
+func (c *PaymentClient) ReplayWebhookEvent(ctx context.Context, id int) error {
+	record, err := c.orm.PaymentEvent.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if !record.ProcessedAt.IsZero() {
+		return ErrWebhookEventProcessed
+	}
+
+	if record.Provider != c.provider.Name() {
+		return fmt.Errorf("webhook event was received from payment provider %s, not %s", record.Provider, c.provider.Name())
+	}
+
+	// The payload was verified when it was received so it only has to be decoded.
+	event, err := c.provider.DecodeWebhookEvent([]byte(record.Payload))
+	if err != nil {
+		return err
+	}
+
+	return c.processPaymentEvent(ctx, record, event)
+}
+
+// ReplayFailedWebhookEvents processes all recorded webhook events which have not been processed successfully,
+// in the order they were received, and returns the amount that succeeded
+func (c *PaymentClient) ReplayFailedWebhookEvents(ctx context.Context) (int, error) {
+	ids, err := c.orm.PaymentEvent.Query().
+		Where(paymentevent.ProcessedAtIsNil()).
+		Order(ent.Asc(paymentevent.FieldID)).
+		IDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var replayed int
+	var errs []error
+	for _, id := range ids {
+		if err := c.ReplayWebhookEvent(ctx, id); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		replayed++
+	}
+
+	return replayed, errors.Join(errs...)
+}
+
+// processPaymentEvent processes a webhook event and records the outcome on the event log entry
+func (c *PaymentClient) processPaymentEvent(ctx context.Context, record *ent.PaymentEvent, event *WebhookEvent) error {
+	processErr := c.ProcessWebhookEvent(ctx, event)
+
+	op := record.Update().
+		AddAttempts(1)
+
+	if processErr != nil {
+		op.SetError(processErr.Error())
+	} else {
+		op.SetProcessedAt(time.Now()).
+			ClearError()
+	}
+
+	if err := op.Exec(ctx); err != nil {
+		return err
+	}
+
+	return processErr
+}
+
+// ProcessWebhookEvent reconciles the local payment entities with the state described by a webhook event.
+// Events referring to customers which do not exist locally are ignored, as are events which were created before the
+// latest event already applied to an entity, since providers do not deliver events in order.
+func (c *PaymentClient) ProcessWebhookEvent(ctx context.Context, event *WebhookEvent) error {
+	subscriptionAt := event.Created
+	if event.Subscription == nil && event.SubscriptionID != "" {
+		sub, err := c.provider.GetSubscription(ctx, event.SubscriptionID)
+		if err != nil {
+			return err
+		}
+		event.Subscription = sub
+		// The subscription which was fetched is current rather than as old as the event.
+		subscriptionAt = time.Now()
+	}
+
+	if event.Subscription != nil {
+		if err := c.syncSubscription(ctx, event.Subscription, subscriptionAt); err != nil {
+			return err
+		}
+	}
+
+	if event.PaymentIntent != nil {
+		if err := c.syncPaymentIntent(ctx, event.PaymentIntent, event.Created); err != nil {
+			return err
+		}
+	}
+
+	if event.PaymentMethod != nil {
+		if err := c.syncPaymentMethod(ctx, event.PaymentMethod, event.Created); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isStaleWebhook returns whether the state of an entity as of a given time is older than the state which was last
+// synced to it, and logs that it is ignored
+func isStaleWebhook(entity, providerID string, at, syncedAt time.Time) bool {
+	if !at.Before(syncedAt) {
+		return false
+	}
+
+	log.Default().Info("ignoring stale webhook event",
+		"entity", entity,
+		"provider_id", providerID,
+		"created", at,
+		"synced_at", syncedAt,
+	)
+	return true
+}
+
+// getCustomerByProviderID loads the payment customer for a given provider customer ID, returning nil if
+// the customer does not exist locally
+func (c *PaymentClient) getCustomerByProviderID(ctx context.Context, providerCustomerID string) (*ent.PaymentCustomer, error) {
+	if providerCustomerID == "" {
+		return nil, nil
+	}
+
+	customer, err := c.orm.PaymentCustomer.Query().
+		Where(paymentcustomer.ProviderCustomerID(providerCustomerID)).
+		First(ctx)
+
+	switch {
+	case err == nil:
+		return customer, nil
+	case ent.IsNotFound(err):
+		log.Default().Warn("ignoring webhook for unknown payment customer",
+			"provider_customer_id", providerCustomerID,
+		)
+		return nil, nil
+	default:
+		return nil, err
+	}
+}
+
+// syncSubscription creates or updates the local subscription to match the provider's state as of a given time
+func (c *PaymentClient) syncSubscription(ctx context.Context, result *SubscriptionResult, at time.Time) error {
+	sub, err := c.orm.Subscription.Query().
+		Where(subscription.ProviderSubscriptionID(result.ID)).
+		Only(ctx)
+
+	switch {
+	case err == nil:
+		if isStaleWebhook("subscription", result.ID, at, sub.SyncedAt) {
+			return nil
+		}
+
+		op := sub.Update().
+			SetStatus(subscription.Status(result.Status)).
+			SetMetadata(result.Metadata).
+			SetSyncedAt(at)
+
+		if result.PriceID != "" {
+			op.SetPriceID(result.PriceID).
+				SetAmount(result.Amount).
+				SetCurrency(result.Currency).
+				SetInterval(subscription.Interval(result.Interval)).
+				SetIntervalCount(result.IntervalCount)
+		}
+		if !result.CurrentPeriodStart.IsZero() {
+			op.SetCurrentPeriodStart(result.CurrentPeriodStart)
+		}
+		if !result.CurrentPeriodEnd.IsZero() {
+			op.SetCurrentPeriodEnd(result.CurrentPeriodEnd)
+		}
+		if result.TrialStart != nil {
+			op.SetTrialStart(*result.TrialStart)
+		}
+		if result.TrialEnd != nil {
+			op.SetTrialEnd(*result.TrialEnd)
+		}
+		if result.CanceledAt != nil {
+			op.SetCanceledAt(*result.CanceledAt)
+		} else {
+			op.ClearCanceledAt()
+		}
+		if result.EndedAt != nil {
+			op.SetEndedAt(*result.EndedAt)
+		} else {
+			op.ClearEndedAt()
+		}
+
+		return op.Exec(ctx)
+
+	case ent.IsNotFound(err):
+		// Subscriptions without pricing information cannot be stored.
+		if result.PriceID == "" {
+			return nil
+		}
+
+		customer, err := c.getCustomerByProviderID(ctx, result.CustomerID)
+		if err != nil || customer == nil {
+			return err
+		}
+
+		op := c.orm.Subscription.Create().
+			SetProviderSubscriptionID(result.ID).
+			SetProvider(customer.Provider).
+			SetStatus(subscription.Status(result.Status)).
+			SetPriceID(result.PriceID).
+			SetAmount(result.Amount).
+			SetCurrency(result.Currency).
+			SetInterval(subscription.Interval(result.Interval)).
+			SetIntervalCount(result.IntervalCount).
+			SetCurrentPeriodStart(result.CurrentPeriodStart).
+			SetCurrentPeriodEnd(result.CurrentPeriodEnd).
+			SetNillableTrialStart(result.TrialStart).
+			SetNillableTrialEnd(result.TrialEnd).
+			SetNillableCanceledAt(result.CanceledAt).
+			SetNillableEndedAt(result.EndedAt).
+			SetMetadata(result.Metadata).
+			SetSyncedAt(at).
+			SetCustomer(customer)
+
+		return op.Exec(ctx)
+
+	default:
+		return err
+	}
+}
+
+// syncPaymentIntent creates or updates the local payment intent to match the provider's state as of a given time
+func (c *PaymentClient) syncPaymentIntent(ctx context.Context, result *PaymentIntentResult, at time.Time) error {
+	pi, err := c.orm.PaymentIntent.Query().
+		Where(paymentintent.ProviderPaymentIntentID(result.ID)).
+		Only(ctx)
+
+	switch {
+	case err == nil:
+		if isStaleWebhook("payment_intent", result.ID, at, pi.SyncedAt) {
+			return nil
+		}
+
+		return pi.Update().
+			SetStatus(paymentintent.Status(result.Status)).
+			SetAmount(result.Amount).
+			SetCurrency(result.Currency).
+			SetDescription(result.Description).
+			SetMetadata(result.Metadata).
+			SetSyncedAt(at).
+			Exec(ctx)
+
+	case ent.IsNotFound(err):
+		customer, err := c.getCustomerByProviderID(ctx, result.CustomerID)
+		if err != nil || customer == nil {
+			return err
+		}
+
+		return c.orm.PaymentIntent.Create().
+			SetProviderPaymentIntentID(result.ID).
+			SetProvider(customer.Provider).
+			SetStatus(paymentintent.Status(result.Status)).
+			SetAmount(result.Amount).
+			SetCurrency(result.Currency).
+			SetDescription(result.Description).
+			SetClientSecret(result.ClientSecret).
+			SetMetadata(result.Metadata).
+			SetSyncedAt(at).
+			SetCustomer(customer).
+			Exec(ctx)
+
+	default:
+		return err
+	}
+}
+
+// syncPaymentMethod creates, updates or removes the local payment method to match the provider's state as of a
+// given time
+func (c *PaymentClient) syncPaymentMethod(ctx context.Context, result *PaymentMethodResult, at time.Time) error {
+	pm, err := c.orm.PaymentMethod.Query().
+		Where(paymentmethod.ProviderPaymentMethodID(result.ID)).
+		Only(ctx)
+
+	switch {
+	case err == nil:
+		if isStaleWebhook("payment_method", result.ID, at, pm.SyncedAt) {
+			return nil
+		}
+
+		// Detached payment methods no longer belong to anyone.
+		if result.CustomerID == "" {
+			return c.orm.PaymentMethod.DeleteOne(pm).Exec(ctx)
+		}
+
+		op := pm.Update().
+			SetLastFour(result.LastFour).
+			SetBrand(result.Brand).
+			SetMetadata(result.Metadata).
+			SetSyncedAt(at)
+
+		if result.ExpMonth > 0 {
+			op.SetExpMonth(result.ExpMonth)
+		}
+		if result.ExpYear > 0 {
+			op.SetExpYear(result.ExpYear)
+		}
+
+		return op.Exec(ctx)
+
+	case ent.IsNotFound(err):
+		// Only payment method types that can be displayed and still belong to a customer are stored.
+		if result.CustomerID == "" || paymentmethod.TypeValidator(paymentmethod.Type(result.Type)) != nil {
+			return nil
+		}
+
+		customer, err := c.getCustomerByProviderID(ctx, result.CustomerID)
+		if err != nil || customer == nil {
+			return err
+		}
+
+		op := c.orm.PaymentMethod.Create().
+			SetProviderPaymentMethodID(result.ID).
+			SetProvider(customer.Provider).
+			SetType(paymentmethod.Type(result.Type)).
+			SetLastFour(result.LastFour).
+			SetBrand(result.Brand).
+			SetMetadata(result.Metadata).
+			SetSyncedAt(at).
+			SetCustomer(customer)
+
+		if result.ExpMonth > 0 {
+			op.SetExpMonth(result.ExpMonth)
+		}
+		if result.ExpYear > 0 {
+			op.SetExpYear(result.ExpYear)
+		}
+
+		return op.Exec(ctx)
+
+	default:
+		return err
+	}
+}
@@ -0,0 +1,284 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/gob"
+	"encoding/hex"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/sessions"
+	"github.com/labstack/echo/v4"
+	"github.com/occult/pagode/config"
+	"github.com/occult/pagode/ent"
+	entsession "github.com/occult/pagode/ent/session"
+	"github.com/occult/pagode/pkg/log"
+)
+
+// sessionLastSeenInterval is how often the last seen time of a session is updated when it is loaded, in order to
+// avoid writing to the database on every request
+const sessionLastSeenInterval = time.Minute
+
+// SessionStore is a sessions.Store which stores session data in the database rather than in the cookie. The cookie
+// only contains a random token, and only a hash of that token is stored, so sessions can be listed and revoked.
+// Expired sessions are removed from the database periodically, until the store is closed.
+type SessionStore struct {
+	orm *ent.Client
+
+	// Options stores the default options for all sessions and their cookies.
+	Options *sessions.Options
+
+	// IPExtractor extracts the IP address of the client which created a session.
+	IPExtractor echo.IPExtractor
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// NewSessionStore creates a new session store which records client IP addresses using a given IP extractor
+func NewSessionStore(cfg *config.Config, orm *ent.Client, ipExtractor echo.IPExtractor) *SessionStore {
+	s := &SessionStore{
+		orm: orm,
+		Options: &sessions.Options{
+			Path:     "/",
+			MaxAge:   int(cfg.App.Session.Expiration.Seconds()),
+			HttpOnly: true,
+			Secure:   cfg.HTTP.TLS.Enabled,
+			SameSite: http.SameSiteStrictMode,
+		},
+		IPExtractor: ipExtractor,
+		stop:        make(chan struct{}),
+		done:        make(chan struct{}),
+	}
+
+	go s.cleanupPeriodically(cfg.App.Session.CleanupInterval)
+
+	return s
+}
+
+// Get returns a session for the given name, which is cached for the duration of the request.
+func (s *SessionStore) Get(r *http.Request, name string) (*sessions.Session, error) {
+	return sessions.GetRegistry(r).Get(s, name)
+}
+
+// New loads the session for the given name from the database, or returns a new session if the request does not
+// contain a valid session cookie.
+func (s *SessionStore) New(r *http.Request, name string) (*sessions.Session, error) {
+	sess := sessions.NewSession(s, name)
+	opts := *s.Options
+	sess.Options = &opts
+	sess.IsNew = true
+
+	cookie, err := r.Cookie(name)
+	if err != nil {
+		return sess, nil
+	}
+
+	rec, err := s.orm.Session.
+		Query().
+		Where(entsession.Token(hashSessionToken(cookie.Value))).
+		Where(entsession.ExpiresAtGT(time.Now())).
+		Only(r.Context())
+
+	switch err.(type) {
+	case nil:
+	case *ent.NotFoundError:
+		return sess, nil
+	default:
+		return sess, err
+	}
+
+	// Start over with a new session if the data can no longer be decoded.
+	if err = gob.NewDecoder(bytes.NewReader(rec.Data)).Decode(&sess.Values); err != nil {
+		sess.Values = make(map[any]any)
+		return sess, nil
+	}
+	sess.ID = cookie.Value
+	sess.IsNew = false
+
+	if time.Since(rec.LastSeenAt) > sessionLastSeenInterval {
+		err = s.orm.Session.
+			UpdateOne(rec).
+			SetLastSeenAt(time.Now()).
+			Exec(r.Context())
+	}
+
+	return sess, err
+}
+
+// Save stores the session in the database and sets the session cookie.
+// If the session was revoked since it was loaded, its values are cleared, so a revoked session cannot be restored by a
+// request which was in progress.
+// A session without values is not stored, and a session with a negative MaxAge or without values is deleted.
+func (s *SessionStore) Save(r *http.Request, w http.ResponseWriter, sess *sessions.Session) error {
+	if sess.Options.MaxAge < 0 || len(sess.Values) == 0 {
+		return s.delete(r, w, sess)
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(time.Duration(sess.Options.MaxAge) * time.Second)
+
+	data, err := encodeSessionValues(sess)
+	if err != nil {
+		return err
+	}
+
+	if sess.ID != "" {
+		update := s.orm.Session.
+			Update().
+			Where(entsession.Token(hashSessionToken(sess.ID))).
+			SetData(data).
+			SetLastSeenAt(now).
+			SetExpiresAt(expiresAt)
+
+		if userID, ok := sessionUserID(sess); ok {
+			update.SetUserID(userID)
+		} else {
+			update.ClearUserID()
+		}
+
+		updated, err := update.Save(r.Context())
+		if err != nil {
+			return err
+		}
+
+		if updated > 0 {
+			http.SetCookie(w, sessions.NewCookie(sess.Name(), sess.ID, sess.Options))
+			return nil
+		}
+
+		// The session has been revoked.
+		sess.Values = make(map[any]any)
+		return s.delete(r, w, sess)
+	}
+
+	token, err := newSessionToken()
+	if err != nil {
+		return err
+	}
+
+	create := s.orm.Session.
+		Create().
+		SetToken(hashSessionToken(token)).
+		SetName(sess.Name()).
+		SetData(data).
+		SetUserAgent(r.UserAgent()).
+		SetIPAddress(s.IPExtractor(r)).
+		SetExpiresAt(expiresAt)
+
+	if userID, ok := sessionUserID(sess); ok {
+		create.SetUserID(userID)
+	}
+
+	if err = create.Exec(r.Context()); err != nil {
+		return err
+	}
+
+	sess.ID = token
+	sess.IsNew = false
+	http.SetCookie(w, sessions.NewCookie(sess.Name(), sess.ID, sess.Options))
+	return nil
+}
+
+// delete deletes a stored session and removes its cookie, if it was deleted explicitly or the request has one.
+func (s *SessionStore) delete(r *http.Request, w http.ResponseWriter, sess *sessions.Session) error {
+	if sess.ID != "" {
+		_, err := s.orm.Session.
+			Delete().
+			Where(entsession.Token(hashSessionToken(sess.ID))).
+			Exec(r.Context())
+		if err != nil {
+			return err
+		}
+	}
+
+	if _, err := r.Cookie(sess.Name()); err == nil || sess.Options.MaxAge < 0 {
+		opts := *sess.Options
+		opts.MaxAge = -1
+		http.SetCookie(w, sessions.NewCookie(sess.Name(), "", &opts))
+	}
+
+	sess.ID = ""
+	sess.IsNew = true
+	return nil
+}
+
+// Cleanup removes the expired sessions from the database.
+func (s *SessionStore) Cleanup(ctx context.Context) error {
+	_, err := s.orm.Session.
+		Delete().
+		Where(entsession.ExpiresAtLT(time.Now())).
+		Exec(ctx)
+	return err
+}
+
+// Close stops removing expired sessions periodically.
+func (s *SessionStore) Close() {
+	close(s.stop)
+	<-s.done
+}
+
+// cleanupPeriodically runs the cleanup at a given interval until the store is closed.
+func (s *SessionStore) cleanupPeriodically(interval time.Duration) {
+	defer close(s.done)
+
+	if interval <= 0 {
+		<-s.stop
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+			if err := s.Cleanup(context.Background()); err != nil {
+				log.Default().Error("unable to remove expired sessions", "error", err)
+			}
+		}
+	}
+}
+
+// sessionUserID returns the ID of the user a session belongs to, if it is an authenticated session. While an admin
+// impersonates a user, the session still belongs to the admin.
+func sessionUserID(sess *sessions.Session) (int, bool) {
+	if sess.Name() != AuthSessionName || sess.Values[authSessionKeyAuthenticated] != true {
+		return 0, false
+	}
+	if adminID, ok := sess.Values[authSessionKeyImpersonatorID].(int); ok {
+		return adminID, true
+	}
+	userID, ok := sess.Values[authSessionKeyUserID].(int)
+	return userID, ok
+}
+
+// encodeSessionValues encodes the values of a session for storage
+func encodeSessionValues(sess *sessions.Session) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(sess.Values); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// newSessionToken generates a random token to identify a session
+func newSessionToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashSessionToken hashes a session token for storage
+func hashSessionToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
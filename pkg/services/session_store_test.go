@@ -0,0 +1,191 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	entsession "github.com/occult/pagode/ent/session"
+	"github.com/occult/pagode/pkg/session"
+	"github.com/occult/pagode/pkg/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newSessionContext creates a web context using the session store with the given cookies
+func newSessionContext(cookies ...*http.Cookie) echo.Context {
+	ctx, _ := tests.NewContext(c.Web, "/")
+	for _, cookie := range cookies {
+		ctx.Request().AddCookie(cookie)
+	}
+	session.Store(ctx, c.Session)
+	return ctx
+}
+
+// sessionCookie returns the session cookie set in the response of a given context
+func sessionCookie(t *testing.T, ctx echo.Context, name string) *http.Cookie {
+	for _, cookie := range (&http.Response{Header: ctx.Response().Header()}).Cookies() {
+		if cookie.Name == name {
+			return cookie
+		}
+	}
+	t.Fatalf("cookie %s not set", name)
+	return nil
+}
+
+func TestSessionStore(t *testing.T) {
+	// Save a new session
+	ctx1 := newSessionContext()
+	sess, err := session.Get(ctx1, "test")
+	require.NoError(t, err)
+	assert.True(t, sess.IsNew)
+	sess.Values["a"] = "b"
+	require.NoError(t, sess.Save(ctx1.Request(), ctx1.Response()))
+	cookie := sessionCookie(t, ctx1, "test")
+	assert.Equal(t, sess.ID, cookie.Value)
+
+	// The cookie should only contain the token, which is stored hashed
+	exists, err := c.ORM.Session.
+		Query().
+		Where(entsession.Token(hashSessionToken(cookie.Value))).
+		Exist(context.Background())
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	// Load the session in another request
+	ctx2 := newSessionContext(cookie)
+	sess, err = session.Get(ctx2, "test")
+	require.NoError(t, err)
+	assert.False(t, sess.IsNew)
+	assert.Equal(t, "b", sess.Values["a"])
+
+	// Delete the session
+	sess.Options.MaxAge = -1
+	require.NoError(t, sess.Save(ctx2.Request(), ctx2.Response()))
+	ctx3 := newSessionContext(cookie)
+	sess, err = session.Get(ctx3, "test")
+	require.NoError(t, err)
+	assert.True(t, sess.IsNew)
+	assert.Empty(t, sess.Values)
+}
+
+func TestSessionStore_Revoked(t *testing.T) {
+	ctx1 := newSessionContext()
+	require.NoError(t, c.Auth.Login(ctx1, usr.ID))
+	cookie := sessionCookie(t, ctx1, AuthSessionName)
+
+	// The session should belong to the user
+	rec, err := c.ORM.Session.
+		Query().
+		Where(entsession.Token(hashSessionToken(cookie.Value))).
+		Only(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, rec.UserID)
+	assert.Equal(t, usr.ID, *rec.UserID)
+
+	// Load the session, then revoke it before it is saved again
+	ctx2 := newSessionContext(cookie)
+	uid, err := c.Auth.GetAuthenticatedUserID(ctx2)
+	require.NoError(t, err)
+	assert.Equal(t, usr.ID, uid)
+	require.NoError(t, c.Auth.RevokeSession(ctx2, usr.ID, rec.ID))
+
+	// Saving should not restore the revoked session
+	sess, err := session.Get(ctx2, AuthSessionName)
+	require.NoError(t, err)
+	require.NoError(t, sess.Save(ctx2.Request(), ctx2.Response()))
+	assert.NotEqual(t, cookie.Value, sess.ID)
+	_, err = c.Auth.GetAuthenticatedUserID(ctx2)
+	assert.Equal(t, NotAuthenticatedError{}, err)
+
+	ctx3 := newSessionContext(cookie)
+	_, err = c.Auth.GetAuthenticatedUserID(ctx3)
+	assert.Equal(t, NotAuthenticatedError{}, err)
+}
+
+func TestSessionStore_Fixation(t *testing.T) {
+	// Plant a session before logging in
+	ctx1 := newSessionContext()
+	require.NoError(t, c.Auth.SetPendingTwoFactorUserID(ctx1, usr.ID))
+	planted := sessionCookie(t, ctx1, AuthSessionName)
+
+	// Logging in should issue a new token
+	ctx2 := newSessionContext(planted)
+	require.NoError(t, c.Auth.Login(ctx2, usr.ID))
+	cookie := sessionCookie(t, ctx2, AuthSessionName)
+	assert.NotEqual(t, planted.Value, cookie.Value)
+
+	// The planted session should not be authenticated
+	ctx3 := newSessionContext(planted)
+	_, err := c.Auth.GetAuthenticatedUserID(ctx3)
+	assert.Equal(t, NotAuthenticatedError{}, err)
+
+	ctx4 := newSessionContext(cookie)
+	uid, err := c.Auth.GetAuthenticatedUserID(ctx4)
+	require.NoError(t, err)
+	assert.Equal(t, usr.ID, uid)
+}
+
+func TestSessionStore_Empty(t *testing.T) {
+	// Sessions without values should not be stored
+	ctx1 := newSessionContext()
+	sess, err := session.Get(ctx1, "empty")
+	require.NoError(t, err)
+	require.NoError(t, sess.Save(ctx1.Request(), ctx1.Response()))
+	assert.Empty(t, sess.ID)
+	assert.Empty(t, ctx1.Response().Header().Values("Set-Cookie"))
+
+	// Sessions which no longer hold values should be deleted, along with their cookie
+	sess.Values["a"] = "b"
+	require.NoError(t, sess.Save(ctx1.Request(), ctx1.Response()))
+	cookie := sessionCookie(t, ctx1, "empty")
+	token := hashSessionToken(cookie.Value)
+
+	ctx2 := newSessionContext(cookie)
+	sess, err = session.Get(ctx2, "empty")
+	require.NoError(t, err)
+	delete(sess.Values, "a")
+	require.NoError(t, sess.Save(ctx2.Request(), ctx2.Response()))
+	assert.Negative(t, sessionCookie(t, ctx2, "empty").MaxAge)
+
+	exists, err := c.ORM.Session.
+		Query().
+		Where(entsession.Token(token)).
+		Exist(context.Background())
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestSessionStore_Cleanup(t *testing.T) {
+	ctx := newSessionContext()
+	sess, err := session.Get(ctx, "cleanup")
+	require.NoError(t, err)
+	sess.Values["a"] = "b"
+	require.NoError(t, sess.Save(ctx.Request(), ctx.Response()))
+	token := hashSessionToken(sess.ID)
+
+	count := func() int {
+		n, err := c.ORM.Session.
+			Query().
+			Where(entsession.Token(token)).
+			Count(context.Background())
+		require.NoError(t, err)
+		return n
+	}
+
+	// Sessions which have not expired should be kept
+	require.NoError(t, c.Session.Cleanup(context.Background()))
+	assert.Equal(t, 1, count())
+
+	_, err = c.ORM.Session.
+		Update().
+		Where(entsession.Token(token)).
+		SetExpiresAt(time.Now().Add(-time.Minute)).
+		Save(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, c.Session.Cleanup(context.Background()))
+	assert.Zero(t, count())
+}
@@ -0,0 +1,302 @@
+package services
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/occult/pagode/config"
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/ent/credential"
+	"github.com/occult/pagode/pkg/session"
+	"github.com/occult/pagode/pkg/webauthn"
+)
+
+const (
+	// webAuthnSessionName stores the name of the session which contains the state of a passkey ceremony
+	webAuthnSessionName = "webauthn"
+
+	// webAuthnSessionKeyChallenge stores the key used to store the challenge of the ceremony in the session
+	webAuthnSessionKeyChallenge = "challenge"
+
+	// webAuthnSessionKeyUserID stores the key used to store the ID of the user registering a passkey in the session
+	webAuthnSessionKeyUserID = "user_id"
+
+	// webAuthnSessionKeyExpiresAt stores the key used to store when the ceremony expires in the session
+	webAuthnSessionKeyExpiresAt = "expires_at"
+)
+
+// InvalidPasskeyError is an error returned when a passkey could not be registered or used
+type InvalidPasskeyError struct {
+	Err error
+}
+
+// Error implements the error interface.
+func (e InvalidPasskeyError) Error() string {
+	return fmt.Sprintf("invalid passkey: %v", e.Err)
+}
+
+// Unwrap returns the reason the passkey is invalid.
+func (e InvalidPasskeyError) Unwrap() error {
+	return e.Err
+}
+
+// WebAuthnClient handles the WebAuthn ceremonies which allow users to register passkeys and log in with them.
+// The state of a ceremony is stored in the session between the options being provided to the browser and its
+// response being verified.
+type WebAuthnClient struct {
+	config *config.Config
+	orm    *ent.Client
+	rp     *webauthn.RelyingParty
+}
+
+// NewWebAuthnClient creates a new WebAuthnClient
+func NewWebAuthnClient(cfg *config.Config, orm *ent.Client) (*WebAuthnClient, error) {
+	host, err := url.Parse(cfg.App.Host)
+	if err != nil {
+		return nil, fmt.Errorf("invalid app host: %w", err)
+	}
+
+	rp := &webauthn.RelyingParty{
+		ID:      cfg.Auth.WebAuthn.RPID,
+		Name:    cfg.App.Name,
+		Origins: cfg.Auth.WebAuthn.Origins,
+		Timeout: cfg.Auth.WebAuthn.Timeout,
+	}
+
+	if rp.ID == "" {
+		rp.ID = host.Hostname()
+	}
+
+	if len(rp.Origins) == 0 {
+		rp.Origins = []string{host.Scheme + "://" + host.Host}
+	}
+
+	if rp.ID == "" {
+		return nil, errors.New("passkeys require a relying party ID or an app host")
+	}
+
+	return &WebAuthnClient{
+		config: cfg,
+		orm:    orm,
+		rp:     rp,
+	}, nil
+}
+
+// BeginRegistration starts registering a passkey for a given user and returns the options to provide to the
+// browser.
+func (c *WebAuthnClient) BeginRegistration(ctx echo.Context, u *ent.User) (*webauthn.CreationOptions, error) {
+	existing, err := c.GetCredentials(ctx, u.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Prevent registering an authenticator which already stores a passkey for the user.
+	exclude := make([]webauthn.CredentialDescriptor, len(existing))
+	for i, cred := range existing {
+		exclude[i] = webauthn.NewCredentialDescriptor(cred.CredentialID, cred.Transports)
+	}
+
+	challenge, err := c.beginCeremony(ctx, u.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	options := c.rp.CreationOptions(challenge, webauthn.User{
+		ID:          c.userHandle(u.ID),
+		Name:        u.Email,
+		DisplayName: u.Name,
+	}, exclude)
+
+	return &options, nil
+}
+
+// FinishRegistration verifies the response of the browser to the registration started for a given user and
+// stores the new passkey with a given name.
+func (c *WebAuthnClient) FinishRegistration(
+	ctx echo.Context,
+	u *ent.User,
+	name string,
+	resp *webauthn.RegistrationResponse,
+) (*ent.Credential, error) {
+	challenge, userID, err := c.finishCeremony(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if userID != u.ID {
+		return nil, InvalidPasskeyError{Err: errors.New("registration was started by another user")}
+	}
+
+	cred, err := c.rp.VerifyRegistration(resp, challenge)
+	if err != nil {
+		return nil, InvalidPasskeyError{Err: err}
+	}
+
+	exists, err := c.orm.Credential.
+		Query().
+		Where(credential.CredentialID(cred.ID)).
+		Exist(ctx.Request().Context())
+	switch {
+	case err != nil:
+		return nil, err
+	case exists:
+		return nil, InvalidPasskeyError{Err: errors.New("passkey already registered")}
+	}
+
+	return c.orm.Credential.
+		Create().
+		SetName(name).
+		SetCredentialID(cred.ID).
+		SetPublicKey(cred.PublicKey).
+		SetSignCount(cred.SignCount).
+		SetAaguid(cred.AAGUID).
+		SetTransports(cred.Transports).
+		SetUserID(u.ID).
+		Save(ctx.Request().Context())
+}
+
+// BeginLogin starts logging in with a passkey and returns the options to provide to the browser.
+// Any passkey the authenticator stores can be used, so the user does not have to be identified first.
+func (c *WebAuthnClient) BeginLogin(ctx echo.Context) (*webauthn.RequestOptions, error) {
+	challenge, err := c.beginCeremony(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	options := c.rp.RequestOptions(challenge)
+	return &options, nil
+}
+
+// FinishLogin verifies the response of the browser to the login which was started and returns the user the
+// passkey belongs to.
+func (c *WebAuthnClient) FinishLogin(ctx echo.Context, resp *webauthn.AssertionResponse) (*ent.User, error) {
+	challenge, _, err := c.finishCeremony(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := resp.CredentialID()
+	if err != nil {
+		return nil, InvalidPasskeyError{Err: err}
+	}
+
+	cred, err := c.orm.Credential.
+		Query().
+		Where(credential.CredentialID(id)).
+		WithUser().
+		Only(ctx.Request().Context())
+	switch {
+	case err == nil:
+	case ent.IsNotFound(err):
+		return nil, InvalidPasskeyError{Err: errors.New("unknown passkey")}
+	default:
+		return nil, err
+	}
+
+	handle, err := resp.UserHandle()
+	if err != nil || (len(handle) > 0 && !hmac.Equal(handle, c.userHandle(cred.UserID))) {
+		return nil, InvalidPasskeyError{Err: errors.New("user handle mismatch")}
+	}
+
+	signCount, err := c.rp.VerifyAssertion(resp, challenge, cred.PublicKey, cred.SignCount)
+	if err != nil {
+		return nil, InvalidPasskeyError{Err: err}
+	}
+
+	err = cred.Update().
+		SetSignCount(signCount).
+		SetLastUsedAt(time.Now()).
+		Exec(ctx.Request().Context())
+	if err != nil {
+		return nil, err
+	}
+
+	return cred.Edges.User, nil
+}
+
+// GetCredentials returns the passkeys of a given user
+func (c *WebAuthnClient) GetCredentials(ctx echo.Context, userID int) ([]*ent.Credential, error) {
+	return c.orm.Credential.
+		Query().
+		Where(credential.UserID(userID)).
+		Order(ent.Asc(credential.FieldCreatedAt)).
+		All(ctx.Request().Context())
+}
+
+// DeleteCredential deletes a passkey of a given user
+func (c *WebAuthnClient) DeleteCredential(ctx echo.Context, userID, credentialID int) error {
+	deleted, err := c.orm.Credential.
+		Delete().
+		Where(credential.ID(credentialID)).
+		Where(credential.UserID(userID)).
+		Exec(ctx.Request().Context())
+	if err == nil && deleted == 0 {
+		return &ent.NotFoundError{}
+	}
+	return err
+}
+
+// beginCeremony generates the challenge of a new ceremony for a given user, if any, and stores it in the session,
+// replacing any ceremony which was not finished.
+func (c *WebAuthnClient) beginCeremony(ctx echo.Context, userID int) ([]byte, error) {
+	challenge, err := webauthn.NewChallenge()
+	if err != nil {
+		return nil, err
+	}
+
+	sess, err := session.Get(ctx, webAuthnSessionName)
+	if err != nil {
+		return nil, err
+	}
+
+	sess.Values[webAuthnSessionKeyChallenge] = challenge
+	sess.Values[webAuthnSessionKeyUserID] = userID
+	sess.Values[webAuthnSessionKeyExpiresAt] = time.Now().Add(c.rp.Timeout).Unix()
+
+	if err = sess.Save(ctx.Request(), ctx.Response()); err != nil {
+		return nil, err
+	}
+
+	return challenge, nil
+}
+
+// finishCeremony removes the ceremony stored in the session, so its challenge can only be used once, and returns
+// its challenge along with the user it was started for.
+func (c *WebAuthnClient) finishCeremony(ctx echo.Context) ([]byte, int, error) {
+	sess, err := session.Get(ctx, webAuthnSessionName)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	challenge, ok := sess.Values[webAuthnSessionKeyChallenge].([]byte)
+	userID, _ := sess.Values[webAuthnSessionKeyUserID].(int)
+	expiresAt, _ := sess.Values[webAuthnSessionKeyExpiresAt].(int64)
+
+	delete(sess.Values, webAuthnSessionKeyChallenge)
+	delete(sess.Values, webAuthnSessionKeyUserID)
+	delete(sess.Values, webAuthnSessionKeyExpiresAt)
+
+	if err = sess.Save(ctx.Request(), ctx.Response()); err != nil {
+		return nil, 0, err
+	}
+
+	if !ok || time.Now().Unix() > expiresAt {
+		return nil, 0, InvalidPasskeyError{Err: errors.New("no ceremony in progress")}
+	}
+
+	return challenge, userID, nil
+}
+
+// userHandle returns the opaque handle which identifies a given user to authenticators. It is derived from the
+// ID of the user so that it does not need to be stored, without disclosing the ID.
+func (c *WebAuthnClient) userHandle(userID int) []byte {
+	mac := hmac.New(sha256.New, []byte(c.config.App.EncryptionKey))
+	mac.Write([]byte("webauthn:" + strconv.Itoa(userID)))
+	return mac.Sum(nil)
+}
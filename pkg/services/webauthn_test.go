@@ -0,0 +1,117 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/pkg/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebAuthnClient_Passkeys(t *testing.T) {
+	ctx, _ := tests.NewContext(c.Web, "/")
+	tests.InitSession(ctx)
+
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	authenticator, err := tests.NewAuthenticator(c.Config.App.Host)
+	require.NoError(t, err)
+
+	// Register a passkey
+	creation, err := c.WebAuthn.BeginRegistration(ctx, u)
+	require.NoError(t, err)
+	assert.Equal(t, "localhost", creation.RP.ID)
+	assert.Empty(t, creation.ExcludeCredentials)
+
+	registration, err := authenticator.Register(*creation)
+	require.NoError(t, err)
+
+	cred, err := c.WebAuthn.FinishRegistration(ctx, u, "Laptop", registration)
+	require.NoError(t, err)
+	assert.Equal(t, "Laptop", cred.Name)
+	assert.Equal(t, []string{"internal"}, cred.Transports)
+
+	// The registration cannot be finished twice
+	_, err = c.WebAuthn.FinishRegistration(ctx, u, "Laptop", registration)
+	assert.ErrorAs(t, err, &InvalidPasskeyError{})
+
+	// The registered passkey should be excluded when registering another
+	creation, err = c.WebAuthn.BeginRegistration(ctx, u)
+	require.NoError(t, err)
+	require.Len(t, creation.ExcludeCredentials, 1)
+
+	// Log in with the passkey
+	request, err := c.WebAuthn.BeginLogin(ctx)
+	require.NoError(t, err)
+	assertion, err := authenticator.Login(*request)
+	require.NoError(t, err)
+
+	got, err := c.WebAuthn.FinishLogin(ctx, assertion)
+	require.NoError(t, err)
+	assert.Equal(t, u.ID, got.ID)
+
+	creds, err := c.WebAuthn.GetCredentials(ctx, u.ID)
+	require.NoError(t, err)
+	require.Len(t, creds, 1)
+	assert.Equal(t, uint32(1), creds[0].SignCount)
+	assert.NotNil(t, creds[0].LastUsedAt)
+
+	// Replaying the assertion should fail
+	_, err = c.WebAuthn.BeginLogin(ctx)
+	require.NoError(t, err)
+	_, err = c.WebAuthn.FinishLogin(ctx, assertion)
+	assert.ErrorAs(t, err, &InvalidPasskeyError{})
+
+	// Passkeys of other users cannot be deleted
+	err = c.WebAuthn.DeleteCredential(ctx, usr.ID, cred.ID)
+	assert.True(t, ent.IsNotFound(err))
+
+	require.NoError(t, c.WebAuthn.DeleteCredential(ctx, u.ID, cred.ID))
+	request, err = c.WebAuthn.BeginLogin(ctx)
+	require.NoError(t, err)
+	assertion, err = authenticator.Login(*request)
+	require.NoError(t, err)
+	_, err = c.WebAuthn.FinishLogin(ctx, assertion)
+	assert.ErrorAs(t, err, &InvalidPasskeyError{})
+}
+
+func TestWebAuthnClient_InvalidResponse(t *testing.T) {
+	ctx, _ := tests.NewContext(c.Web, "/")
+	tests.InitSession(ctx)
+
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	// Responses from other origins should be rejected
+	authenticator, err := tests.NewAuthenticator("https://attacker.example")
+	require.NoError(t, err)
+
+	creation, err := c.WebAuthn.BeginRegistration(ctx, u)
+	require.NoError(t, err)
+	registration, err := authenticator.Register(*creation)
+	require.NoError(t, err)
+	_, err = c.WebAuthn.FinishRegistration(ctx, u, "Phone", registration)
+	assert.ErrorAs(t, err, &InvalidPasskeyError{})
+
+	// Registrations started by another user should be rejected
+	authenticator.Origin = c.Config.App.Host
+	creation, err = c.WebAuthn.BeginRegistration(ctx, usr)
+	require.NoError(t, err)
+	registration, err = authenticator.Register(*creation)
+	require.NoError(t, err)
+	_, err = c.WebAuthn.FinishRegistration(ctx, u, "Phone", registration)
+	assert.ErrorAs(t, err, &InvalidPasskeyError{})
+
+	// Responses for another challenge should be rejected
+	creation, err = c.WebAuthn.BeginRegistration(ctx, u)
+	require.NoError(t, err)
+	registration, err = authenticator.Register(*creation)
+	require.NoError(t, err)
+	_, err = c.WebAuthn.BeginRegistration(ctx, u)
+	require.NoError(t, err)
+	_, err = c.WebAuthn.FinishRegistration(ctx, u, "Phone", registration)
+	assert.ErrorAs(t, err, &InvalidPasskeyError{})
+}
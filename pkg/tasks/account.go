@@ -0,0 +1,65 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"github.com/mikestefanello/backlite"
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/pkg/services"
+)
+
+// AccountPurgeTask permanently deletes an account once the grace period after the user deleted it has passed.
+type AccountPurgeTask struct {
+	UserID int
+}
+
+// Config satisfies the backlite.Task interface by providing configuration for the queue that these items will be
+// placed into for execution.
+func (t AccountPurgeTask) Config() backlite.QueueConfig {
+	return backlite.QueueConfig{
+		Name:        "AccountPurgeTask",
+		MaxAttempts: 5,
+		Timeout:     time.Minute,
+		Backoff:     time.Hour,
+		Retention: &backlite.Retention{
+			Duration:   7 * 24 * time.Hour,
+			OnlyFailed: false,
+			Data: &backlite.RetainData{
+				OnlyFailed: true,
+			},
+		},
+	}
+}
+
+// NewAccountPurgeTaskQueue provides a Queue that can process AccountPurgeTask tasks.
+// Billing with the payment provider is closed before the account is deleted, so the user is no longer charged.
+func NewAccountPurgeTaskQueue(c *services.Container) backlite.Queue {
+	return backlite.NewQueue[AccountPurgeTask](func(ctx context.Context, task AccountPurgeTask) error {
+		u, err := c.ORM.User.Get(ctx, task.UserID)
+		switch {
+		case ent.IsNotFound(err):
+			return nil
+		case err != nil:
+			return err
+		}
+
+		// The account may have been restored, or deleted again later on, in which case another task will purge it.
+		if !c.Auth.IsAccountPurgeDue(u) {
+			return nil
+		}
+
+		customer, err := u.QueryPaymentCustomer().Only(ctx)
+		switch {
+		case ent.IsNotFound(err):
+		case err != nil:
+			return err
+		default:
+			if err = c.Payment.CloseCustomer(ctx, customer); err != nil {
+				return err
+			}
+		}
+
+		return c.Auth.PurgeAccount(ctx, u)
+	})
+}
@@ -0,0 +1,77 @@
+package tasks
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/backlite"
+	"github.com/occult/pagode/ent"
+	appctx "github.com/occult/pagode/pkg/context"
+	"github.com/occult/pagode/pkg/services"
+	"github.com/occult/pagode/pkg/ui/emails"
+)
+
+// DataExportTask exports the data stored about a user and emails them a link to download it.
+type DataExportTask struct {
+	UserID int
+}
+
+// Config satisfies the backlite.Task interface by providing configuration for the queue that these items will be
+// placed into for execution.
+func (t DataExportTask) Config() backlite.QueueConfig {
+	return backlite.QueueConfig{
+		Name:        "DataExportTask",
+		MaxAttempts: 3,
+		Timeout:     time.Minute,
+		Backoff:     time.Minute,
+		Retention: &backlite.Retention{
+			Duration:   24 * time.Hour,
+			OnlyFailed: false,
+			Data: &backlite.RetainData{
+				OnlyFailed: true,
+			},
+		},
+	}
+}
+
+// NewDataExportTaskQueue provides a Queue that can process DataExportTask tasks.
+func NewDataExportTaskQueue(c *services.Container) backlite.Queue {
+	return backlite.NewQueue[DataExportTask](func(ctx context.Context, task DataExportTask) error {
+		u, err := c.ORM.User.Get(ctx, task.UserID)
+		switch {
+		case ent.IsNotFound(err):
+			// The account has been deleted since the export was requested.
+			return nil
+		case err != nil:
+			return err
+		}
+
+		name, err := c.Export.Export(ctx, u.ID)
+		if err != nil {
+			return err
+		}
+
+		token, err := c.Export.GenerateDownloadToken(u.ID, name)
+		if err != nil {
+			return err
+		}
+
+		ectx := newEchoContext(ctx, c)
+		return c.Mail.Compose().
+			To(u.Email).
+			Subject("Your data export is ready").
+			Component(emails.DataExportReady(ectx, u.Name, token, c.Config.App.DataExport.Expiration)).
+			Send(ectx)
+	})
+}
+
+// newEchoContext returns an Echo context wrapping a given context, so tasks can use code written for requests, such
+// as the rendering of emails.
+func newEchoContext(ctx context.Context, c *services.Container) echo.Context {
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "/", nil)
+	ectx := c.Web.NewContext(req, nil)
+	ectx.Set(appctx.ConfigKey, c.Config)
+	return ectx
+}
@@ -7,4 +7,6 @@ import (
 // Register registers all task queues with the task client.
 func Register(c *services.Container) {
 	c.Tasks.Register(NewExampleTaskQueue(c))
+	c.Tasks.Register(NewDataExportTaskQueue(c))
+	c.Tasks.Register(NewAccountPurgeTaskQueue(c))
 }
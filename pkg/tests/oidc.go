@@ -0,0 +1,210 @@
+package tests
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// OIDCIdentity is the identity of a user at the stub OpenID Connect provider
+type OIDCIdentity struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+}
+
+// OIDCProvider is a stub OpenID Connect identity provider which supports discovery and the authorization code
+// flow with PKCE
+type OIDCProvider struct {
+	ClientID     string
+	ClientSecret string
+
+	server *httptest.Server
+	key    *rsa.PrivateKey
+
+	mu    sync.Mutex
+	codes map[string]oidcAuthorization
+}
+
+// oidcAuthorization is an authorization granted by the stub provider which can be exchanged for tokens
+type oidcAuthorization struct {
+	identity      OIDCIdentity
+	redirectURI   string
+	nonce         string
+	codeChallenge string
+}
+
+// NewOIDCProvider starts a new stub OpenID Connect provider, which must be closed once it is no longer used
+func NewOIDCProvider() (*OIDCProvider, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &OIDCProvider{
+		ClientID:     "client",
+		ClientSecret: "secret",
+		key:          key,
+		codes:        make(map[string]oidcAuthorization),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", p.discovery)
+	mux.HandleFunc("GET /jwks", p.jwks)
+	mux.HandleFunc("POST /token", p.token)
+	p.server = httptest.NewServer(mux)
+
+	return p, nil
+}
+
+// URL returns the issuer URL of the provider
+func (p *OIDCProvider) URL() string {
+	return p.server.URL
+}
+
+// Close shuts the provider down
+func (p *OIDCProvider) Close() {
+	p.server.Close()
+}
+
+// Authorize simulates a user logging in at the provider with a given identity after being redirected to a given
+// authorization URL, and returns the authorization code the provider redirects back with
+func (p *OIDCProvider) Authorize(authURL string, identity OIDCIdentity) (string, error) {
+	u, err := url.Parse(authURL)
+	if err != nil {
+		return "", err
+	}
+
+	q := u.Query()
+	switch {
+	case q.Get("client_id") != p.ClientID:
+		return "", errors.New("invalid client_id")
+	case q.Get("response_type") != "code":
+		return "", errors.New("invalid response_type")
+	case q.Get("code_challenge_method") != "S256" || q.Get("code_challenge") == "":
+		return "", errors.New("missing pkce code challenge")
+	}
+
+	code := rand.Text()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.codes[code] = oidcAuthorization{
+		identity:      identity,
+		redirectURI:   q.Get("redirect_uri"),
+		nonce:         q.Get("nonce"),
+		codeChallenge: q.Get("code_challenge"),
+	}
+
+	return code, nil
+}
+
+func (p *OIDCProvider) discovery(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"issuer":                                p.URL(),
+		"authorization_endpoint":                p.URL() + "/authorize",
+		"token_endpoint":                        p.URL() + "/token",
+		"jwks_uri":                              p.URL() + "/jwks",
+		"response_types_supported":              []string{"code"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+		"code_challenge_methods_supported":      []string{"S256"},
+	})
+}
+
+func (p *OIDCProvider) jwks(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": "stub",
+			"alg": "RS256",
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(p.key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(p.key.E)).Bytes()),
+		}},
+	})
+}
+
+func (p *OIDCProvider) token(w http.ResponseWriter, r *http.Request) {
+	tokenError := func(code string) {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": code})
+	}
+
+	if err := r.ParseForm(); err != nil {
+		tokenError("invalid_request")
+		return
+	}
+
+	clientID, clientSecret, ok := r.BasicAuth()
+	if !ok {
+		clientID, clientSecret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+	if clientID != p.ClientID || clientSecret != p.ClientSecret {
+		tokenError("invalid_client")
+		return
+	}
+
+	// Codes can only be used once.
+	p.mu.Lock()
+	auth, ok := p.codes[r.PostForm.Get("code")]
+	delete(p.codes, r.PostForm.Get("code"))
+	p.mu.Unlock()
+
+	challenge := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+
+	switch {
+	case !ok || r.PostForm.Get("grant_type") != "authorization_code":
+		tokenError("invalid_grant")
+		return
+	case r.PostForm.Get("redirect_uri") != auth.redirectURI:
+		tokenError("invalid_grant")
+		return
+	case base64.RawURLEncoding.EncodeToString(challenge[:]) != auth.codeChallenge:
+		tokenError("invalid_grant")
+		return
+	}
+
+	idToken := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":            p.URL(),
+		"sub":            auth.identity.Subject,
+		"aud":            p.ClientID,
+		"iat":            time.Now().Unix(),
+		"exp":            time.Now().Add(time.Hour).Unix(),
+		"nonce":          auth.nonce,
+		"email":          auth.identity.Email,
+		"email_verified": auth.identity.EmailVerified,
+		"name":           auth.identity.Name,
+	})
+	idToken.Header["kid"] = "stub"
+
+	signed, err := idToken.SignedString(p.key)
+	if err != nil {
+		tokenError("server_error")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"access_token": rand.Text(),
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+		"id_token":     signed,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
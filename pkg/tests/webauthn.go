@@ -0,0 +1,193 @@
+package tests
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"sort"
+
+	"github.com/occult/pagode/pkg/webauthn"
+)
+
+// Authenticator is a stub WebAuthn authenticator which stores a single ES256 passkey
+type Authenticator struct {
+	// Origin is the origin the browser reports ceremonies are performed from
+	Origin string
+
+	// SignCount is the signature counter, which is incremented with each assertion
+	SignCount uint32
+
+	key          *ecdsa.PrivateKey
+	credentialID []byte
+	userHandle   []byte
+}
+
+// NewAuthenticator creates a new stub authenticator which performs ceremonies from a given origin
+func NewAuthenticator(origin string) (*Authenticator, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	id := make([]byte, 16)
+	if _, err = rand.Read(id); err != nil {
+		return nil, err
+	}
+
+	return &Authenticator{
+		Origin:       origin,
+		key:          key,
+		credentialID: id,
+	}, nil
+}
+
+// Register creates the passkey using given creation options and returns the response of the browser
+func (a *Authenticator) Register(options webauthn.CreationOptions) (*webauthn.RegistrationResponse, error) {
+	var err error
+	if a.userHandle, err = base64.RawURLEncoding.DecodeString(options.User.ID); err != nil {
+		return nil, err
+	}
+
+	clientData, err := a.clientData("webauthn.create", options.Challenge)
+	if err != nil {
+		return nil, err
+	}
+
+	pub, err := a.key.PublicKey.Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey := encodeCBOR(map[int64]any{
+		1:  int64(2),
+		3:  webauthn.AlgES256,
+		-1: int64(1),
+		-2: pub[1:33],
+		-3: pub[33:],
+	})
+
+	authData := a.authData(options.RP.ID, 0x45)
+	authData = append(authData, make([]byte, 16)...)
+	authData = binary.BigEndian.AppendUint16(authData, uint16(len(a.credentialID)))
+	authData = append(authData, a.credentialID...)
+	authData = append(authData, publicKey...)
+
+	attestation := encodeCBOR(map[string]any{
+		"fmt":      "none",
+		"attStmt":  map[string]any{},
+		"authData": authData,
+	})
+
+	var resp webauthn.RegistrationResponse
+	resp.ID = base64.RawURLEncoding.EncodeToString(a.credentialID)
+	resp.RawID = resp.ID
+	resp.Type = "public-key"
+	resp.Response.ClientDataJSON = base64.RawURLEncoding.EncodeToString(clientData)
+	resp.Response.AttestationObject = base64.RawURLEncoding.EncodeToString(attestation)
+	resp.Response.Transports = []string{"internal"}
+	return &resp, nil
+}
+
+// Login authenticates with the passkey using given request options and returns the response of the browser
+func (a *Authenticator) Login(options webauthn.RequestOptions) (*webauthn.AssertionResponse, error) {
+	if a.userHandle == nil {
+		return nil, errors.New("no passkey has been registered")
+	}
+
+	clientData, err := a.clientData("webauthn.get", options.Challenge)
+	if err != nil {
+		return nil, err
+	}
+
+	a.SignCount++
+	authData := a.authData(options.RPID, 0x05)
+
+	clientDataHash := sha256.Sum256(clientData)
+	digest := sha256.Sum256(append(authData, clientDataHash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	if err != nil {
+		return nil, err
+	}
+
+	var resp webauthn.AssertionResponse
+	resp.ID = base64.RawURLEncoding.EncodeToString(a.credentialID)
+	resp.RawID = resp.ID
+	resp.Type = "public-key"
+	resp.Response.ClientDataJSON = base64.RawURLEncoding.EncodeToString(clientData)
+	resp.Response.AuthenticatorData = base64.RawURLEncoding.EncodeToString(authData)
+	resp.Response.Signature = base64.RawURLEncoding.EncodeToString(signature)
+	resp.Response.UserHandle = base64.RawURLEncoding.EncodeToString(a.userHandle)
+	return &resp, nil
+}
+
+func (a *Authenticator) clientData(ceremony, challenge string) ([]byte, error) {
+	return json.Marshal(map[string]any{
+		"type":        ceremony,
+		"challenge":   challenge,
+		"origin":      a.Origin,
+		"crossOrigin": false,
+	})
+}
+
+func (a *Authenticator) authData(rpID string, flags byte) []byte {
+	rpIDHash := sha256.Sum256([]byte(rpID))
+	data := append(rpIDHash[:], flags)
+	return binary.BigEndian.AppendUint32(data, a.SignCount)
+}
+
+// encodeCBOR encodes the subset of CBOR needed to create attestation objects
+func encodeCBOR(v any) []byte {
+	head := func(major byte, n uint64) []byte {
+		switch {
+		case n < 24:
+			return []byte{major<<5 | byte(n)}
+		case n <= 0xff:
+			return []byte{major<<5 | 24, byte(n)}
+		default:
+			return binary.BigEndian.AppendUint16([]byte{major<<5 | 25}, uint16(n))
+		}
+	}
+
+	switch v := v.(type) {
+	case int64:
+		if v < 0 {
+			return head(1, uint64(-1-v))
+		}
+		return head(0, uint64(v))
+	case []byte:
+		return append(head(2, uint64(len(v))), v...)
+	case string:
+		return append(head(3, uint64(len(v))), v...)
+	case map[int64]any:
+		keys := make([]int64, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		out := head(5, uint64(len(v)))
+		for _, k := range keys {
+			out = append(out, encodeCBOR(k)...)
+			out = append(out, encodeCBOR(v[k])...)
+		}
+		return out
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		out := head(5, uint64(len(v)))
+		for _, k := range keys {
+			out = append(out, encodeCBOR(k)...)
+			out = append(out, encodeCBOR(v[k])...)
+		}
+		return out
+	default:
+		panic("unsupported CBOR type")
+	}
+}
@@ -1,6 +1,8 @@
 package emails
 
 import (
+	"time"
+
 	"github.com/labstack/echo/v4"
 	"github.com/occult/pagode/pkg/routenames"
 	"github.com/occult/pagode/pkg/ui"
@@ -20,3 +22,91 @@ func ConfirmEmailAddress(ctx echo.Context, username, token string) Node {
 		A(Href(url), Text(url)),
 	}
 }
+
+func ConfirmEmailChange(ctx echo.Context, username, token string) Node {
+	url := ui.NewRequest(ctx).
+		Url(routenames.ConfirmEmailChange, token)
+
+	return Group{
+		Strong(Textf("Hello %s,", username)),
+		Br(),
+		P(Text("Please click on the following link to confirm your new email address:")),
+		Br(),
+		A(Href(url), Text(url)),
+		Br(),
+		P(Text("Your email address will not change until you do. If you didn’t request this change, you can ignore this email.")),
+	}
+}
+
+func EmailChangeRequested(ctx echo.Context, username, email string) Node {
+	url := ui.NewRequest(ctx).
+		Url(routenames.ForgotPassword)
+
+	return Group{
+		Strong(Textf("Hello %s,", username)),
+		Br(),
+		P(Textf("A request was made to change the email address of your account to %s. It will only change once the new address has been confirmed.", email)),
+		Br(),
+		P(Text("If this wasn’t you, we recommend resetting your password:")),
+		Br(),
+		A(Href(url), Text(url)),
+	}
+}
+
+func LoginLink(ctx echo.Context, username, token string, expiration time.Duration) Node {
+	url := ui.NewRequest(ctx).
+		Url(routenames.LoginLinkConfirm, token)
+
+	return Group{
+		Strong(Textf("Hello %s,", username)),
+		Br(),
+		P(Text("Please click on the following link to log in to your account:")),
+		Br(),
+		A(Href(url), Text(url)),
+		Br(),
+		P(Textf("This link can only be used once and expires in %d minutes. If you didn’t request it, you can ignore this email.", int(expiration.Minutes()))),
+	}
+}
+
+func AccountLocked(ctx echo.Context, username string, until time.Time) Node {
+	url := ui.NewRequest(ctx).
+		Url(routenames.ForgotPassword)
+
+	return Group{
+		Strong(Textf("Hello %s,", username)),
+		Br(),
+		P(Textf("Your account has been locked until %s after too many failed login attempts.", until.UTC().Format("2006-01-02 15:04 MST"))),
+		Br(),
+		P(Text("If this wasn’t you, we recommend resetting your password:")),
+		Br(),
+		A(Href(url), Text(url)),
+	}
+}
+
+func OrganizationInvitation(ctx echo.Context, inviter, organization, token string) Node {
+	url := ui.NewRequest(ctx).
+		Url(routenames.Invitation, token)
+
+	return Group{
+		Strong(Text("Hello,")),
+		Br(),
+		P(Textf("%s has invited you to join %s. Please click on the following link to accept the invitation:", inviter, organization)),
+		Br(),
+		A(Href(url), Text(url)),
+	}
+}
+
+func DataExportReady(ctx echo.Context, username, token string, expiration time.Duration) Node {
+	url := ui.NewRequest(ctx).
+		Url(routenames.ProfileDataExportDownload, token)
+
+	return Group{
+		Strong(Textf("Hello %s,", username)),
+		Br(),
+		P(Text("A copy of your data is ready. Please click on the following link to download it while logged in:")),
+		Br(),
+		A(Href(url), Text(url)),
+		Br(),
+		P(Textf("This link expires in %d hours. If you didn’t request your data, we recommend resetting your password.", int(expiration.Hours()))),
+	}
+}
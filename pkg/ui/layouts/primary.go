@@ -162,6 +162,9 @@ func sidebarMenu(r *ui.Request) Node {
 						Target("_blank"),
 					),
 				),
+				MenuLink(r, "Webhook events", routenames.AdminPaymentEvents),
+				MenuLink(r, "Audit log", routenames.AdminAuditLog),
+				MenuLink(r, "Cache", routenames.AdminCache),
 			),
 		}
 	}
@@ -0,0 +1,182 @@
+package pages
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/pkg/pager"
+	"github.com/occult/pagode/pkg/routenames"
+	"github.com/occult/pagode/pkg/services"
+	"github.com/occult/pagode/pkg/ui"
+	. "github.com/occult/pagode/pkg/ui/components"
+	"github.com/occult/pagode/pkg/ui/layouts"
+	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/components"
+	. "maragu.dev/gomponents/html"
+)
+
+func AdminAuditLog(
+	ctx echo.Context,
+	events []*ent.AuditEvent,
+	filter services.AuditFilter,
+	entityTypes []string,
+	pgr pager.Pager,
+) error {
+	r := ui.NewRequest(ctx)
+	r.Title = "Audit log"
+
+	optionalID := func(id *int) string {
+		if id == nil {
+			return ""
+		}
+		return fmt.Sprint(*id)
+	}
+
+	filterID := func(id int) string {
+		if id == 0 {
+			return ""
+		}
+		return fmt.Sprint(id)
+	}
+
+	changes := func(e *ent.AuditEvent) Node {
+		if len(e.Changes) == 0 {
+			return nil
+		}
+		b, err := json.Marshal(e.Changes)
+		if err != nil {
+			return nil
+		}
+		return Code(Text(string(b)))
+	}
+
+	actions := []Choice{
+		{Value: "", Label: "All"},
+		{Value: services.AuditActionCreate, Label: services.AuditActionCreate},
+		{Value: services.AuditActionUpdate, Label: services.AuditActionUpdate},
+		{Value: services.AuditActionDelete, Label: services.AuditActionDelete},
+		{Value: services.AuditActionImpersonationStart, Label: services.AuditActionImpersonationStart},
+		{Value: services.AuditActionImpersonationStop, Label: services.AuditActionImpersonationStop},
+	}
+
+	types := []Choice{{Value: "", Label: "All"}}
+	for _, t := range entityTypes {
+		types = append(types, Choice{Value: t, Label: t})
+	}
+
+	rows := make(Group, 0, len(events))
+	for _, e := range events {
+		rows = append(rows, Tr(
+			Th(Text(fmt.Sprint(e.ID))),
+			Td(Text(e.CreatedAt.Format(time.DateTime))),
+			Td(Text(e.Action)),
+			Td(Text(e.EntityType)),
+			Td(Text(optionalID(e.EntityID))),
+			Td(Text(optionalID(e.ActorID))),
+			Td(Text(optionalID(e.ImpersonatorID))),
+			Td(Text(e.IPAddress)),
+			Td(Code(Text(e.RequestID))),
+			Td(changes(e)),
+		))
+	}
+
+	pagedHref := func(page int) string {
+		q := url.Values{}
+		if filter.Action != "" {
+			q.Set("action", filter.Action)
+		}
+		if filter.EntityType != "" {
+			q.Set("entity_type", filter.EntityType)
+		}
+		if filter.EntityID != 0 {
+			q.Set("entity_id", strconv.Itoa(filter.EntityID))
+		}
+		if filter.ActorID != 0 {
+			q.Set("actor_id", strconv.Itoa(filter.ActorID))
+		}
+		q.Set(pager.QueryKey, strconv.Itoa(page))
+		return fmt.Sprintf("%s?%s", r.Path(routenames.AdminAuditLog), q.Encode())
+	}
+
+	return r.Render(layouts.Primary, Group{
+		Form(
+			Method(http.MethodGet),
+			Action(r.Path(routenames.AdminAuditLog)),
+			Div(
+				Class("columns"),
+				Div(Class("column"), SelectList(OptionsParams{
+					Name:    "action",
+					Label:   "Action",
+					Value:   filter.Action,
+					Options: actions,
+				})),
+				Div(Class("column"), SelectList(OptionsParams{
+					Name:    "entity_type",
+					Label:   "Entity type",
+					Value:   filter.EntityType,
+					Options: types,
+				})),
+				Div(Class("column"), InputField(InputFieldParams{
+					Name:      "entity_id",
+					InputType: "number",
+					Label:     "Entity ID",
+					Value:     filterID(filter.EntityID),
+				})),
+				Div(Class("column"), InputField(InputFieldParams{
+					Name:      "actor_id",
+					InputType: "number",
+					Label:     "Actor ID",
+					Value:     filterID(filter.ActorID),
+					Help:      "Also matches events of impersonated users.",
+				})),
+			),
+			ControlGroup(
+				FormButton("is-primary", "Filter"),
+				ButtonLink(r.Path(routenames.AdminAuditLog), "is-light", "Clear"),
+			),
+		),
+		Table(
+			Class("table is-fullwidth"),
+			THead(
+				Tr(
+					Th(Text("ID")),
+					Th(Text("Time")),
+					Th(Text("Action")),
+					Th(Text("Entity type")),
+					Th(Text("Entity ID")),
+					Th(Text("Actor ID")),
+					Th(Text("Impersonator ID")),
+					Th(Text("IP address")),
+					Th(Text("Request ID")),
+					Th(Text("Changes")),
+				),
+			),
+			TBody(rows),
+		),
+		Nav(
+			Class("pagination"),
+			A(
+				Classes{
+					"pagination-previous": true,
+					"is-disabled":         pgr.IsBeginning(),
+				},
+				If(!pgr.IsBeginning(), Href(pagedHref(pgr.Page-1))),
+				Text("Previous page"),
+			),
+			A(
+				Classes{
+					"pagination-previous": true,
+					"is-disabled":         pgr.IsEnd(),
+				},
+				If(!pgr.IsEnd(), Href(pagedHref(pgr.Page+1))),
+				Text("Next page"),
+			),
+		),
+	})
+}
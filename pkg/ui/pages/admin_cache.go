@@ -0,0 +1,71 @@
+package pages
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/occult/pagode/pkg/routenames"
+	"github.com/occult/pagode/pkg/services"
+	"github.com/occult/pagode/pkg/ui"
+	. "github.com/occult/pagode/pkg/ui/components"
+	"github.com/occult/pagode/pkg/ui/layouts"
+	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
+)
+
+func AdminCache(ctx echo.Context, stats services.CacheStats) error {
+	r := ui.NewRequest(ctx)
+	r.Title = "Cache"
+
+	hitRatio := "-"
+	if fetches := stats.Hits + stats.Misses; fetches > 0 {
+		hitRatio = fmt.Sprintf("%.1f%%", float64(stats.Hits)/float64(fetches)*100)
+	}
+
+	capacity := "Unbounded"
+	if stats.Capacity > 0 {
+		capacity = fmt.Sprint(stats.Capacity)
+	}
+
+	row := func(label, value string) Node {
+		return Tr(
+			Th(Text(label)),
+			Td(Text(value)),
+		)
+	}
+
+	rows := Group{
+		row("Hits", fmt.Sprint(stats.Hits)),
+		row("Misses", fmt.Sprint(stats.Misses)),
+		row("Hit ratio", hitRatio),
+	}
+	if stats.StoreStats {
+		rows = append(rows,
+			row("Entries", fmt.Sprint(stats.Entries)),
+			row("Capacity", capacity),
+			row("Evictions", fmt.Sprint(stats.Evictions)),
+			row("Expirations", fmt.Sprint(stats.Expirations)),
+			row("Tags", fmt.Sprint(stats.Tags)),
+			row("Tagged entries", fmt.Sprint(stats.TaggedEntries)),
+		)
+	}
+
+	return r.Render(layouts.Primary, Group{
+		Form(
+			Method(http.MethodPost),
+			Action(r.Path(routenames.AdminCacheFlushPages)),
+			FormButton("is-primary", "Flush cached pages"),
+			CSRF(r),
+		),
+		Table(
+			Class("table is-fullwidth"),
+			TBody(rows),
+		),
+		P(
+			Class("help"),
+			Text("Hits and misses are counted since the app started."),
+			If(!stats.StoreStats, Text(" The cache store does not report statistics about its entries.")),
+		),
+	})
+}
@@ -0,0 +1,114 @@
+package pages
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/pkg/pager"
+	"github.com/occult/pagode/pkg/routenames"
+	"github.com/occult/pagode/pkg/ui"
+	. "github.com/occult/pagode/pkg/ui/components"
+	"github.com/occult/pagode/pkg/ui/layouts"
+	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/components"
+	. "maragu.dev/gomponents/html"
+)
+
+func AdminPaymentEvents(ctx echo.Context, events []*ent.PaymentEvent, pgr pager.Pager) error {
+	r := ui.NewRequest(ctx)
+	r.Title = "Webhook events"
+
+	status := func(e *ent.PaymentEvent) Node {
+		switch {
+		case !e.ProcessedAt.IsZero():
+			return Span(Class("tag is-success"), Text("Processed"))
+		case e.Error != "":
+			return Span(Class("tag is-danger"), Text("Failed"))
+		default:
+			return Span(Class("tag is-warning"), Text("Pending"))
+		}
+	}
+
+	replay := func(e *ent.PaymentEvent) Node {
+		if !e.ProcessedAt.IsZero() {
+			return nil
+		}
+		return Form(
+			Method(http.MethodPost),
+			Action(r.Path(routenames.AdminPaymentEventReplay, e.ID)),
+			FormButton("is-link is-small", "Replay"),
+			CSRF(r),
+		)
+	}
+
+	rows := make(Group, 0, len(events))
+	for _, e := range events {
+		rows = append(rows, Tr(
+			Th(Text(fmt.Sprint(e.ID))),
+			Td(Text(e.Provider)),
+			Td(Code(Text(e.EventID))),
+			Td(Text(e.Type)),
+			Td(status(e)),
+			Td(Text(fmt.Sprint(e.Attempts))),
+			Td(Text(e.CreatedAt.Format(time.DateTime))),
+			Td(Text(e.Error)),
+			Td(replay(e)),
+		))
+	}
+
+	pagedHref := func(page int) string {
+		return fmt.Sprintf("%s?%s=%d",
+			r.Path(routenames.AdminPaymentEvents),
+			pager.QueryKey,
+			page,
+		)
+	}
+
+	return r.Render(layouts.Primary, Group{
+		Form(
+			Method(http.MethodPost),
+			Action(r.Path(routenames.AdminPaymentEventReplayFailed)),
+			FormButton("is-primary", "Replay all failed events"),
+			CSRF(r),
+		),
+		Table(
+			Class("table is-fullwidth"),
+			THead(
+				Tr(
+					Th(Text("ID")),
+					Th(Text("Provider")),
+					Th(Text("Event ID")),
+					Th(Text("Type")),
+					Th(Text("Status")),
+					Th(Text("Attempts")),
+					Th(Text("Received")),
+					Th(Text("Error")),
+					Th(),
+				),
+			),
+			TBody(rows),
+		),
+		Nav(
+			Class("pagination"),
+			A(
+				Classes{
+					"pagination-previous": true,
+					"is-disabled":         pgr.IsBeginning(),
+				},
+				If(!pgr.IsBeginning(), Href(pagedHref(pgr.Page-1))),
+				Text("Previous page"),
+			),
+			A(
+				Classes{
+					"pagination-previous": true,
+					"is-disabled":         pgr.IsEnd(),
+				},
+				If(!pgr.IsEnd(), Href(pagedHref(pgr.Page+1))),
+				Text("Next page"),
+			),
+		),
+	})
+}
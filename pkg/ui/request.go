@@ -4,6 +4,7 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/occult/pagode/config"
 	"github.com/occult/pagode/ent"
+	"github.com/occult/pagode/pkg/authz"
 	"github.com/occult/pagode/pkg/context"
 	"github.com/occult/pagode/pkg/htmx"
 	"maragu.dev/gomponents"
@@ -28,7 +29,7 @@ type (
 		// IsAuth stores whether the user is authenticated.
 		IsAuth bool
 
-		// IsAdmin stores whether the user is an admin.
+		// IsAdmin stores whether the user can manage entities in the admin panel.
 		IsAdmin bool
 
 		// AuthUser stores the authenticated user.
@@ -83,7 +84,7 @@ func NewRequest(ctx echo.Context) *Request {
 		if user, ok := u.(*ent.User); ok {
 			p.IsAuth = true
 			p.AuthUser = user
-			p.IsAdmin = p.AuthUser.Admin
+			p.IsAdmin = authz.Has(p.AuthUser, authz.EntitiesManage)
 		}
 	}
 
@@ -0,0 +1,63 @@
+package webauthn
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeCBOR(t *testing.T) {
+	tests := map[string]struct {
+		data     []byte
+		expected any
+	}{
+		"uint":        {[]byte{0x18, 0x64}, int64(100)},
+		"negint":      {[]byte{0x38, 0x63}, int64(-100)},
+		"bytes":       {[]byte{0x42, 0x01, 0x02}, []byte{0x01, 0x02}},
+		"text":        {[]byte{0x63, 'f', 'm', 't'}, "fmt"},
+		"array":       {[]byte{0x82, 0x01, 0x20}, []any{int64(1), int64(-1)}},
+		"map":         {[]byte{0xa2, 0x01, 0x02, 0x61, 'a', 0xf5}, map[any]any{int64(1): int64(2), "a": true}},
+		"tag":         {[]byte{0xc1, 0x01}, int64(1)},
+		"null":        {[]byte{0xf6}, nil},
+		"uint16":      {[]byte{0x19, 0x01, 0x00}, int64(256)},
+		"nested maps": {[]byte{0xa1, 0x01, 0xa0}, map[any]any{int64(1): map[any]any{}}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, rest, err := decodeCBOR(append(test.data, 0xff))
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, got)
+			assert.Equal(t, []byte{0xff}, rest)
+		})
+	}
+}
+
+func TestDecodeCBOR_Invalid(t *testing.T) {
+	tests := map[string][]byte{
+		"empty":              {},
+		"truncated bytes":    {0x45, 0x01},
+		"truncated argument": {0x19, 0x01},
+		"truncated array":    {0x83, 0x01},
+		"indefinite":         {0x5f},
+		"bytes map key":      {0xa1, 0x41, 0x01, 0x01},
+		"duplicate map key":  {0xa2, 0x01, 0x01, 0x01, 0x02},
+		"huge array":         {0x9b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	}
+
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, _, err := decodeCBOR(data)
+			assert.Error(t, err)
+		})
+	}
+
+	// Deeply nested arrays should be rejected
+	nested := make([]byte, maxCBORDepth+2)
+	for i := range nested {
+		nested[i] = 0x81
+	}
+	_, _, err := decodeCBOR(nested)
+	assert.Error(t, err)
+}
@@ -0,0 +1,444 @@
+package webauthn
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Authenticator data flags.
+const (
+	flagUserPresent        byte = 0x01
+	flagUserVerified       byte = 0x04
+	flagAttestedCredential byte = 0x40
+)
+
+const (
+	// challengeLength stores the amount of random bytes in a challenge
+	challengeLength = 32
+
+	// maxCredentialIDLength stores the maximum length of a credential ID, as defined by the specification
+	maxCredentialIDLength = 1023
+
+	// publicKeyCredentialType is the only type of credential
+	publicKeyCredentialType = "public-key"
+)
+
+// RelyingParty verifies the WebAuthn ceremonies used to register and authenticate with passkeys.
+// User verification, such as a PIN or biometrics, is always required, so passkeys can be used without a password
+// or second factor. Attestation is not requested, so authenticators are not verified to be genuine.
+type RelyingParty struct {
+	// ID is the domain passkeys are scoped to.
+	ID string
+
+	// Name is the name shown to users when creating a passkey.
+	Name string
+
+	// Origins are the origins ceremonies may be performed from.
+	Origins []string
+
+	// Timeout is how long users have to complete a ceremony.
+	Timeout time.Duration
+}
+
+// User is the user a passkey is created for.
+type User struct {
+	// ID is the opaque user handle stored by the authenticator, which should not contain personal information.
+	ID          []byte
+	Name        string
+	DisplayName string
+}
+
+// CredentialDescriptor identifies a credential in ceremony options.
+type CredentialDescriptor struct {
+	Type       string   `json:"type"`
+	ID         string   `json:"id"`
+	Transports []string `json:"transports,omitempty"`
+}
+
+// NewCredentialDescriptor creates a descriptor of a credential with a given ID and transports.
+func NewCredentialDescriptor(id []byte, transports []string) CredentialDescriptor {
+	return CredentialDescriptor{
+		Type:       publicKeyCredentialType,
+		ID:         encode(id),
+		Transports: transports,
+	}
+}
+
+// CredentialParameter is a type of credential which may be created, identified by its COSE algorithm.
+type CredentialParameter struct {
+	Type string `json:"type"`
+	Alg  int64  `json:"alg"`
+}
+
+// CreationOptions are the options used by the browser to create a passkey, in the JSON format accepted by
+// PublicKeyCredential.parseCreationOptionsFromJSON().
+type CreationOptions struct {
+	RP struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"rp"`
+	User struct {
+		ID          string `json:"id"`
+		Name        string `json:"name"`
+		DisplayName string `json:"displayName"`
+	} `json:"user"`
+	Challenge              string                 `json:"challenge"`
+	PubKeyCredParams       []CredentialParameter  `json:"pubKeyCredParams"`
+	Timeout                int64                  `json:"timeout,omitempty"`
+	ExcludeCredentials     []CredentialDescriptor `json:"excludeCredentials"`
+	AuthenticatorSelection struct {
+		ResidentKey        string `json:"residentKey"`
+		RequireResidentKey bool   `json:"requireResidentKey"`
+		UserVerification   string `json:"userVerification"`
+	} `json:"authenticatorSelection"`
+	Attestation string `json:"attestation"`
+}
+
+// RequestOptions are the options used by the browser to authenticate with a passkey, in the JSON format accepted
+// by PublicKeyCredential.parseRequestOptionsFromJSON().
+type RequestOptions struct {
+	Challenge        string                 `json:"challenge"`
+	Timeout          int64                  `json:"timeout,omitempty"`
+	RPID             string                 `json:"rpId"`
+	AllowCredentials []CredentialDescriptor `json:"allowCredentials"`
+	UserVerification string                 `json:"userVerification"`
+}
+
+// RegistrationResponse is the response of the browser once a passkey has been created, in the JSON format
+// returned by PublicKeyCredential.toJSON().
+type RegistrationResponse struct {
+	ID       string `json:"id"`
+	RawID    string `json:"rawId"`
+	Type     string `json:"type"`
+	Response struct {
+		ClientDataJSON    string   `json:"clientDataJSON"`
+		AttestationObject string   `json:"attestationObject"`
+		Transports        []string `json:"transports"`
+	} `json:"response"`
+}
+
+// AssertionResponse is the response of the browser once a user has authenticated with a passkey, in the JSON
+// format returned by PublicKeyCredential.toJSON().
+type AssertionResponse struct {
+	ID       string `json:"id"`
+	RawID    string `json:"rawId"`
+	Type     string `json:"type"`
+	Response struct {
+		ClientDataJSON    string `json:"clientDataJSON"`
+		AuthenticatorData string `json:"authenticatorData"`
+		Signature         string `json:"signature"`
+		UserHandle        string `json:"userHandle"`
+	} `json:"response"`
+}
+
+// CredentialID returns the ID of the credential which was used to authenticate.
+func (r *AssertionResponse) CredentialID() ([]byte, error) {
+	return decode(r.RawID)
+}
+
+// UserHandle returns the user handle stored by the authenticator, if provided.
+func (r *AssertionResponse) UserHandle() ([]byte, error) {
+	return decode(r.Response.UserHandle)
+}
+
+// Credential is a newly registered passkey.
+type Credential struct {
+	ID []byte
+
+	// PublicKey is the COSE-encoded public key of the credential.
+	PublicKey []byte
+
+	SignCount  uint32
+	AAGUID     []byte
+	Transports []string
+}
+
+// clientData is the data the browser signs along with the authenticator data.
+type clientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+	Origin    string `json:"origin"`
+}
+
+// authenticatorData is the data provided by the authenticator.
+type authenticatorData struct {
+	rpIDHash     []byte
+	flags        byte
+	signCount    uint32
+	aaguid       []byte
+	credentialID []byte
+	publicKey    []byte
+}
+
+// NewChallenge generates a random challenge for a ceremony.
+func NewChallenge() ([]byte, error) {
+	challenge := make([]byte, challengeLength)
+	if _, err := rand.Read(challenge); err != nil {
+		return nil, err
+	}
+	return challenge, nil
+}
+
+// CreationOptions returns the options used to create a passkey for a given user, excluding the credentials they
+// have already registered.
+func (rp *RelyingParty) CreationOptions(challenge []byte, u User, exclude []CredentialDescriptor) CreationOptions {
+	var o CreationOptions
+	o.RP.ID = rp.ID
+	o.RP.Name = rp.Name
+	o.User.ID = encode(u.ID)
+	o.User.Name = u.Name
+	o.User.DisplayName = u.DisplayName
+	o.Challenge = encode(challenge)
+	o.Timeout = rp.Timeout.Milliseconds()
+	o.ExcludeCredentials = exclude
+	if o.ExcludeCredentials == nil {
+		o.ExcludeCredentials = []CredentialDescriptor{}
+	}
+	o.AuthenticatorSelection.ResidentKey = "required"
+	o.AuthenticatorSelection.RequireResidentKey = true
+	o.AuthenticatorSelection.UserVerification = "required"
+	o.Attestation = "none"
+
+	for _, alg := range []int64{AlgES256, AlgEdDSA, AlgRS256} {
+		o.PubKeyCredParams = append(o.PubKeyCredParams, CredentialParameter{
+			Type: publicKeyCredentialType,
+			Alg:  alg,
+		})
+	}
+
+	return o
+}
+
+// RequestOptions returns the options used to authenticate with any passkey stored by the authenticator, so the
+// user does not have to be identified first.
+func (rp *RelyingParty) RequestOptions(challenge []byte) RequestOptions {
+	return RequestOptions{
+		Challenge:        encode(challenge),
+		Timeout:          rp.Timeout.Milliseconds(),
+		RPID:             rp.ID,
+		AllowCredentials: []CredentialDescriptor{},
+		UserVerification: "required",
+	}
+}
+
+// VerifyRegistration verifies the response of the browser to a registration ceremony started with a given
+// challenge and returns the new credential.
+func (rp *RelyingParty) VerifyRegistration(resp *RegistrationResponse, challenge []byte) (*Credential, error) {
+	if resp.Type != publicKeyCredentialType {
+		return nil, errors.New("invalid credential type")
+	}
+
+	if _, err := rp.verifyClientData(resp.Response.ClientDataJSON, "webauthn.create", challenge); err != nil {
+		return nil, err
+	}
+
+	raw, err := decode(resp.Response.AttestationObject)
+	if err != nil {
+		return nil, fmt.Errorf("invalid attestation object: %w", err)
+	}
+
+	item, _, err := decodeCBOR(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid attestation object: %w", err)
+	}
+	attestation, ok := item.(map[any]any)
+	if !ok {
+		return nil, errors.New("invalid attestation object")
+	}
+
+	// The attestation statement is not verified, as attestation is not requested.
+	authData, ok := attestation["authData"].([]byte)
+	if !ok {
+		return nil, errors.New("missing authenticator data")
+	}
+
+	data, err := rp.verifyAuthenticatorData(authData)
+	if err != nil {
+		return nil, err
+	}
+
+	if data.flags&flagAttestedCredential == 0 {
+		return nil, errors.New("missing attested credential data")
+	}
+
+	rawID, err := decode(resp.RawID)
+	if err != nil || !bytes.Equal(rawID, data.credentialID) {
+		return nil, errors.New("credential ID mismatch")
+	}
+
+	if _, err = parsePublicKey(data.publicKey); err != nil {
+		return nil, fmt.Errorf("invalid public key: %w", err)
+	}
+
+	return &Credential{
+		ID:         data.credentialID,
+		PublicKey:  data.publicKey,
+		SignCount:  data.signCount,
+		AAGUID:     data.aaguid,
+		Transports: resp.Response.Transports,
+	}, nil
+}
+
+// VerifyAssertion verifies the response of the browser to an authentication ceremony started with a given
+// challenge, using the COSE-encoded public key and the last signature counter stored for the credential, and
+// returns the new signature counter.
+func (rp *RelyingParty) VerifyAssertion(
+	resp *AssertionResponse,
+	challenge []byte,
+	credentialPublicKey []byte,
+	signCount uint32,
+) (uint32, error) {
+	if resp.Type != publicKeyCredentialType {
+		return 0, errors.New("invalid credential type")
+	}
+
+	clientDataJSON, err := rp.verifyClientData(resp.Response.ClientDataJSON, "webauthn.get", challenge)
+	if err != nil {
+		return 0, err
+	}
+
+	authData, err := decode(resp.Response.AuthenticatorData)
+	if err != nil {
+		return 0, fmt.Errorf("invalid authenticator data: %w", err)
+	}
+
+	data, err := rp.verifyAuthenticatorData(authData)
+	if err != nil {
+		return 0, err
+	}
+
+	signature, err := decode(resp.Response.Signature)
+	if err != nil {
+		return 0, fmt.Errorf("invalid signature: %w", err)
+	}
+
+	key, err := parsePublicKey(credentialPublicKey)
+	if err != nil {
+		return 0, fmt.Errorf("invalid public key: %w", err)
+	}
+
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	if err = key.verify(slices.Concat(authData, clientDataHash[:]), signature); err != nil {
+		return 0, err
+	}
+
+	// Authenticators which support signature counters increment them with each use, so a counter which did not
+	// increase indicates the credential may have been cloned.
+	if (data.signCount != 0 || signCount != 0) && data.signCount <= signCount {
+		return 0, errors.New("signature counter did not increase")
+	}
+
+	return data.signCount, nil
+}
+
+// verifyClientData verifies the encoded client data of a ceremony of a given type and returns it decoded.
+func (rp *RelyingParty) verifyClientData(encoded, ceremony string, challenge []byte) ([]byte, error) {
+	raw, err := decode(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("invalid client data: %w", err)
+	}
+
+	var cd clientData
+	if err = json.Unmarshal(raw, &cd); err != nil {
+		return nil, fmt.Errorf("invalid client data: %w", err)
+	}
+
+	if cd.Type != ceremony {
+		return nil, fmt.Errorf("unexpected ceremony type %q", cd.Type)
+	}
+
+	got, err := decode(cd.Challenge)
+	if err != nil || subtle.ConstantTimeCompare(got, challenge) != 1 {
+		return nil, errors.New("challenge mismatch")
+	}
+
+	if !slices.Contains(rp.Origins, cd.Origin) {
+		return nil, fmt.Errorf("unexpected origin %q", cd.Origin)
+	}
+
+	return raw, nil
+}
+
+// verifyAuthenticatorData parses authenticator data and verifies it was scoped to the relying party and that
+// the user was both present and verified.
+func (rp *RelyingParty) verifyAuthenticatorData(raw []byte) (*authenticatorData, error) {
+	data, err := parseAuthenticatorData(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid authenticator data: %w", err)
+	}
+
+	rpIDHash := sha256.Sum256([]byte(rp.ID))
+	if !bytes.Equal(data.rpIDHash, rpIDHash[:]) {
+		return nil, errors.New("relying party ID mismatch")
+	}
+
+	if data.flags&flagUserPresent == 0 {
+		return nil, errors.New("user not present")
+	}
+
+	if data.flags&flagUserVerified == 0 {
+		return nil, errors.New("user not verified")
+	}
+
+	return data, nil
+}
+
+// parseAuthenticatorData parses authenticator data, including the attested credential data, if present.
+func parseAuthenticatorData(raw []byte) (*authenticatorData, error) {
+	if len(raw) < 37 {
+		return nil, errors.New("too short")
+	}
+
+	data := &authenticatorData{
+		rpIDHash:  raw[:32],
+		flags:     raw[32],
+		signCount: binary.BigEndian.Uint32(raw[33:37]),
+	}
+
+	if data.flags&flagAttestedCredential == 0 {
+		return data, nil
+	}
+
+	rest := raw[37:]
+	if len(rest) < 18 {
+		return nil, errors.New("attested credential data too short")
+	}
+
+	data.aaguid = rest[:16]
+	length := int(binary.BigEndian.Uint16(rest[16:18]))
+	rest = rest[18:]
+	if length > maxCredentialIDLength || length > len(rest) {
+		return nil, errors.New("invalid credential ID length")
+	}
+	data.credentialID = rest[:length]
+	rest = rest[length:]
+
+	// The public key is followed by extensions, if any, so it is decoded to find where it ends.
+	_, after, err := decodeCBOR(rest)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key: %w", err)
+	}
+	data.publicKey = rest[:len(rest)-len(after)]
+
+	return data, nil
+}
+
+// encode encodes binary data as used in the JSON format of the WebAuthn API.
+func encode(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decode decodes binary data encoded as used in the JSON format of the WebAuthn API.
+func decode(data string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(data, "="))
+}
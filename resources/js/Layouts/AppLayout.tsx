@@ -5,6 +5,7 @@ import { type ReactNode } from "react";
 import { useFlashToasts } from "@/hooks/useFlashToast";
 import { SharedProps } from "@/types/global";
 import { Toaster } from "sonner";
+import ImpersonationBanner from "@/components/ImpersonationBanner";
 
 interface AppLayoutProps {
   children: ReactNode;
@@ -23,6 +24,7 @@ export default function AppLayout({
   return (
     <AppLayoutTemplate breadcrumbs={breadcrumbs} {...props}>
       <Toaster richColors position="top-center" />
+      <ImpersonationBanner />
       <div className="flex flex-col h-full">
         {children}
       </div>
@@ -18,6 +18,31 @@ const sidebarNavItems: NavItem[] = [
     href: "/profile/password",
     icon: null,
   },
+  {
+    title: "Two-factor",
+    href: "/profile/two-factor",
+    icon: null,
+  },
+  {
+    title: "Passkeys",
+    href: "/profile/passkeys",
+    icon: null,
+  },
+  {
+    title: "Sessions",
+    href: "/profile/sessions",
+    icon: null,
+  },
+  {
+    title: "Access tokens",
+    href: "/profile/tokens",
+    icon: null,
+  },
+  {
+    title: "Organizations",
+    href: "/organizations",
+    icon: null,
+  },
   {
     title: "Appearance",
     href: "/profile/appearance",
@@ -1,6 +1,7 @@
-import { Head, router, useForm } from "@inertiajs/react";
+import { Head, router, useForm, usePage } from "@inertiajs/react";
 import AppLayout from "@/Layouts/AppLayout";
 import { BreadcrumbItem, User } from "@/types";
+import { SharedProps } from "@/types/global";
 import { Button } from "@/components/ui/button";
 import { Card, CardContent, CardHeader, CardTitle } from "@/components/ui/card";
 import {
@@ -13,9 +14,11 @@ import {
 } from "@/components/ui/table";
 import { useState } from "react";
 import { UserFormModal } from "@/components/Admin/UserFormModal";
+import { UserRolesModal, type Role } from "@/components/Admin/UserRolesModal";
 
 type Props = {
   users: User[];
+  roles: Role[];
   pagination: {
     total: number;
     page: number;
@@ -27,7 +30,6 @@ type Props = {
 type UserFormData = {
   name: string;
   email: string;
-  admin: boolean;
   emailVerified: boolean;
 };
 
@@ -38,15 +40,16 @@ const breadcrumbs: BreadcrumbItem[] = [
   },
 ];
 
-export default function AdminView({ users, pagination }: Props) {
+export default function AdminView({ users, roles, pagination }: Props) {
+  const { auth } = usePage<SharedProps>().props;
   const [showModal, setShowModal] = useState(false);
   const [editingUser, setEditingUser] = useState<User | null>(null);
+  const [rolesUser, setRolesUser] = useState<User | null>(null);
 
   const { data, setData, post, processing, errors, reset } =
     useForm<UserFormData>({
       name: "",
       email: "",
-      admin: false,
       emailVerified: false,
     });
 
@@ -55,7 +58,6 @@ export default function AdminView({ users, pagination }: Props) {
     setData({
       name: "",
       email: "",
-      admin: false,
       emailVerified: false,
     });
     setShowModal(true);
@@ -66,7 +68,6 @@ export default function AdminView({ users, pagination }: Props) {
     setData({
       name: user.name ?? "",
       email: user.email ?? "",
-      admin: !!user.admin,
       emailVerified: !!user.emailVerified,
     });
     setShowModal(true);
@@ -109,6 +110,33 @@ export default function AdminView({ users, pagination }: Props) {
     );
   };
 
+  const handleUnlockUser = (userId: number) => {
+    router.post(
+      `/admin/users/${userId}/unlock`,
+      {},
+      {
+        preserveScroll: true,
+        preserveState: true,
+      },
+    );
+  };
+
+  const handleImpersonateUser = (userId: number) => {
+    router.post(`/admin/users/${userId}/impersonate`);
+  };
+
+  const canImpersonate = (user: User) =>
+    !!auth.permissions?.includes("users.impersonate") &&
+    user.id !== auth.user?.id &&
+    !userRoles(user).some((role) => role.name === "admin");
+
+  const userRoles = (user: User) =>
+    ((user.edges as { roles?: Role[] } | undefined)?.roles ?? []);
+
+  const isLocked = (user: User) =>
+    !!user.locked_until &&
+    new Date(user.locked_until as string).getTime() > Date.now();
+
   return (
     <AppLayout breadcrumbs={breadcrumbs}>
       <Head title="User" />
@@ -132,8 +160,11 @@ export default function AdminView({ users, pagination }: Props) {
                           <TableHead>Name</TableHead>
                           <TableHead>Email</TableHead>
                           <TableHead>Verified</TableHead>
-                          <TableHead>Admin</TableHead>
+                          <TableHead>Roles</TableHead>
                           <TableHead>Created at</TableHead>
+                          <TableHead>Locked until</TableHead>
+                          <TableHead></TableHead>
+                          <TableHead></TableHead>
                           <TableHead></TableHead>
                           <TableHead></TableHead>
                         </TableRow>
@@ -148,7 +179,9 @@ export default function AdminView({ users, pagination }: Props) {
                               {user.verified ? "true" : "false"}
                             </TableCell>
                             <TableCell>
-                              {user.admin ? "true" : "false"}
+                              {userRoles(user)
+                                .map((role) => role.name)
+                                .join(", ")}
                             </TableCell>
                             <TableCell>
                               {new Date(user.created_at)
@@ -156,6 +189,41 @@ export default function AdminView({ users, pagination }: Props) {
                                 .slice(0, 19)
                                 .replace("T", " ")}
                             </TableCell>
+                            <TableCell>
+                              {isLocked(user) && (
+                                <div className="flex items-center gap-2">
+                                  {new Date(user.locked_until as string)
+                                    .toISOString()
+                                    .slice(0, 19)
+                                    .replace("T", " ")}
+                                  <Button
+                                    size="sm"
+                                    variant="outline"
+                                    onClick={() => handleUnlockUser(user.id)}
+                                  >
+                                    Unlock
+                                  </Button>
+                                </div>
+                              )}
+                            </TableCell>
+                            <TableCell>
+                              {canImpersonate(user) && (
+                                <Button
+                                  onClick={() => handleImpersonateUser(user.id)}
+                                  variant="outline"
+                                >
+                                  Impersonate
+                                </Button>
+                              )}
+                            </TableCell>
+                            <TableCell>
+                              <Button
+                                onClick={() => setRolesUser(user)}
+                                variant="secondary"
+                              >
+                                Roles
+                              </Button>
+                            </TableCell>
                             <TableCell>
                               <Button
                                 onClick={() => openEditModal(user)}
@@ -215,6 +283,13 @@ export default function AdminView({ users, pagination }: Props) {
           errors={errors}
           processing={processing}
         />
+
+        <UserRolesModal
+          user={rolesUser}
+          roles={roles}
+          assigned={rolesUser ? userRoles(rolesUser) : []}
+          onClose={() => setRolesUser(null)}
+        />
       </div>
     </AppLayout>
   );
@@ -0,0 +1,55 @@
+import { type SharedData } from "@/types";
+import { Head, useForm, usePage } from "@inertiajs/react";
+import { LoaderCircle } from "lucide-react";
+import { FormEventHandler } from "react";
+
+import { Button } from "@/components/ui/button";
+import AuthLayout from "@/Layouts/AuthLayout";
+import TextLink from "@/components/TextLink";
+
+interface InvitationProps {
+  token: string;
+  organization: string;
+  email: string;
+}
+
+export default function Invitation({
+  token,
+  organization,
+  email,
+}: InvitationProps) {
+  const { auth } = usePage<SharedData>().props;
+  const { post, processing } = useForm({});
+
+  const submit: FormEventHandler = (e) => {
+    e.preventDefault();
+    post(`/invitations/${token}`);
+  };
+
+  return (
+    <AuthLayout
+      title={`Join ${organization}`}
+      description={`This invitation was sent to ${email}`}
+      logo="Pagode"
+    >
+      <Head title={`Join ${organization}`} />
+
+      {auth.user ? (
+        <form onSubmit={submit}>
+          <Button className="w-full" autoFocus disabled={processing}>
+            {processing && <LoaderCircle className="h-4 w-4 animate-spin" />}
+            Accept invitation
+          </Button>
+        </form>
+      ) : (
+        <div className="space-x-1 text-center text-sm text-muted-foreground">
+          <span>Please</span>
+          <TextLink href="/user/login">log in</TextLink>
+          <span>or</span>
+          <TextLink href="/user/register">sign up</TextLink>
+          <span>with {email} to accept the invitation.</span>
+        </div>
+      )}
+    </AuthLayout>
+  );
+}
@@ -9,6 +9,8 @@ import { Label } from "@/components/ui/label";
 import AuthLayout from "@/Layouts/AuthLayout";
 import TextLink from "@/components/TextLink";
 import InputError from "@/components/InputError";
+import OAuthProviders, { type OAuthProvider } from "@/components/OAuthProviders";
+import PasskeyLogin from "@/components/PasskeyLogin";
 
 type LoginForm = {
   email: string;
@@ -19,9 +21,14 @@ type LoginForm = {
 interface LoginProps {
   status?: string;
   canResetPassword: boolean;
+  providers: OAuthProvider[];
 }
 
-export default function Login({ status, canResetPassword }: LoginProps) {
+export default function Login({
+  status,
+  canResetPassword,
+  providers,
+}: LoginProps) {
   const { data, setData, post, processing, errors, reset } = useForm<
     Required<LoginForm>
   >({
@@ -111,6 +118,16 @@ export default function Login({ status, canResetPassword }: LoginProps) {
           </Button>
         </div>
 
+        <div className="text-center text-sm">
+          <TextLink href="/user/login/link" tabIndex={5}>
+            Email me a login link
+          </TextLink>
+        </div>
+
+        <PasskeyLogin />
+
+        <OAuthProviders providers={providers} />
+
         <div className="text-center text-sm text-muted-foreground">
           Don't have an account?{" "}
           <TextLink href="/user/register" tabIndex={5}>
@@ -0,0 +1,70 @@
+import { Head, useForm } from "@inertiajs/react";
+import { LoaderCircle } from "lucide-react";
+import { FormEventHandler } from "react";
+
+import { Button } from "@/components/ui/button";
+import { Input } from "@/components/ui/input";
+import { Label } from "@/components/ui/label";
+import AuthLayout from "@/Layouts/AuthLayout";
+import InputError from "@/components/InputError";
+import TextLink from "@/components/TextLink";
+
+export default function LoginLink({ status }: { status?: string }) {
+  const { data, setData, post, processing, errors } = useForm<
+    Required<{ email: string }>
+  >({
+    email: "",
+  });
+
+  const submit: FormEventHandler = (e) => {
+    e.preventDefault();
+    post("/user/login/link");
+  };
+
+  return (
+    <AuthLayout
+      title="Log in with email"
+      description="Enter your email to receive a link to log in"
+      logo="Pagode"
+    >
+      <Head title="Log in with email" />
+
+      {status && (
+        <div className="mb-4 text-center text-sm font-medium text-green-600">
+          {status}
+        </div>
+      )}
+
+      <div className="space-y-6">
+        <form onSubmit={submit}>
+          <div className="grid gap-2">
+            <Label htmlFor="email">Email address</Label>
+            <Input
+              id="email"
+              type="email"
+              name="email"
+              autoComplete="off"
+              value={data.email}
+              autoFocus
+              onChange={(e) => setData("email", e.target.value)}
+              placeholder="email@example.com"
+            />
+            <InputError message={errors.email} />
+          </div>
+
+          <div className="my-6 flex items-center justify-start">
+            <Button className="w-full" disabled={processing}>
+              {processing && <LoaderCircle className="h-4 w-4 animate-spin" />}
+              Email me a login link
+            </Button>
+          </div>
+        </form>
+
+        <div className="space-x-1 text-center text-sm text-muted-foreground">
+          <span>Or, return to</span>
+          <TextLink href="/user/login">log in</TextLink>
+        </div>
+      </div>
+    </AuthLayout>
+  );
+}
@@ -0,0 +1,40 @@
+import { Head, useForm } from "@inertiajs/react";
+import { LoaderCircle } from "lucide-react";
+import { FormEventHandler } from "react";
+
+import { Button } from "@/components/ui/button";
+import AuthLayout from "@/Layouts/AuthLayout";
+import TextLink from "@/components/TextLink";
+
+export default function LoginLinkConfirm({ token }: { token: string }) {
+  const { post, processing } = useForm({});
+
+  const submit: FormEventHandler = (e) => {
+    e.preventDefault();
+    post(`/user/login/link/${token}`);
+  };
+
+  return (
+    <AuthLayout
+      title="Log in with email"
+      description="Continue to log in to your account"
+      logo="Pagode"
+    >
+      <Head title="Log in with email" />
+
+      <div className="space-y-6">
+        <form onSubmit={submit}>
+          <Button className="w-full" autoFocus disabled={processing}>
+            {processing && <LoaderCircle className="h-4 w-4 animate-spin" />}
+            Log in
+          </Button>
+        </form>
+
+        <div className="space-x-1 text-center text-sm text-muted-foreground">
+          <span>Or, return to</span>
+          <TextLink href="/user/login">log in</TextLink>
+        </div>
+      </div>
+    </AuthLayout>
+  );
+}
@@ -6,6 +6,7 @@ import { Button } from "@/components/ui/button";
 import { Input } from "@/components/ui/input";
 import { Label } from "@/components/ui/label";
 import InputError from "@/components/InputError";
+import OAuthProviders, { type OAuthProvider } from "@/components/OAuthProviders";
 import TextLink from "@/components/TextLink";
 import AuthLayout from "@/Layouts/AuthLayout";
 
@@ -16,7 +17,11 @@ type RegisterForm = {
   password_confirmation: string;
 };
 
-export default function Register() {
+interface RegisterProps {
+  providers: OAuthProvider[];
+}
+
+export default function Register({ providers }: RegisterProps) {
   const { data, setData, post, processing, errors } = useForm<
     Required<RegisterForm>
   >({
@@ -106,6 +111,8 @@ export default function Register() {
           </Button>
         </div>
 
+        <OAuthProviders providers={providers} />
+
         <div className="text-center text-sm text-muted-foreground">
           Already have an account?{" "}
           <TextLink href="/user/login" tabIndex={6}>
@@ -0,0 +1,126 @@
+import { Head, useForm } from "@inertiajs/react";
+import { LoaderCircle } from "lucide-react";
+import { FormEventHandler, useState } from "react";
+
+import { Button } from "@/components/ui/button";
+import { Checkbox } from "@/components/ui/checkbox";
+import { Input } from "@/components/ui/input";
+import { Label } from "@/components/ui/label";
+import AuthLayout from "@/Layouts/AuthLayout";
+import InputError from "@/components/InputError";
+
+type TwoFactorChallengeForm = {
+  code: string;
+  recovery_code: string;
+  remember: boolean;
+};
+
+interface TwoFactorChallengeProps {
+  rememberDays: number;
+}
+
+export default function TwoFactorChallenge({
+  rememberDays,
+}: TwoFactorChallengeProps) {
+  const [useRecoveryCode, setUseRecoveryCode] = useState(false);
+
+  const { data, setData, post, processing, errors, reset } = useForm<
+    Required<TwoFactorChallengeForm>
+  >({
+    code: "",
+    recovery_code: "",
+    remember: false,
+  });
+
+  const submit: FormEventHandler = (e) => {
+    e.preventDefault();
+    post("/user/login/two-factor", {
+      onFinish: () => reset("code", "recovery_code"),
+    });
+  };
+
+  const toggleRecoveryCode = () => {
+    reset("code", "recovery_code");
+    setUseRecoveryCode(!useRecoveryCode);
+  };
+
+  return (
+    <AuthLayout
+      title="Two-factor authentication"
+      description={
+        useRecoveryCode
+          ? "Enter one of your recovery codes to continue"
+          : "Enter the code from your authenticator app to continue"
+      }
+      logo="Pagode"
+    >
+      <Head title="Two-factor authentication" />
+
+      <form className="flex flex-col gap-6" onSubmit={submit}>
+        <div className="grid gap-6">
+          {useRecoveryCode ? (
+            <div className="grid gap-2">
+              <Label htmlFor="recovery_code">Recovery code</Label>
+              <Input
+                id="recovery_code"
+                type="text"
+                required
+                autoFocus
+                autoComplete="off"
+                value={data.recovery_code}
+                onChange={(e) => setData("recovery_code", e.target.value)}
+                placeholder="xxxxx-xxxxx"
+              />
+              <InputError message={errors.recovery_code} />
+            </div>
+          ) : (
+            <div className="grid gap-2">
+              <Label htmlFor="code">Authentication code</Label>
+              <Input
+                id="code"
+                type="text"
+                inputMode="numeric"
+                required
+                autoFocus
+                autoComplete="one-time-code"
+                value={data.code}
+                onChange={(e) => setData("code", e.target.value)}
+                placeholder="123456"
+              />
+              <InputError message={errors.code} />
+            </div>
+          )}
+
+          <div className="flex items-center space-x-3">
+            <Checkbox
+              id="remember"
+              name="remember"
+              checked={data.remember}
+              onClick={() => setData("remember", !data.remember)}
+            />
+            <Label htmlFor="remember">
+              Remember this device for {rememberDays} days
+            </Label>
+          </div>
+
+          <Button type="submit" className="mt-4 w-full" disabled={processing}>
+            {processing && <LoaderCircle className="h-4 w-4 animate-spin" />}
+            Continue
+          </Button>
+        </div>
+
+        <div className="text-center text-sm text-muted-foreground">
+          <button
+            type="button"
+            className="underline underline-offset-4"
+            onClick={toggleRecoveryCode}
+          >
+            {useRecoveryCode
+              ? "Use an authentication code"
+              : "Use a recovery code"}
+          </button>
+        </div>
+      </form>
+    </AuthLayout>
+  );
+}
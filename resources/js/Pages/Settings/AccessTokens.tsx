@@ -0,0 +1,221 @@
+import { type BreadcrumbItem } from "@/types";
+import { Head, router, useForm } from "@inertiajs/react";
+import { FormEventHandler, useState } from "react";
+
+import { Button } from "@/components/ui/button";
+import { Checkbox } from "@/components/ui/checkbox";
+import { Input } from "@/components/ui/input";
+import { Label } from "@/components/ui/label";
+import AppLayout from "@/Layouts/AppLayout";
+import SettingsLayout from "@/Layouts/Settings/Layout";
+import HeadingSmall from "@/components/HeadingSmall";
+import InputError from "@/components/InputError";
+
+const breadcrumbs: BreadcrumbItem[] = [
+  {
+    title: "Access tokens",
+    href: "/profile/tokens",
+  },
+];
+
+interface AccessToken {
+  id: number;
+  name: string;
+  scopes: string[];
+  createdAt: string;
+  lastUsedAt: string | null;
+  expiresAt: string | null;
+}
+
+interface Scope {
+  name: string;
+  description: string;
+}
+
+interface AccessTokensProps {
+  tokens: AccessToken[];
+  scopes: Scope[];
+  newToken: string;
+}
+
+const expirations = [
+  { days: 7, label: "7 days" },
+  { days: 30, label: "30 days" },
+  { days: 90, label: "90 days" },
+  { days: 365, label: "1 year" },
+  { days: 0, label: "Never" },
+];
+
+export default function AccessTokens({
+  tokens,
+  scopes,
+  newToken,
+}: AccessTokensProps) {
+  const [revoking, setRevoking] = useState(false);
+
+  const { data, setData, errors, post, reset, processing } = useForm<{
+    name: string;
+    scopes: string[];
+    expires_in: number;
+  }>({
+    name: "",
+    scopes: ["read"],
+    expires_in: 30,
+  });
+
+  const create: FormEventHandler = (e) => {
+    e.preventDefault();
+
+    post("/profile/tokens", {
+      preserveScroll: true,
+      onSuccess: () => reset(),
+    });
+  };
+
+  const toggleScope = (name: string) => {
+    setData(
+      "scopes",
+      data.scopes.includes(name)
+        ? data.scopes.filter((scope) => scope !== name)
+        : [...data.scopes, name],
+    );
+  };
+
+  const revoke = (token: AccessToken) => {
+    if (!confirm(`Are you sure you want to revoke ${token.name}?`)) {
+      return;
+    }
+
+    setRevoking(true);
+    router.post(
+      `/profile/tokens/${token.id}/revoke`,
+      {},
+      { onFinish: () => setRevoking(false) },
+    );
+  };
+
+  return (
+    <AppLayout breadcrumbs={breadcrumbs}>
+      <Head title="Access tokens" />
+
+      <SettingsLayout>
+        <div className="space-y-6">
+          <HeadingSmall
+            title="Access tokens"
+            description="Authenticate scripts and apps with an Authorization: Bearer header"
+          />
+
+          {newToken && (
+            <div className="space-y-2 rounded-lg border p-4">
+              <p className="text-sm font-medium">
+                Copy your new access token now. You won’t be able to see it
+                again.
+              </p>
+              <code className="block rounded bg-muted p-2 font-mono text-sm break-all">
+                {newToken}
+              </code>
+            </div>
+          )}
+
+          {tokens.length > 0 && (
+            <ul className="divide-y rounded-lg border">
+              {tokens.map((token) => (
+                <li
+                  key={token.id}
+                  className="flex items-center justify-between gap-4 p-4"
+                >
+                  <div className="min-w-0 space-y-1">
+                    <p className="truncate text-sm font-medium">
+                      {token.name}
+                    </p>
+                    <p className="text-sm text-muted-foreground">
+                      {token.scopes.join(", ")} &middot;{" "}
+                      {token.lastUsedAt
+                        ? `Last used ${new Date(token.lastUsedAt).toLocaleString()}`
+                        : "Never used"}{" "}
+                      &middot;{" "}
+                      {token.expiresAt
+                        ? `Expires ${new Date(token.expiresAt).toLocaleString()}`
+                        : "Never expires"}
+                    </p>
+                  </div>
+                  <Button
+                    variant="secondary"
+                    size="sm"
+                    disabled={revoking}
+                    onClick={() => revoke(token)}
+                  >
+                    Revoke
+                  </Button>
+                </li>
+              ))}
+            </ul>
+          )}
+
+          <HeadingSmall
+            title="Create access token"
+            description="Tokens act on your behalf, limited to the selected scopes"
+          />
+
+          <form onSubmit={create} className="space-y-6">
+            <div className="grid gap-2">
+              <Label htmlFor="name">Name</Label>
+
+              <Input
+                id="name"
+                value={data.name}
+                onChange={(e) => setData("name", e.target.value)}
+                className="mt-1 block w-full"
+                required
+                placeholder="Deploy script"
+              />
+
+              <InputError message={errors.name} />
+            </div>
+
+            <div className="grid gap-2">
+              <Label>Scopes</Label>
+
+              {scopes.map((scope) => (
+                <div key={scope.name} className="flex items-center space-x-3">
+                  <Checkbox
+                    id={`scope-${scope.name}`}
+                    checked={data.scopes.includes(scope.name)}
+                    onCheckedChange={() => toggleScope(scope.name)}
+                  />
+                  <Label htmlFor={`scope-${scope.name}`}>
+                    {scope.name}{" "}
+                    <span className="text-muted-foreground">
+                      &middot; {scope.description}
+                    </span>
+                  </Label>
+                </div>
+              ))}
+
+              <InputError message={errors.scopes} />
+            </div>
+
+            <div className="grid gap-2">
+              <Label htmlFor="expires_in">Expiration</Label>
+
+              <select
+                id="expires_in"
+                value={data.expires_in}
+                onChange={(e) => setData("expires_in", Number(e.target.value))}
+                className="mt-1 block w-full rounded-md border bg-transparent px-3 py-2 text-sm"
+              >
+                {expirations.map((expiration) => (
+                  <option key={expiration.days} value={expiration.days}>
+                    {expiration.label}
+                  </option>
+                ))}
+              </select>
+            </div>
+
+            <Button disabled={processing}>Create token</Button>
+          </form>
+        </div>
+      </SettingsLayout>
+    </AppLayout>
+  );
+}
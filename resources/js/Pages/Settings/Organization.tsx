@@ -0,0 +1,229 @@
+import { type BreadcrumbItem, type SharedData } from "@/types";
+import { Head, router, useForm, usePage } from "@inertiajs/react";
+import { FormEventHandler, useState } from "react";
+
+import { Button } from "@/components/ui/button";
+import { Input } from "@/components/ui/input";
+import { Label } from "@/components/ui/label";
+import AppLayout from "@/Layouts/AppLayout";
+import SettingsLayout from "@/Layouts/Settings/Layout";
+import HeadingSmall from "@/components/HeadingSmall";
+import InputError from "@/components/InputError";
+
+interface Member {
+  id: number;
+  name: string;
+  email: string;
+  role: string;
+  joinedAt: string;
+}
+
+interface Invitation {
+  id: number;
+  email: string;
+  role: string;
+  expiresAt: string;
+}
+
+interface OrganizationProps {
+  organization: { id: number; name: string };
+  role: string;
+  canManage: boolean;
+  members: Member[];
+  invitations: Invitation[];
+}
+
+export default function Organization({
+  organization,
+  canManage,
+  members,
+  invitations,
+}: OrganizationProps) {
+  const { auth } = usePage<SharedData>().props;
+  const [processing, setProcessing] = useState(false);
+
+  const breadcrumbs: BreadcrumbItem[] = [
+    {
+      title: "Organizations",
+      href: "/organizations",
+    },
+    {
+      title: organization.name,
+      href: `/organizations/${organization.id}`,
+    },
+  ];
+
+  const {
+    data,
+    setData,
+    errors,
+    post,
+    reset,
+    processing: inviting,
+  } = useForm({
+    email: "",
+    role: "member",
+  });
+
+  const invite: FormEventHandler = (e) => {
+    e.preventDefault();
+
+    post(`/organizations/${organization.id}/invitations`, {
+      forceFormData: true,
+      preserveScroll: true,
+      onSuccess: () => reset(),
+    });
+  };
+
+  const submit = (url: string, message: string) => {
+    if (!confirm(message)) {
+      return;
+    }
+
+    setProcessing(true);
+    router.post(url, {}, { onFinish: () => setProcessing(false) });
+  };
+
+  return (
+    <AppLayout breadcrumbs={breadcrumbs}>
+      <Head title={organization.name} />
+
+      <SettingsLayout>
+        <div className="space-y-6">
+          <HeadingSmall
+            title={organization.name}
+            description="Members share the subscriptions of the organization"
+          />
+
+          <ul className="divide-y rounded-lg border">
+            {members.map((member) => (
+              <li
+                key={member.id}
+                className="flex items-center justify-between gap-4 p-4"
+              >
+                <div className="min-w-0 space-y-1">
+                  <p className="truncate text-sm font-medium">{member.name}</p>
+                  <p className="text-sm text-muted-foreground">
+                    {member.email} &middot;{" "}
+                    <span className="capitalize">{member.role}</span>
+                  </p>
+                </div>
+                {member.id === auth.user.id ? (
+                  <Button
+                    variant="secondary"
+                    size="sm"
+                    disabled={processing}
+                    onClick={() =>
+                      submit(
+                        `/organizations/${organization.id}/members/${member.id}/remove`,
+                        `Are you sure you want to leave ${organization.name}?`,
+                      )
+                    }
+                  >
+                    Leave
+                  </Button>
+                ) : (
+                  canManage && (
+                    <Button
+                      variant="secondary"
+                      size="sm"
+                      disabled={processing}
+                      onClick={() =>
+                        submit(
+                          `/organizations/${organization.id}/members/${member.id}/remove`,
+                          `Are you sure you want to remove ${member.name}?`,
+                        )
+                      }
+                    >
+                      Remove
+                    </Button>
+                  )
+                )}
+              </li>
+            ))}
+          </ul>
+
+          {canManage && (
+            <>
+              <HeadingSmall
+                title="Invitations"
+                description="Invite others to join the organization by email"
+              />
+
+              {invitations.length > 0 && (
+                <ul className="divide-y rounded-lg border">
+                  {invitations.map((invitation) => (
+                    <li
+                      key={invitation.id}
+                      className="flex items-center justify-between gap-4 p-4"
+                    >
+                      <div className="min-w-0 space-y-1">
+                        <p className="truncate text-sm font-medium">
+                          {invitation.email}
+                        </p>
+                        <p className="text-sm text-muted-foreground">
+                          <span className="capitalize">{invitation.role}</span>{" "}
+                          &middot; Expires{" "}
+                          {new Date(invitation.expiresAt).toLocaleString()}
+                        </p>
+                      </div>
+                      <Button
+                        variant="secondary"
+                        size="sm"
+                        disabled={processing}
+                        onClick={() =>
+                          submit(
+                            `/organizations/${organization.id}/invitations/${invitation.id}/revoke`,
+                            `Are you sure you want to revoke the invitation for ${invitation.email}?`,
+                          )
+                        }
+                      >
+                        Revoke
+                      </Button>
+                    </li>
+                  ))}
+                </ul>
+              )}
+
+              <form onSubmit={invite} className="space-y-6">
+                <div className="grid gap-2">
+                  <Label htmlFor="email">Email address</Label>
+
+                  <Input
+                    id="email"
+                    type="email"
+                    value={data.email}
+                    onChange={(e) => setData("email", e.target.value)}
+                    className="mt-1 block w-full"
+                    required
+                    placeholder="email@example.com"
+                  />
+
+                  <InputError message={errors.email} />
+                </div>
+
+                <div className="grid gap-2">
+                  <Label htmlFor="role">Role</Label>
+
+                  <select
+                    id="role"
+                    value={data.role}
+                    onChange={(e) => setData("role", e.target.value)}
+                    className="mt-1 block w-full rounded-md border bg-transparent px-3 py-2 text-sm"
+                  >
+                    <option value="member">Member</option>
+                    <option value="admin">Admin</option>
+                  </select>
+
+                  <InputError message={errors.role} />
+                </div>
+
+                <Button disabled={inviting}>Send invitation</Button>
+              </form>
+            </>
+          )}
+        </div>
+      </SettingsLayout>
+    </AppLayout>
+  );
+}
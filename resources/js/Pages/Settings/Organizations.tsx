@@ -0,0 +1,143 @@
+import { type BreadcrumbItem } from "@/types";
+import { Head, Link, router, useForm } from "@inertiajs/react";
+import { FormEventHandler, useState } from "react";
+
+import { Button } from "@/components/ui/button";
+import { Input } from "@/components/ui/input";
+import { Label } from "@/components/ui/label";
+import AppLayout from "@/Layouts/AppLayout";
+import SettingsLayout from "@/Layouts/Settings/Layout";
+import HeadingSmall from "@/components/HeadingSmall";
+import InputError from "@/components/InputError";
+
+const breadcrumbs: BreadcrumbItem[] = [
+  {
+    title: "Organizations",
+    href: "/organizations",
+  },
+];
+
+interface Organization {
+  id: number;
+  name: string;
+  role: string;
+  active: boolean;
+}
+
+interface OrganizationsProps {
+  organizations: Organization[];
+}
+
+export default function Organizations({ organizations }: OrganizationsProps) {
+  const [switching, setSwitching] = useState(false);
+
+  const { data, setData, errors, post, reset, processing } = useForm({
+    name: "",
+  });
+
+  const create: FormEventHandler = (e) => {
+    e.preventDefault();
+
+    post("/organizations", {
+      forceFormData: true,
+      preserveScroll: true,
+      onSuccess: () => reset(),
+    });
+  };
+
+  const switchTo = (id: number) => {
+    setSwitching(true);
+    router.post(
+      "/organizations/switch",
+      { organization_id: id },
+      { forceFormData: true, onFinish: () => setSwitching(false) },
+    );
+  };
+
+  const personal = !organizations.some((org) => org.active);
+
+  return (
+    <AppLayout breadcrumbs={breadcrumbs}>
+      <Head title="Organizations" />
+
+      <SettingsLayout>
+        <div className="space-y-6">
+          <HeadingSmall
+            title="Organizations"
+            description="Share subscriptions and resources with your team"
+          />
+
+          <ul className="divide-y rounded-lg border">
+            <li className="flex items-center justify-between gap-4 p-4">
+              <div className="min-w-0 space-y-1">
+                <p className="truncate text-sm font-medium">Personal account</p>
+                <p className="text-sm text-muted-foreground">
+                  {personal ? "Active" : "Act on your own behalf"}
+                </p>
+              </div>
+              <Button
+                variant="secondary"
+                size="sm"
+                disabled={switching || personal}
+                onClick={() => switchTo(0)}
+              >
+                Switch
+              </Button>
+            </li>
+            {organizations.map((org) => (
+              <li
+                key={org.id}
+                className="flex items-center justify-between gap-4 p-4"
+              >
+                <div className="min-w-0 space-y-1">
+                  <Link
+                    href={`/organizations/${org.id}`}
+                    className="truncate text-sm font-medium hover:underline"
+                  >
+                    {org.name}
+                  </Link>
+                  <p className="text-sm text-muted-foreground capitalize">
+                    {org.role}
+                    {org.active && " · Active"}
+                  </p>
+                </div>
+                <Button
+                  variant="secondary"
+                  size="sm"
+                  disabled={switching || org.active}
+                  onClick={() => switchTo(org.id)}
+                >
+                  Switch
+                </Button>
+              </li>
+            ))}
+          </ul>
+
+          <HeadingSmall
+            title="Create organization"
+            description="You will be its owner and can invite others to join it"
+          />
+
+          <form onSubmit={create} className="space-y-6">
+            <div className="grid gap-2">
+              <Label htmlFor="name">Name</Label>
+
+              <Input
+                id="name"
+                value={data.name}
+                onChange={(e) => setData("name", e.target.value)}
+                className="mt-1 block w-full"
+                required
+                placeholder="Organization name"
+              />
+
+              <InputError message={errors.name} />
+            </div>
+
+            <Button disabled={processing}>Create organization</Button>
+          </form>
+        </div>
+      </SettingsLayout>
+    </AppLayout>
+  );
+}
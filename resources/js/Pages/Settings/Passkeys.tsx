@@ -0,0 +1,143 @@
+import { type BreadcrumbItem } from "@/types";
+import { Head, router } from "@inertiajs/react";
+import { FormEventHandler, useEffect, useState } from "react";
+import { toast } from "sonner";
+
+import { Button } from "@/components/ui/button";
+import { Input } from "@/components/ui/input";
+import { Label } from "@/components/ui/label";
+import AppLayout from "@/Layouts/AppLayout";
+import SettingsLayout from "@/Layouts/Settings/Layout";
+import HeadingSmall from "@/components/HeadingSmall";
+import { createPasskey, passkeysSupported } from "@/lib/passkeys";
+
+const breadcrumbs: BreadcrumbItem[] = [
+  {
+    title: "Passkeys",
+    href: "/profile/passkeys",
+  },
+];
+
+interface Passkey {
+  id: number;
+  name: string;
+  createdAt: string;
+  lastUsedAt: string | null;
+}
+
+interface PasskeysProps {
+  passkeys: Passkey[];
+}
+
+export default function Passkeys({ passkeys }: PasskeysProps) {
+  const [supported, setSupported] = useState(false);
+  const [name, setName] = useState("");
+  const [processing, setProcessing] = useState(false);
+
+  useEffect(() => setSupported(passkeysSupported()), []);
+
+  const add: FormEventHandler = async (e) => {
+    e.preventDefault();
+    setProcessing(true);
+
+    try {
+      const credential = await createPasskey("/user/passkeys/options");
+      router.post(
+        "/user/passkeys",
+        { name, credential },
+        {
+          preserveScroll: true,
+          onSuccess: () => setName(""),
+          onFinish: () => setProcessing(false),
+        },
+      );
+    } catch {
+      // The user cancelled or the browser could not create a passkey.
+      toast.error("Unable to create a passkey.");
+      setProcessing(false);
+    }
+  };
+
+  const remove = (passkey: Passkey) => {
+    if (!confirm(`Are you sure you want to remove ${passkey.name}?`)) {
+      return;
+    }
+
+    setProcessing(true);
+    router.post(
+      `/profile/passkeys/${passkey.id}/delete`,
+      {},
+      { onFinish: () => setProcessing(false) },
+    );
+  };
+
+  return (
+    <AppLayout breadcrumbs={breadcrumbs}>
+      <Head title="Passkeys" />
+
+      <SettingsLayout>
+        <div className="space-y-6">
+          <HeadingSmall
+            title="Passkeys"
+            description="Log in with your fingerprint, face or device PIN instead of a password"
+          />
+
+          {passkeys.length > 0 && (
+            <ul className="divide-y rounded-lg border">
+              {passkeys.map((passkey) => (
+                <li
+                  key={passkey.id}
+                  className="flex items-center justify-between gap-4 p-4"
+                >
+                  <div className="min-w-0 space-y-1">
+                    <p className="truncate text-sm font-medium">
+                      {passkey.name}
+                    </p>
+                    <p className="text-sm text-muted-foreground">
+                      Added {new Date(passkey.createdAt).toLocaleString()}{" "}
+                      &middot;{" "}
+                      {passkey.lastUsedAt
+                        ? `Last used ${new Date(passkey.lastUsedAt).toLocaleString()}`
+                        : "Never used"}
+                    </p>
+                  </div>
+                  <Button
+                    variant="secondary"
+                    size="sm"
+                    disabled={processing}
+                    onClick={() => remove(passkey)}
+                  >
+                    Remove
+                  </Button>
+                </li>
+              ))}
+            </ul>
+          )}
+
+          {supported ? (
+            <form onSubmit={add} className="space-y-6">
+              <div className="grid gap-2">
+                <Label htmlFor="name">Name</Label>
+
+                <Input
+                  id="name"
+                  value={name}
+                  onChange={(e) => setName(e.target.value)}
+                  className="mt-1 block w-full"
+                  required
+                  placeholder="Work laptop"
+                />
+              </div>
+
+              <Button disabled={processing}>Add passkey</Button>
+            </form>
+          ) : (
+            <p className="text-sm text-muted-foreground">
+              This browser does not support passkeys.
+            </p>
+          )}
+        </div>
+      </SettingsLayout>
+    </AppLayout>
+  );
+}
@@ -7,6 +7,7 @@ import { Button } from "@/components/ui/button";
 import { Input } from "@/components/ui/input";
 import { Label } from "@/components/ui/label";
 import DeleteUser from "@/components/DeleteUser";
+import ExportData from "@/components/ExportData";
 import SettingsLayout from "@/Layouts/Settings/Layout";
 import HeadingSmall from "@/components/HeadingSmall";
 import InputError from "@/components/InputError";
@@ -91,6 +92,25 @@ export default function Profile({
               />
 
               <InputError className="mt-2" message={errors.email} />
+
+              {auth.user.pending_email && (
+                <p className="text-sm text-muted-foreground">
+                  Waiting for confirmation of{" "}
+                  <span className="font-medium text-foreground">
+                    {auth.user.pending_email}
+                  </span>
+                  . Check your inbox for the confirmation link.{" "}
+                  <Link
+                    href="/profile/email/cancel"
+                    method="post"
+                    as="button"
+                    preserveScroll
+                    className="text-foreground underline decoration-neutral-300 underline-offset-4 transition-colors duration-300 ease-out hover:decoration-current! dark:decoration-neutral-500"
+                  >
+                    Cancel the change.
+                  </Link>
+                </p>
+              )}
             </div>
 
             {mustVerifyEmail && auth.user.email_verified_at === null && (
@@ -131,6 +151,8 @@ export default function Profile({
           </form>
         </div>
 
+        <ExportData />
+
         <DeleteUser />
       </SettingsLayout>
     </AppLayout>
@@ -0,0 +1,94 @@
+import { type BreadcrumbItem } from "@/types";
+import { Head, router } from "@inertiajs/react";
+import { useState } from "react";
+
+import { Button } from "@/components/ui/button";
+import AppLayout from "@/Layouts/AppLayout";
+import SettingsLayout from "@/Layouts/Settings/Layout";
+import HeadingSmall from "@/components/HeadingSmall";
+
+const breadcrumbs: BreadcrumbItem[] = [
+  {
+    title: "Sessions",
+    href: "/profile/sessions",
+  },
+];
+
+interface Session {
+  id: number;
+  userAgent: string;
+  ipAddress: string;
+  createdAt: string;
+  lastSeenAt: string;
+  current: boolean;
+}
+
+interface SessionsProps {
+  sessions: Session[];
+}
+
+export default function Sessions({ sessions }: SessionsProps) {
+  const [processing, setProcessing] = useState(false);
+
+  const revoke = (url: string) => {
+    setProcessing(true);
+    router.post(url, {}, { onFinish: () => setProcessing(false) });
+  };
+
+  const revokeAll = () => {
+    if (!confirm("Are you sure you want to sign out of all devices, including this one?")) {
+      return;
+    }
+
+    revoke("/profile/sessions/revoke");
+  };
+
+  return (
+    <AppLayout breadcrumbs={breadcrumbs}>
+      <Head title="Sessions" />
+
+      <SettingsLayout>
+        <div className="space-y-6">
+          <HeadingSmall
+            title="Sessions"
+            description="Manage the devices which are signed in to your account"
+          />
+
+          <ul className="divide-y rounded-lg border">
+            {sessions.map((session) => (
+              <li
+                key={session.id}
+                className="flex items-center justify-between gap-4 p-4"
+              >
+                <div className="min-w-0 space-y-1">
+                  <p className="truncate text-sm font-medium">
+                    {session.userAgent || "Unknown device"}
+                  </p>
+                  <p className="text-sm text-muted-foreground">
+                    {session.ipAddress || "Unknown IP address"} &middot; Last
+                    active {new Date(session.lastSeenAt).toLocaleString()}
+                    {session.current && " · This device"}
+                  </p>
+                </div>
+                <Button
+                  variant="secondary"
+                  size="sm"
+                  disabled={processing}
+                  onClick={() =>
+                    revoke(`/profile/sessions/${session.id}/revoke`)
+                  }
+                >
+                  Sign out
+                </Button>
+              </li>
+            ))}
+          </ul>
+
+          <Button variant="destructive" disabled={processing} onClick={revokeAll}>
+            Sign out everywhere
+          </Button>
+        </div>
+      </SettingsLayout>
+    </AppLayout>
+  );
+}
@@ -0,0 +1,180 @@
+import { type BreadcrumbItem } from "@/types";
+import { Head, useForm } from "@inertiajs/react";
+import { FormEventHandler } from "react";
+
+import { Button } from "@/components/ui/button";
+import { Input } from "@/components/ui/input";
+import { Label } from "@/components/ui/label";
+import AppLayout from "@/Layouts/AppLayout";
+import SettingsLayout from "@/Layouts/Settings/Layout";
+import HeadingSmall from "@/components/HeadingSmall";
+import InputError from "@/components/InputError";
+
+const breadcrumbs: BreadcrumbItem[] = [
+  {
+    title: "Two-factor authentication",
+    href: "/profile/two-factor",
+  },
+];
+
+interface TwoFactorProps {
+  enabled: boolean;
+  recoveryCodes?: string[] | null;
+  remainingRecoveryCodes?: number;
+  secret?: string;
+  uri?: string;
+  qrCode?: string;
+}
+
+export default function TwoFactor({
+  enabled,
+  recoveryCodes,
+  remainingRecoveryCodes,
+  secret,
+  uri,
+  qrCode,
+}: TwoFactorProps) {
+  const enableForm = useForm({ code: "" });
+  const passwordForm = useForm({ password: "" });
+
+  const enable: FormEventHandler = (e) => {
+    e.preventDefault();
+
+    enableForm.post("/profile/two-factor/enable", {
+      forceFormData: true,
+      preserveScroll: true,
+      onFinish: () => enableForm.reset(),
+    });
+  };
+
+  const submitWithPassword = (url: string) => {
+    passwordForm.post(url, {
+      forceFormData: true,
+      preserveScroll: true,
+      onFinish: () => passwordForm.reset(),
+    });
+  };
+
+  return (
+    <AppLayout breadcrumbs={breadcrumbs}>
+      <Head title="Two-factor authentication" />
+
+      <SettingsLayout>
+        <div className="space-y-6">
+          <HeadingSmall
+            title="Two-factor authentication"
+            description="Add additional security to your account using an authenticator app"
+          />
+
+          {recoveryCodes && recoveryCodes.length > 0 && (
+            <div className="space-y-4 rounded-lg border p-4">
+              <p className="text-sm font-medium">
+                Store these recovery codes somewhere safe. Each code can be
+                used once to log in if you lose access to your authenticator
+                app. They will not be shown again.
+              </p>
+              <div className="grid grid-cols-2 gap-2 font-mono text-sm">
+                {recoveryCodes.map((code) => (
+                  <span key={code}>{code}</span>
+                ))}
+              </div>
+            </div>
+          )}
+
+          {enabled ? (
+            <>
+              <p className="text-sm text-muted-foreground">
+                Two-factor authentication is enabled. You have{" "}
+                {remainingRecoveryCodes} unused recovery codes remaining.
+              </p>
+
+              <form
+                className="space-y-6"
+                onSubmit={(e) => e.preventDefault()}
+              >
+                <div className="grid gap-2">
+                  <Label htmlFor="password">Current password</Label>
+                  <Input
+                    id="password"
+                    type="password"
+                    value={passwordForm.data.password}
+                    onChange={(e) =>
+                      passwordForm.setData("password", e.target.value)
+                    }
+                    autoComplete="current-password"
+                    placeholder="Current password"
+                  />
+                  <InputError message={passwordForm.errors.password} />
+                </div>
+
+                <div className="flex items-center gap-4">
+                  <Button
+                    type="button"
+                    variant="secondary"
+                    disabled={passwordForm.processing}
+                    onClick={() =>
+                      submitWithPassword("/profile/two-factor/recovery-codes")
+                    }
+                  >
+                    Regenerate recovery codes
+                  </Button>
+                  <Button
+                    type="button"
+                    variant="destructive"
+                    disabled={passwordForm.processing}
+                    onClick={() =>
+                      submitWithPassword("/profile/two-factor/disable")
+                    }
+                  >
+                    Disable
+                  </Button>
+                </div>
+              </form>
+            </>
+          ) : (
+            <form onSubmit={enable} className="space-y-6">
+              <p className="text-sm text-muted-foreground">
+                Scan the QR code with your authenticator app, or enter the
+                setup key manually, then enter the code it generates.
+              </p>
+
+              {qrCode && (
+                <img
+                  src={qrCode}
+                  alt="QR code"
+                  width={200}
+                  height={200}
+                  className="rounded-lg border bg-white p-2"
+                />
+              )}
+
+              <div className="grid gap-2">
+                <Label>Setup key</Label>
+                <code className="text-sm break-all">{secret}</code>
+                <a href={uri} className="text-sm underline underline-offset-4">
+                  Open in authenticator app
+                </a>
+              </div>
+
+              <div className="grid gap-2">
+                <Label htmlFor="code">Authentication code</Label>
+                <Input
+                  id="code"
+                  type="text"
+                  inputMode="numeric"
+                  value={enableForm.data.code}
+                  onChange={(e) => enableForm.setData("code", e.target.value)}
+                  autoComplete="one-time-code"
+                  placeholder="123456"
+                />
+                <InputError message={enableForm.errors.code} />
+              </div>
+
+              <Button disabled={enableForm.processing}>Enable</Button>
+            </form>
+          )}
+        </div>
+      </SettingsLayout>
+    </AppLayout>
+  );
+}
@@ -11,7 +11,6 @@ import { Label } from "@/components/ui/label";
 type UserFormData = {
   name: string;
   email: string;
-  admin: boolean;
   emailVerified: boolean;
 };
 
@@ -72,30 +71,15 @@ export function UserFormModal({
             )}
           </div>
 
-          <div className="grid gap-4 sm:grid-cols-2">
-            <div className="grid gap-2">
-              <Label>Admin</Label>
-              <Button
-                type="button"
-                variant={data.admin ? "default" : "outline"}
-                onClick={() => setData("admin", !data.admin)}
-              >
-                {data.admin ? "Yes (Click to unset)" : "No (Click to set)"}
-              </Button>
-            </div>
-
-            <div className="grid gap-2">
-              <Label>Verified</Label>
-              <Button
-                type="button"
-                variant={data.emailVerified ? "default" : "outline"}
-                onClick={() => setData("emailVerified", !data.emailVerified)}
-              >
-                {data.emailVerified
-                  ? "Yes (Click to unset)"
-                  : "No (Click to set)"}
-              </Button>
-            </div>
+          <div className="grid gap-2">
+            <Label>Verified</Label>
+            <Button
+              type="button"
+              variant={data.emailVerified ? "default" : "outline"}
+              onClick={() => setData("emailVerified", !data.emailVerified)}
+            >
+              {data.emailVerified ? "Yes (Click to unset)" : "No (Click to set)"}
+            </Button>
           </div>
 
           <div className="flex justify-end pt-4">
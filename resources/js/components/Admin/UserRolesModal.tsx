@@ -0,0 +1,95 @@
+import { router } from "@inertiajs/react";
+import { useEffect, useState } from "react";
+
+import {
+  Dialog,
+  DialogContent,
+  DialogHeader,
+  DialogTitle,
+} from "@/components/ui/dialog";
+import { Button } from "@/components/ui/button";
+import { Checkbox } from "@/components/ui/checkbox";
+import { Label } from "@/components/ui/label";
+import { User } from "@/types";
+
+export type Role = {
+  id: number;
+  name: string;
+  description?: string;
+};
+
+type Props = {
+  user: User | null;
+  roles: Role[];
+  assigned: Role[];
+  onClose: () => void;
+};
+
+export function UserRolesModal({ user, roles, assigned, onClose }: Props) {
+  const [selected, setSelected] = useState<number[]>([]);
+  const [processing, setProcessing] = useState(false);
+
+  useEffect(() => {
+    setSelected(assigned.map((role) => role.id));
+  }, [user]); // eslint-disable-line react-hooks/exhaustive-deps
+
+  const toggle = (id: number) => {
+    setSelected((current) =>
+      current.includes(id)
+        ? current.filter((roleId) => roleId !== id)
+        : [...current, id],
+    );
+  };
+
+  const handleSubmit = (e: React.FormEvent) => {
+    e.preventDefault();
+    if (!user) return;
+
+    router.post(
+      `/admin/users/${user.id}/roles`,
+      { roles: selected },
+      {
+        preserveScroll: true,
+        onStart: () => setProcessing(true),
+        onFinish: () => setProcessing(false),
+        onSuccess: () => onClose(),
+      },
+    );
+  };
+
+  return (
+    <Dialog open={!!user} onOpenChange={onClose}>
+      <DialogContent>
+        <DialogHeader>
+          <DialogTitle>Roles of {user?.name}</DialogTitle>
+        </DialogHeader>
+
+        <form onSubmit={handleSubmit} className="space-y-4">
+          {roles.map((role) => (
+            <div key={role.id} className="flex items-start space-x-3">
+              <Checkbox
+                id={`role-${role.id}`}
+                checked={selected.includes(role.id)}
+                onClick={() => toggle(role.id)}
+              />
+              <div className="grid gap-1">
+                <Label htmlFor={`role-${role.id}`}>{role.name}</Label>
+                {role.description && (
+                  <p className="text-sm text-muted-foreground">
+                    {role.description}
+                  </p>
+                )}
+              </div>
+            </div>
+          ))}
+
+          <div className="flex justify-end pt-4">
+            <Button type="submit" disabled={processing}>
+              Update
+            </Button>
+          </div>
+        </form>
+      </DialogContent>
+    </Dialog>
+  );
+}
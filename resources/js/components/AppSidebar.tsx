@@ -69,7 +69,7 @@ export function AppSidebar() {
     },
   ];
 
-  if (auth.user?.admin) {
+  if (auth.permissions?.includes("users.manage")) {
     mainNavItems.push({
       title: "Admin Panel",
       href: "/admin/users",
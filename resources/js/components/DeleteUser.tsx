@@ -52,7 +52,8 @@ export default function DeleteUser() {
         <div className="relative space-y-0.5 text-red-600 dark:text-red-100">
           <p className="font-medium">Warning</p>
           <p className="text-sm">
-            Please proceed with caution, this cannot be undone.
+            You can restore your account by logging in during the grace period,
+            after which this cannot be undone.
           </p>
         </div>
 
@@ -65,9 +66,10 @@ export default function DeleteUser() {
               Are you sure you want to delete your account?
             </DialogTitle>
             <DialogDescription>
-              Once your account is deleted, all of its resources and data will
-              also be permanently deleted. Please enter your password to confirm
-              you would like to permanently delete your account.
+              Once the grace period has passed, your account and all of its
+              resources and data will be permanently deleted, and any
+              subscriptions cancelled. Please enter your password to confirm
+              you would like to delete your account.
             </DialogDescription>
             <form className="space-y-6" onSubmit={deleteUser}>
               <div className="grid gap-2">
@@ -0,0 +1,35 @@
+import { useForm } from "@inertiajs/react";
+import { FormEventHandler } from "react";
+
+import { Button } from "@/components/ui/button";
+import HeadingSmall from "./HeadingSmall";
+
+export default function ExportData() {
+  const { post, processing } = useForm({});
+
+  const exportData: FormEventHandler = (e) => {
+    e.preventDefault();
+
+    post("/profile/export", {
+      preserveScroll: true,
+    });
+  };
+
+  return (
+    <div className="space-y-6">
+      <HeadingSmall
+        title="Download your data"
+        description="Get a copy of the data stored about your account"
+      />
+      <form onSubmit={exportData} className="space-y-4">
+        <p className="text-sm text-muted-foreground">
+          We will gather your profile, chat messages and rooms, and billing
+          records into a zip file and email you a link to download it.
+        </p>
+        <Button variant="secondary" disabled={processing}>
+          Request my data
+        </Button>
+      </form>
+    </div>
+  );
+}
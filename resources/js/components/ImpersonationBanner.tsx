@@ -0,0 +1,30 @@
+import { Link, usePage } from "@inertiajs/react";
+import { SharedProps } from "@/types/global";
+
+export default function ImpersonationBanner() {
+  const { auth } = usePage<SharedProps>().props;
+
+  if (!auth.impersonator || !auth.user) {
+    return null;
+  }
+
+  return (
+    <div className="flex items-center justify-center gap-2 bg-amber-500 px-4 py-2 text-sm text-amber-950">
+      <span>
+        {auth.impersonator.name} is impersonating{" "}
+        <span className="font-medium">
+          {auth.user.name} ({auth.user.email})
+        </span>
+        .
+      </span>
+      <Link
+        href="/impersonation/stop"
+        method="post"
+        as="button"
+        className="font-medium underline underline-offset-4"
+      >
+        Stop impersonating
+      </Link>
+    </div>
+  );
+}
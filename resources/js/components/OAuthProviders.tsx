@@ -0,0 +1,32 @@
+import { Button } from "@/components/ui/button";
+
+export interface OAuthProvider {
+  name: string;
+  label: string;
+}
+
+interface OAuthProvidersProps {
+  providers?: OAuthProvider[];
+}
+
+export default function OAuthProviders({ providers = [] }: OAuthProvidersProps) {
+  if (providers.length === 0) {
+    return null;
+  }
+
+  return (
+    <div className="grid gap-4">
+      <div className="relative text-center text-sm text-muted-foreground">
+        <span>Or continue with</span>
+      </div>
+      <div className="grid gap-2">
+        {providers.map((provider) => (
+          // Providers are external, so a regular link is used rather than an Inertia visit.
+          <Button key={provider.name} variant="outline" className="w-full" asChild>
+            <a href={`/auth/${provider.name}`}>{provider.label}</a>
+          </Button>
+        ))}
+      </div>
+    </div>
+  );
+}
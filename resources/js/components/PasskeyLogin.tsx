@@ -0,0 +1,48 @@
+import { router } from "@inertiajs/react";
+import { KeyRound } from "lucide-react";
+import { useEffect, useState } from "react";
+import { toast } from "sonner";
+
+import { Button } from "@/components/ui/button";
+import { getPasskey, passkeysSupported } from "@/lib/passkeys";
+
+export default function PasskeyLogin() {
+  const [supported, setSupported] = useState(false);
+  const [processing, setProcessing] = useState(false);
+
+  useEffect(() => setSupported(passkeysSupported()), []);
+
+  if (!supported) {
+    return null;
+  }
+
+  const login = async () => {
+    setProcessing(true);
+
+    try {
+      const credential = await getPasskey("/user/login/passkey/options");
+      router.post(
+        "/user/login/passkey",
+        { credential },
+        { onFinish: () => setProcessing(false) },
+      );
+    } catch {
+      // The user cancelled or the browser could not use a passkey.
+      toast.error("Unable to log in with a passkey.");
+      setProcessing(false);
+    }
+  };
+
+  return (
+    <Button
+      type="button"
+      variant="outline"
+      className="w-full"
+      disabled={processing}
+      onClick={login}
+    >
+      <KeyRound className="h-4 w-4" />
+      Log in with a passkey
+    </Button>
+  );
+}
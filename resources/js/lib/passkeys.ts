@@ -0,0 +1,72 @@
+// Passkey ceremonies use the JSON serialization of the WebAuthn API, so options and responses can be exchanged
+// with the server as-is.
+type PublicKeyCredentialStatic = typeof PublicKeyCredential & {
+  parseCreationOptionsFromJSON(options: unknown): PublicKeyCredentialCreationOptions;
+  parseRequestOptionsFromJSON(options: unknown): PublicKeyCredentialRequestOptions;
+};
+
+// PasskeyResponse is the JSON serialization of a credential, which is submitted to the server.
+// eslint-disable-next-line @typescript-eslint/no-explicit-any
+export type PasskeyResponse = Record<string, any>;
+
+type SerializableCredential = PublicKeyCredential & {
+  toJSON(): PasskeyResponse;
+};
+
+function getCsrfToken(): string | undefined {
+  return document.cookie
+    .split("; ")
+    .find((row) => row.startsWith("XSRF-TOKEN="))
+    ?.split("=")[1];
+}
+
+async function fetchOptions(url: string): Promise<unknown> {
+  const csrfToken = getCsrfToken();
+  const res = await fetch(url, {
+    method: "POST",
+    headers: csrfToken ? { "X-XSRF-TOKEN": decodeURIComponent(csrfToken) } : {},
+  });
+
+  if (!res.ok) {
+    throw new Error("Unable to start using a passkey");
+  }
+
+  return res.json();
+}
+
+export function passkeysSupported(): boolean {
+  return (
+    typeof window !== "undefined" &&
+    "PublicKeyCredential" in window &&
+    "parseCreationOptionsFromJSON" in PublicKeyCredential
+  );
+}
+
+// createPasskey creates a passkey using the options provided by the server and returns the response to submit.
+export async function createPasskey(optionsUrl: string): Promise<PasskeyResponse> {
+  const options = await fetchOptions(optionsUrl);
+  const credential = (await navigator.credentials.create({
+    publicKey: (PublicKeyCredential as PublicKeyCredentialStatic).parseCreationOptionsFromJSON(options),
+  })) as SerializableCredential | null;
+
+  if (!credential) {
+    throw new Error("No passkey was created");
+  }
+
+  return credential.toJSON();
+}
+
+// getPasskey asks the user for a passkey using the options provided by the server and returns the response to
+// submit.
+export async function getPasskey(optionsUrl: string): Promise<PasskeyResponse> {
+  const options = await fetchOptions(optionsUrl);
+  const credential = (await navigator.credentials.get({
+    publicKey: (PublicKeyCredential as PublicKeyCredentialStatic).parseRequestOptionsFromJSON(options),
+  })) as SerializableCredential | null;
+
+  if (!credential) {
+    throw new Error("No passkey was selected");
+  }
+
+  return credential.toJSON();
+}
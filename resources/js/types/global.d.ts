@@ -1,4 +1,4 @@
-import { User } from ".";
+import { Impersonator, User } from ".";
 
 export type FlashMessages = {
   success?: string[];
@@ -11,5 +11,7 @@ export type SharedProps = {
   flash: FlashMessages;
   auth: {
     user: User | null;
+    permissions?: string[];
+    impersonator?: Impersonator | null;
   };
 };
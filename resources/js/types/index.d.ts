@@ -3,6 +3,14 @@ import { LucideIcon } from "lucide-react";
 
 export interface Auth {
   user: User;
+  permissions: string[];
+  impersonator?: Impersonator | null;
+}
+
+export interface Impersonator {
+  id: number;
+  name: string;
+  email: string;
 }
 
 export interface BreadcrumbItem {
@@ -35,6 +43,7 @@ export interface User {
   id: number;
   name: string;
   email: string;
+  pending_email?: string;
   avatar?: string;
   email_verified_at: string | null;
   created_at: string;